@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/collections/v1beta1/query.proto
+
+package collectionsv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Collections_FullMethodName = "/cosmos.base.collections.v1beta1.Query/Collections"
+	Query_Get_FullMethodName         = "/cosmos.base.collections.v1beta1.Query/Get"
+	Query_Entries_FullMethodName     = "/cosmos.base.collections.v1beta1.Query/Entries"
+	Query_ByIndex_FullMethodName     = "/cosmos.base.collections.v1beta1.Query/ByIndex"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Collections returns the collections of the schema of a store.
+	Collections(ctx context.Context, in *QueryCollectionsRequest, opts ...grpc.CallOption) (*QueryCollectionsResponse, error)
+	// Get returns the entry of a collection with the given key.
+	Get(ctx context.Context, in *QueryGetRequest, opts ...grpc.CallOption) (*QueryGetResponse, error)
+	// Entries returns the entries of a collection, optionally restricted to the
+	// entries whose multipart key starts with the given first part.
+	Entries(ctx context.Context, in *QueryEntriesRequest, opts ...grpc.CallOption) (*QueryEntriesResponse, error)
+	// ByIndex returns the entries of an indexed collection referenced by the
+	// given key of one of its indexes.
+	ByIndex(ctx context.Context, in *QueryByIndexRequest, opts ...grpc.CallOption) (*QueryByIndexResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Collections(ctx context.Context, in *QueryCollectionsRequest, opts ...grpc.CallOption) (*QueryCollectionsResponse, error) {
+	out := new(QueryCollectionsResponse)
+	err := c.cc.Invoke(ctx, Query_Collections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Get(ctx context.Context, in *QueryGetRequest, opts ...grpc.CallOption) (*QueryGetResponse, error) {
+	out := new(QueryGetResponse)
+	err := c.cc.Invoke(ctx, Query_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Entries(ctx context.Context, in *QueryEntriesRequest, opts ...grpc.CallOption) (*QueryEntriesResponse, error) {
+	out := new(QueryEntriesResponse)
+	err := c.cc.Invoke(ctx, Query_Entries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ByIndex(ctx context.Context, in *QueryByIndexRequest, opts ...grpc.CallOption) (*QueryByIndexResponse, error) {
+	out := new(QueryByIndexResponse)
+	err := c.cc.Invoke(ctx, Query_ByIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Collections returns the collections of the schema of a store.
+	Collections(context.Context, *QueryCollectionsRequest) (*QueryCollectionsResponse, error)
+	// Get returns the entry of a collection with the given key.
+	Get(context.Context, *QueryGetRequest) (*QueryGetResponse, error)
+	// Entries returns the entries of a collection, optionally restricted to the
+	// entries whose multipart key starts with the given first part.
+	Entries(context.Context, *QueryEntriesRequest) (*QueryEntriesResponse, error)
+	// ByIndex returns the entries of an indexed collection referenced by the
+	// given key of one of its indexes.
+	ByIndex(context.Context, *QueryByIndexRequest) (*QueryByIndexResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Collections(context.Context, *QueryCollectionsRequest) (*QueryCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Collections not implemented")
+}
+func (UnimplementedQueryServer) Get(context.Context, *QueryGetRequest) (*QueryGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedQueryServer) Entries(context.Context, *QueryEntriesRequest) (*QueryEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Entries not implemented")
+}
+func (UnimplementedQueryServer) ByIndex(context.Context, *QueryByIndexRequest) (*QueryByIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByIndex not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Collections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Collections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Collections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Collections(ctx, req.(*QueryCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Get(ctx, req.(*QueryGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Entries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Entries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Entries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Entries(ctx, req.(*QueryEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ByIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ByIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ByIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ByIndex(ctx, req.(*QueryByIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.collections.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Collections",
+			Handler:    _Query_Collections_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Query_Get_Handler,
+		},
+		{
+			MethodName: "Entries",
+			Handler:    _Query_Entries_Handler,
+		},
+		{
+			MethodName: "ByIndex",
+			Handler:    _Query_ByIndex_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/collections/v1beta1/query.proto",
+}
@@ -21,15 +21,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName     = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName         = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName        = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName             = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName            = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName           = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName          = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName         = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName      = "/cosmos.gov.v1.Query/TallyResult"
+	Query_MinDeposit_FullMethodName       = "/cosmos.gov.v1.Query/MinDeposit"
+	Query_TallyBreakdown_FullMethodName   = "/cosmos.gov.v1.Query/TallyBreakdown"
+	Query_ValidatorTallies_FullMethodName = "/cosmos.gov.v1.Query/ValidatorTallies"
+	Query_VoterTally_FullMethodName       = "/cosmos.gov.v1.Query/VoterTally"
+	Query_VoterTallies_FullMethodName     = "/cosmos.gov.v1.Query/VoterTallies"
+	Query_LiveTally_FullMethodName        = "/cosmos.gov.v1.Query/LiveTally"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +60,33 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// MinDeposit queries the current minimum deposit for a proposal to enter
+	// voting period, which increases with the number of proposals in voting period.
+	//
+	// Since: cosmos-sdk 0.50
+	MinDeposit(ctx context.Context, in *QueryMinDepositRequest, opts ...grpc.CallOption) (*QueryMinDepositResponse, error)
+	// TallyBreakdown queries the tally breakdown of a proposal, persisted when
+	// its voting period ended.
+	//
+	// Since: cosmos-sdk 0.50
+	TallyBreakdown(ctx context.Context, in *QueryTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryTallyBreakdownResponse, error)
+	// ValidatorTallies queries the per validator tally breakdown of a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidatorTallies(ctx context.Context, in *QueryValidatorTalliesRequest, opts ...grpc.CallOption) (*QueryValidatorTalliesResponse, error)
+	// VoterTally queries the tally breakdown of a proposal for a single voter.
+	//
+	// Since: cosmos-sdk 0.50
+	VoterTally(ctx context.Context, in *QueryVoterTallyRequest, opts ...grpc.CallOption) (*QueryVoterTallyResponse, error)
+	// VoterTallies queries the per voter tally breakdown of a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoterTallies(ctx context.Context, in *QueryVoterTalliesRequest, opts ...grpc.CallOption) (*QueryVoterTalliesResponse, error)
+	// LiveTally queries the current tally and tally breakdown of a proposal in
+	// voting period.
+	//
+	// Since: cosmos-sdk 0.50
+	LiveTally(ctx context.Context, in *QueryLiveTallyRequest, opts ...grpc.CallOption) (*QueryLiveTallyResponse, error)
 }
 
 type queryClient struct {
@@ -145,6 +178,60 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) MinDeposit(ctx context.Context, in *QueryMinDepositRequest, opts ...grpc.CallOption) (*QueryMinDepositResponse, error) {
+	out := new(QueryMinDepositResponse)
+	err := c.cc.Invoke(ctx, Query_MinDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyBreakdown(ctx context.Context, in *QueryTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryTallyBreakdownResponse, error) {
+	out := new(QueryTallyBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_TallyBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorTallies(ctx context.Context, in *QueryValidatorTalliesRequest, opts ...grpc.CallOption) (*QueryValidatorTalliesResponse, error) {
+	out := new(QueryValidatorTalliesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorTallies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoterTally(ctx context.Context, in *QueryVoterTallyRequest, opts ...grpc.CallOption) (*QueryVoterTallyResponse, error) {
+	out := new(QueryVoterTallyResponse)
+	err := c.cc.Invoke(ctx, Query_VoterTally_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoterTallies(ctx context.Context, in *QueryVoterTalliesRequest, opts ...grpc.CallOption) (*QueryVoterTalliesResponse, error) {
+	out := new(QueryVoterTalliesResponse)
+	err := c.cc.Invoke(ctx, Query_VoterTallies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiveTally(ctx context.Context, in *QueryLiveTallyRequest, opts ...grpc.CallOption) (*QueryLiveTallyResponse, error) {
+	out := new(QueryLiveTallyResponse)
+	err := c.cc.Invoke(ctx, Query_LiveTally_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -167,6 +254,33 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// MinDeposit queries the current minimum deposit for a proposal to enter
+	// voting period, which increases with the number of proposals in voting period.
+	//
+	// Since: cosmos-sdk 0.50
+	MinDeposit(context.Context, *QueryMinDepositRequest) (*QueryMinDepositResponse, error)
+	// TallyBreakdown queries the tally breakdown of a proposal, persisted when
+	// its voting period ended.
+	//
+	// Since: cosmos-sdk 0.50
+	TallyBreakdown(context.Context, *QueryTallyBreakdownRequest) (*QueryTallyBreakdownResponse, error)
+	// ValidatorTallies queries the per validator tally breakdown of a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidatorTallies(context.Context, *QueryValidatorTalliesRequest) (*QueryValidatorTalliesResponse, error)
+	// VoterTally queries the tally breakdown of a proposal for a single voter.
+	//
+	// Since: cosmos-sdk 0.50
+	VoterTally(context.Context, *QueryVoterTallyRequest) (*QueryVoterTallyResponse, error)
+	// VoterTallies queries the per voter tally breakdown of a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoterTallies(context.Context, *QueryVoterTalliesRequest) (*QueryVoterTalliesResponse, error)
+	// LiveTally queries the current tally and tally breakdown of a proposal in
+	// voting period.
+	//
+	// Since: cosmos-sdk 0.50
+	LiveTally(context.Context, *QueryLiveTallyRequest) (*QueryLiveTallyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -201,6 +315,24 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) MinDeposit(context.Context, *QueryMinDepositRequest) (*QueryMinDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinDeposit not implemented")
+}
+func (UnimplementedQueryServer) TallyBreakdown(context.Context, *QueryTallyBreakdownRequest) (*QueryTallyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyBreakdown not implemented")
+}
+func (UnimplementedQueryServer) ValidatorTallies(context.Context, *QueryValidatorTalliesRequest) (*QueryValidatorTalliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorTallies not implemented")
+}
+func (UnimplementedQueryServer) VoterTally(context.Context, *QueryVoterTallyRequest) (*QueryVoterTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoterTally not implemented")
+}
+func (UnimplementedQueryServer) VoterTallies(context.Context, *QueryVoterTalliesRequest) (*QueryVoterTalliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoterTallies not implemented")
+}
+func (UnimplementedQueryServer) LiveTally(context.Context, *QueryLiveTallyRequest) (*QueryLiveTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiveTally not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +508,114 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MinDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MinDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinDeposit(ctx, req.(*QueryMinDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TallyBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyBreakdown(ctx, req.(*QueryTallyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorTallies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorTalliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorTallies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorTallies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorTallies(ctx, req.(*QueryValidatorTalliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoterTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoterTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoterTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoterTally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoterTally(ctx, req.(*QueryVoterTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoterTallies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoterTalliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoterTallies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoterTallies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoterTallies(ctx, req.(*QueryVoterTalliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiveTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiveTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiveTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiveTally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiveTally(ctx, req.(*QueryLiveTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +659,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "MinDeposit",
+			Handler:    _Query_MinDeposit_Handler,
+		},
+		{
+			MethodName: "TallyBreakdown",
+			Handler:    _Query_TallyBreakdown_Handler,
+		},
+		{
+			MethodName: "ValidatorTallies",
+			Handler:    _Query_ValidatorTallies_Handler,
+		},
+		{
+			MethodName: "VoterTally",
+			Handler:    _Query_VoterTally_Handler,
+		},
+		{
+			MethodName: "VoterTallies",
+			Handler:    _Query_VoterTallies_Handler,
+		},
+		{
+			MethodName: "LiveTally",
+			Handler:    _Query_LiveTally_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.mint.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.mint.v1beta1.Msg/UpdateParams"
+	Msg_UpdateEmissionSchedule_FullMethodName = "/cosmos.mint.v1beta1.Msg/UpdateEmissionSchedule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,11 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateEmissionSchedule defines a governance operation for replacing the
+	// emission schedule of the x/mint module.
+	//
+	// Since: cosmos-sdk 0.50
+	UpdateEmissionSchedule(ctx context.Context, in *MsgUpdateEmissionSchedule, opts ...grpc.CallOption) (*MsgUpdateEmissionScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +56,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateEmissionSchedule(ctx context.Context, in *MsgUpdateEmissionSchedule, opts ...grpc.CallOption) (*MsgUpdateEmissionScheduleResponse, error) {
+	out := new(MsgUpdateEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateEmissionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +74,11 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateEmissionSchedule defines a governance operation for replacing the
+	// emission schedule of the x/mint module.
+	//
+	// Since: cosmos-sdk 0.50
+	UpdateEmissionSchedule(context.Context, *MsgUpdateEmissionSchedule) (*MsgUpdateEmissionScheduleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +89,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateEmissionSchedule(context.Context, *MsgUpdateEmissionSchedule) (*MsgUpdateEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmissionSchedule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +123,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateEmissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEmissionSchedule(ctx, req.(*MsgUpdateEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +152,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateEmissionSchedule",
+			Handler:    _Msg_UpdateEmissionSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/tx.proto",
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName            = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName       = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName      = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_DowntimeHistory_FullMethodName   = "/cosmos.slashing.v1beta1.Query/DowntimeHistory"
+	Query_DowntimeHistories_FullMethodName = "/cosmos.slashing.v1beta1.Query/DowntimeHistories"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,16 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeHistory queries the downtime infractions history of given cons
+	// address, and the penalty of its next downtime infraction.
+	//
+	// Since: cosmos-sdk 0.50
+	DowntimeHistory(ctx context.Context, in *QueryDowntimeHistoryRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoryResponse, error)
+	// DowntimeHistories queries the downtime infractions history of all
+	// validators.
+	//
+	// Since: cosmos-sdk 0.50
+	DowntimeHistories(ctx context.Context, in *QueryDowntimeHistoriesRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoriesResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +83,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeHistory(ctx context.Context, in *QueryDowntimeHistoryRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoryResponse, error) {
+	out := new(QueryDowntimeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_DowntimeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DowntimeHistories(ctx context.Context, in *QueryDowntimeHistoriesRequest, opts ...grpc.CallOption) (*QueryDowntimeHistoriesResponse, error) {
+	out := new(QueryDowntimeHistoriesResponse)
+	err := c.cc.Invoke(ctx, Query_DowntimeHistories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +111,16 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeHistory queries the downtime infractions history of given cons
+	// address, and the penalty of its next downtime infraction.
+	//
+	// Since: cosmos-sdk 0.50
+	DowntimeHistory(context.Context, *QueryDowntimeHistoryRequest) (*QueryDowntimeHistoryResponse, error)
+	// DowntimeHistories queries the downtime infractions history of all
+	// validators.
+	//
+	// Since: cosmos-sdk 0.50
+	DowntimeHistories(context.Context, *QueryDowntimeHistoriesRequest) (*QueryDowntimeHistoriesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +137,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) DowntimeHistory(context.Context, *QueryDowntimeHistoryRequest) (*QueryDowntimeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeHistory not implemented")
+}
+func (UnimplementedQueryServer) DowntimeHistories(context.Context, *QueryDowntimeHistoriesRequest) (*QueryDowntimeHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeHistories not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +210,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DowntimeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeHistory(ctx, req.(*QueryDowntimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DowntimeHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DowntimeHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeHistories(ctx, req.(*QueryDowntimeHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +265,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeHistory",
+			Handler:    _Query_DowntimeHistory_Handler,
+		},
+		{
+			MethodName: "DowntimeHistories",
+			Handler:    _Query_DowntimeHistories_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -661,7 +661,13 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 		WithHeaderHash(req.Hash).
 		WithConsensusParams(app.GetConsensusParams(app.finalizeBlockState.ctx)).
 		WithVoteInfos(req.DecidedLastCommit.Votes).
-		WithExecMode(sdk.ExecModeFinalize)
+		WithExecMode(sdk.ExecModeFinalize).
+		WithCometInfo(cometInfo{
+			Misbehavior:     req.Misbehavior,
+			ValidatorsHash:  req.NextValidatorsHash,
+			ProposerAddress: req.ProposerAddress,
+			LastCommit:      req.DecidedLastCommit,
+		})
 
 	if app.checkState != nil {
 		app.checkState.ctx = app.checkState.ctx.
@@ -672,6 +678,10 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 	beginBlock := app.beginBlock(req)
 	events = append(events, beginBlock.Events...)
 
+	if app.gasProfiler != nil {
+		app.gasProfiler.beginBlock(req.Height)
+	}
+
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
 	//
@@ -694,15 +704,28 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 	}
 
 	events = append(events, endBlock.Events...)
+	if app.gasProfiler != nil {
+		events = append(events, app.gasProfiler.endBlock()...)
+	}
+
 	cp := app.GetConsensusParams(app.finalizeBlockState.ctx)
 
-	return &abci.ResponseFinalizeBlock{
+	resp := &abci.ResponseFinalizeBlock{
 		Events:                events,
 		TxResults:             txResults,
 		ValidatorUpdates:      endBlock.ValidatorUpdates,
 		ConsensusParamUpdates: &cp,
 		AppHash:               app.workingHash(),
-	}, nil
+	}
+
+	// call the streaming service hooks with the FinalizeBlock messages
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		if err := abciListener.ListenFinalizeBlock(app.finalizeBlockState.ctx, *req, *resp); err != nil {
+			app.logger.Error("FinalizeBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+
+	return resp, nil
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -972,7 +995,9 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.RequestQ
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
-	resp, err := handler(ctx, req)
+	resp, err := runQueryWithGasLimit(ctx, func() (*abci.ResponseQuery, error) {
+		return handler(ctx, req)
+	})
 	if err != nil {
 		resp = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
 		resp.Height = req.Height
@@ -983,6 +1008,11 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.RequestQ
 }
 
 func gRPCErrorToSDKError(err error) error {
+	// queries running out of gas are already reported with the proper SDK error
+	if errors.Is(err, sdkerrors.ErrOutOfGas) {
+		return err
+	}
+
 	status, ok := grpcstatus.FromError(err)
 	if !ok {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
@@ -1006,6 +1036,38 @@ func gRPCErrorToSDKError(err error) error {
 	}
 }
 
+// newQueryGasMeter returns the gas meter used for a query context. A limit of 0
+// means the query is not gas limited.
+func newQueryGasMeter(limit uint64) storetypes.GasMeter {
+	if limit == 0 {
+		return storetypes.NewInfiniteGasMeter()
+	}
+
+	return storetypes.NewGasMeter(limit)
+}
+
+// runQueryWithGasLimit executes a query and converts an out of gas panic
+// raised by the query context's gas meter into an ErrOutOfGas error, so that
+// expensive queries fail cleanly instead of being reported as a panic.
+func runQueryWithGasLimit[T any](ctx sdk.Context, query func() (T, error)) (res T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = errorsmod.Wrapf(
+				sdkerrors.ErrOutOfGas,
+				"query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+				oog.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+			)
+		}
+	}()
+
+	return query()
+}
+
 func checkNegativeHeight(height int64) error {
 	if height < 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot query with height < 0; please provide a valid height")
@@ -1065,7 +1127,8 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit multi-store for safety
 	ctx := sdk.NewContext(cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger).
 		WithMinGasPrices(app.minGasPrices).
-		WithBlockHeight(height)
+		WithBlockHeight(height).
+		WithGasMeter(newQueryGasMeter(app.queryGasLimit))
 
 	if height != lastBlockHeight {
 		rms, ok := app.cms.(*rootmulti.Store)
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"cosmossdk.io/core/comet"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 
@@ -24,6 +25,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client/grpc/gasprofile"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -190,6 +192,39 @@ func TestABCI_FinalizeBlock_WithInitialHeight(t *testing.T) {
 	require.Equal(t, int64(3), app.LastBlockHeight())
 }
 
+func TestABCI_FinalizeBlock_CometInfo(t *testing.T) {
+	name := t.Name()
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil)
+
+	var blockInfo comet.BlockInfo
+	app.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+		blockInfo = ctx.CometInfo()
+		return sdk.BeginBlock{}, nil
+	})
+
+	app.InitChain(&abci.RequestInitChain{InitialHeight: 1})
+
+	misbehavior := abci.Misbehavior{
+		Type:             abci.MisbehaviorType_DUPLICATE_VOTE,
+		Validator:        abci.Validator{Address: []byte("validator"), Power: 10},
+		Height:           1,
+		TotalVotingPower: 10,
+	}
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:          1,
+		ProposerAddress: []byte("proposer"),
+		Misbehavior:     []abci.Misbehavior{misbehavior},
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, blockInfo)
+	require.Equal(t, []byte("proposer"), blockInfo.GetProposerAddress())
+	require.Equal(t, 1, blockInfo.GetEvidence().Len())
+	require.Equal(t, comet.DuplicateVote, blockInfo.GetEvidence().Get(0).Type())
+	require.Equal(t, []byte("validator"), blockInfo.GetEvidence().Get(0).Validator().Address())
+}
+
 func TestABCI_GRPCQuery(t *testing.T) {
 	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
 		testdata.RegisterQueryServer(
@@ -233,6 +268,69 @@ func TestABCI_GRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+// gasConsumingQueryImpl is a query server consuming a fixed amount of gas on
+// every SayHello call.
+type gasConsumingQueryImpl struct {
+	testdata.QueryImpl
+
+	gas uint64
+}
+
+func (q gasConsumingQueryImpl) SayHello(ctx context.Context, req *testdata.SayHelloRequest) (*testdata.SayHelloResponse, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(q.gas, "say hello")
+	return q.QueryImpl.SayHello(ctx, req)
+}
+
+func TestABCI_GRPCQuery_GasLimit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		limit    uint64
+		gas      uint64
+		expPass  bool
+		expError string
+	}{
+		{"no limit", 0, 1_000_000, true, ""},
+		{"within limit", 1000, 999, true, ""},
+		{"exceeds limit", 1000, 1001, false, "query out of gas"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+				testdata.RegisterQueryServer(
+					bapp.GRPCQueryRouter(),
+					gasConsumingQueryImpl{gas: tc.gas},
+				)
+			}
+
+			suite := NewBaseAppSuite(t, grpcQueryOpt, baseapp.SetQueryGasLimit(tc.limit))
+			suite.baseApp.InitChain(&abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+			})
+			suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+			suite.baseApp.Commit()
+
+			req := testdata.SayHelloRequest{Name: "foo"}
+			reqBz, err := req.Marshal()
+			require.NoError(t, err)
+
+			resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+				Data: reqBz,
+				Path: "/testpb.Query/SayHello",
+			})
+			require.NoError(t, err)
+
+			if tc.expPass {
+				require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+				return
+			}
+
+			require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), resQuery.Code, resQuery)
+			require.Contains(t, resQuery.Log, tc.expError)
+		})
+	}
+}
+
 func TestABCI_P2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAddrPeerFilter(func(addrport string) *abci.ResponseQuery {
@@ -416,6 +514,72 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_MsgEventHandler(t *testing.T) {
+	anteKey := []byte("ante-key")
+	handledKey := []byte("handled-key")
+	opts := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.SetMsgEventHandler(func(ctx sdk.Context, events []abci.Event) ([]abci.Event, error) {
+			var handled []abci.Event
+			for _, event := range events {
+				if event.Type != sdk.EventTypeMessage || len(event.Attributes) == 0 || event.Attributes[0].Key != "update_counter" {
+					continue
+				}
+
+				if event.Attributes[0].Value == "2" {
+					return nil, errors.New("event handler failure")
+				}
+
+				store := ctx.KVStore(capKey1)
+				setIntOnStore(store, handledKey, getIntFromStore(t, store, handledKey)+1)
+				handled = append(handled, abci.Event{Type: "counter_handled"})
+			}
+
+			return handled, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, opts)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	tx1Bytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0, 1))
+	require.NoError(t, err)
+	tx2Bytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 2))
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    [][]byte{tx1Bytes, tx2Bytes},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+
+	// the events emitted by the handler are added to the events of each message
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	var msgIndexes []string
+	for _, event := range res.TxResults[0].Events {
+		if event.Type == "counter_handled" {
+			msgIndexes = append(msgIndexes, event.Attributes[0].Value)
+		}
+	}
+	require.Equal(t, []string{"0", "1"}, msgIndexes)
+
+	// a handler error fails the message
+	require.False(t, res.TxResults[1].IsOK())
+	require.Contains(t, res.TxResults[1].Log, "failed to handle message events: event handler failure")
+
+	store := getFinalizeBlockStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(2), getIntFromStore(t, store, anteKey))
+	require.Equal(t, int64(2), getIntFromStore(t, store, deliverKey))
+	require.Equal(t, int64(2), getIntFromStore(t, store, handledKey))
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -489,6 +653,101 @@ func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	require.Equal(t, int64(2), msgCounter2)
 }
 
+func TestABCI_FinalizeBlock_GasProfile(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		anteHandler := anteHandlerTxTest(t, capKey1, anteKey)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return anteHandler(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), tx, simulate)
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetGasProfileBlocks(2))
+
+	suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	nBlocks := 3
+	txCounter := int64(0)
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		// block N has N+1 txs, each with two messages
+		txs := [][]byte{}
+		for i := 0; i <= blockN; i++ {
+			tx := newTxCounter(t, suite.txConfig, txCounter, 2*txCounter, 2*txCounter+1)
+			txCounter++
+
+			txBytes, err := suite.txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			txs = append(txs, txBytes)
+		}
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: int64(blockN) + 1,
+			Txs:    txs,
+		})
+		require.NoError(t, err)
+
+		var gasUsed int64
+		for _, txResult := range res.TxResults {
+			require.True(t, txResult.IsOK())
+			gasUsed += txResult.GasUsed
+		}
+		require.Greater(t, gasUsed, int64(0))
+
+		// the gas profile events are the last events of the block
+		events := res.Events[len(res.Events)-3:]
+		require.Equal(t, baseapp.EventTypeGasProfile, events[0].Type)
+		require.Equal(t, []abci.EventAttribute{
+			{Key: baseapp.AttributeKeyTxCount, Value: fmt.Sprint(blockN + 1)},
+			{Key: baseapp.AttributeKeyGasUsed, Value: fmt.Sprint(gasUsed)},
+			{Key: baseapp.AttributeKeyFees, Value: ""},
+		}, events[0].Attributes)
+		require.Equal(t, baseapp.EventTypeMsgGasProfile, events[1].Type)
+		require.Equal(t, baseapp.EventTypeStoreGasProfile, events[2].Type)
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	reqBz, err := (&gasprofile.QueryGasProfilesRequest{}).Marshal()
+	require.NoError(t, err)
+	resQuery, err := suite.baseApp.Query(context.TODO(), &abci.RequestQuery{
+		Path: "/cosmos.base.gasprofile.v1beta1.Query/GasProfiles",
+		Data: reqBz,
+	})
+	require.NoError(t, err)
+	require.True(t, resQuery.IsOK(), resQuery.Log)
+
+	var res gasprofile.QueryGasProfilesResponse
+	require.NoError(t, res.Unmarshal(resQuery.Value))
+
+	// only the profiles of the last two blocks are kept
+	require.Len(t, res.Profiles, 2)
+	for i, profile := range res.Profiles {
+		txCount := uint64(nBlocks - i)
+		require.Equal(t, int64(nBlocks-i), profile.Height)
+		require.Equal(t, txCount, profile.TxCount)
+		require.True(t, profile.Fees.IsZero())
+
+		require.Len(t, profile.Msgs, 1)
+		require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), profile.Msgs[0].TypeUrl)
+		require.Equal(t, 2*txCount, profile.Msgs[0].Count)
+		require.Greater(t, profile.Msgs[0].GasUsed, uint64(0))
+
+		// the ante handler and the messages only access the first store, which
+		// accounts for all the gas consumed but the 5 gas consumed directly by
+		// every message
+		require.Len(t, profile.Stores, 1)
+		require.Equal(t, capKey1.Name(), profile.Stores[0].Store)
+		require.Equal(t, profile.GasUsed-5*profile.Msgs[0].Count, profile.Stores[0].GasUsed)
+		require.Greater(t, profile.GasUsed, profile.Msgs[0].GasUsed)
+	}
+}
+
 func TestABCI_Query_SimulateTx(t *testing.T) {
 	gasConsumed := uint64(5)
 	anteOpt := func(bapp *baseapp.BaseApp) {
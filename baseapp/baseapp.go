@@ -22,6 +22,7 @@ import (
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/gasprofile"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -82,6 +83,7 @@ type BaseApp struct {
 	verifyVoteExt      sdk.VerifyVoteExtensionHandler // ABCI VerifyVoteExtension handler
 	prepareCheckStater sdk.PrepareCheckStater         // logic to run during commit using the checkState
 	precommiter        sdk.Precommiter                // logic to run during commit using the deliverState
+	msgEventHandler    sdk.MsgEventHandler            // logic to run on the events emitted by each message
 
 	addrPeerFilter sdk.PeerFilter // filter peers by address and port
 	idPeerFilter   sdk.PeerFilter // filter peers by node ID
@@ -156,6 +158,14 @@ type BaseApp struct {
 	// ResponseCommit.RetainHeight.
 	minRetainBlocks uint64
 
+	// queryGasLimit defines the maximum gas a single gRPC or ABCI query may
+	// consume. A value of 0 indicates that queries are not gas limited.
+	queryGasLimit uint64
+
+	// gasProfiler records the gas profiles of the last finalized blocks. It is
+	// nil if gas profiling is disabled.
+	gasProfiler *gasProfiler
+
 	// application's version string
 	version string
 
@@ -224,6 +234,9 @@ func NewBaseApp(
 	if app.interBlockCache != nil {
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
+	if app.gasProfiler != nil {
+		gasprofile.RegisterQueryServer(app.grpcQueryRouter, gasProfileQueryServer{profiler: app.gasProfiler})
+	}
 
 	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
 
@@ -431,6 +444,19 @@ func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
 
+func (app *BaseApp) setQueryGasLimit(queryGasLimit uint64) {
+	app.queryGasLimit = queryGasLimit
+}
+
+func (app *BaseApp) setGasProfileBlocks(gasProfileBlocks uint32) {
+	if gasProfileBlocks == 0 {
+		app.gasProfiler = nil
+		return
+	}
+
+	app.gasProfiler = newGasProfiler(gasProfileBlocks)
+}
+
 func (app *BaseApp) setInterBlockCache(cache storetypes.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
@@ -657,6 +683,10 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 		ctx, _ = ctx.CacheContext()
 	}
 
+	if mode == execModeFinalize && app.gasProfiler != nil {
+		ctx = ctx.WithStoreGasTracker(app.gasProfiler)
+	}
+
 	return ctx
 }
 
@@ -827,6 +857,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		return sdk.GasInfo{}, nil, nil, err
 	}
 
+	if mode == execModeFinalize && app.gasProfiler != nil {
+		defer func() {
+			app.gasProfiler.trackTx(tx, ctx.GasMeter().GasConsumed())
+		}()
+	}
+
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
 		return sdk.GasInfo{}, nil, nil, err
@@ -952,7 +988,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		// ADR 031 request type routing
+		gasBefore := ctx.GasMeter().GasConsumed()
 		msgResult, err := handler(ctx, msg)
+		if err == nil && app.msgEventHandler != nil {
+			msgResult, err = app.handleMsgEvents(ctx, msgResult)
+		}
+		if mode == execModeFinalize && app.gasProfiler != nil {
+			app.gasProfiler.trackMsg(msg, ctx.GasMeter().GasConsumed()-gasBefore)
+		}
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -998,6 +1041,18 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 	}, nil
 }
 
+// handleMsgEvents runs the msg event handler on the events emitted by a message,
+// and adds the events it emits to the message result.
+func (app *BaseApp) handleMsgEvents(ctx sdk.Context, msgResult *sdk.Result) (*sdk.Result, error) {
+	events, err := app.msgEventHandler(ctx, msgResult.Events)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to handle message events")
+	}
+
+	msgResult.Events = append(msgResult.Events, events...)
+	return msgResult, nil
+}
+
 // makeABCIData generates the Data field to be sent to ABCI Check/DeliverTx.
 func makeABCIData(msgResponses []*codectypes.Any) ([]byte, error) {
 	return proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
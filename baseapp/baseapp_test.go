@@ -596,6 +596,22 @@ func TestABCI_CreateQueryContext(t *testing.T) {
 	}
 }
 
+func TestABCI_CreateQueryContext_GasLimit(t *testing.T) {
+	t.Parallel()
+
+	db := dbm.NewMemDB()
+	name := t.Name()
+	app := baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil, baseapp.SetQueryGasLimit(100))
+
+	app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	app.Commit()
+
+	ctx, err := app.CreateQueryContext(1, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), ctx.GasMeter().Limit())
+	require.Panics(t, func() { ctx.GasMeter().ConsumeGas(101, "test") })
+}
+
 func TestSetMinGasPrices(t *testing.T) {
 	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}
 	suite := NewBaseAppSuite(t, baseapp.SetMinGasPrices(minGasPrices.String()))
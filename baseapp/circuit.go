@@ -1,8 +1,21 @@
 package baseapp
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// MsgCircuitBreaker is a CircuitBreaker which can also disable a message based
+// on its content, such as its signers or the amount it transfers. The
+// MsgServiceRouter checks IsMsgAllowed instead of IsAllowed on every message
+// dispatch when the circuit breaker implements it.
+type MsgCircuitBreaker interface {
+	CircuitBreaker
+	IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error)
+}
@@ -0,0 +1,233 @@
+package baseapp
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/gasprofile"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Gas profile event types and attribute keys. Gas profile events are appended
+// to the events of FinalizeBlock by the nodes recording gas profiles only, so
+// they must not be relied on by the state machine.
+const (
+	EventTypeGasProfile      = "gas_profile"
+	EventTypeMsgGasProfile   = "msg_gas_profile"
+	EventTypeStoreGasProfile = "store_gas_profile"
+
+	AttributeKeyTxCount = "tx_count"
+	AttributeKeyGasUsed = "gas_used"
+	AttributeKeyFees    = "fees"
+	AttributeKeyTypeURL = "type_url"
+	AttributeKeyCount   = "count"
+	AttributeKeyStore   = "store"
+)
+
+var _ sdk.StoreGasTracker = (*gasProfiler)(nil)
+
+// gasProfiler records the gas consumed by the transactions of the blocks
+// finalized by the BaseApp, by message type and by store, and keeps the
+// profiles of the last blocks in memory.
+type gasProfiler struct {
+	// retainBlocks is the number of profiles kept in memory.
+	retainBlocks int
+
+	// current is the profile of the block being finalized. It is only accessed
+	// by FinalizeBlock, hence it is not guarded by the mutex.
+	current *blockGasProfile
+
+	mtx      sync.RWMutex
+	profiles []gasprofile.BlockGasProfile // most recent last
+}
+
+// blockGasProfile is the gas profile of a block being finalized.
+type blockGasProfile struct {
+	height  int64
+	txCount uint64
+	gasUsed uint64
+	fees    sdk.Coins
+	msgs    map[string]*gasprofile.MsgGasUsage
+	stores  map[string]uint64
+}
+
+func newGasProfiler(retainBlocks uint32) *gasProfiler {
+	return &gasProfiler{retainBlocks: int(retainBlocks)}
+}
+
+// beginBlock starts recording the gas profile of the block at the given height.
+func (p *gasProfiler) beginBlock(height int64) {
+	p.current = &blockGasProfile{
+		height: height,
+		msgs:   make(map[string]*gasprofile.MsgGasUsage),
+		stores: make(map[string]uint64),
+	}
+}
+
+// TrackStoreGas implements the sdk.StoreGasTracker interface.
+func (p *gasProfiler) TrackStoreGas(storeName string, gas storetypes.Gas) {
+	if p.current == nil {
+		return
+	}
+
+	p.current.stores[storeName] += gas
+}
+
+// trackTx records the gas consumed by a transaction of the block, and the fees
+// it paid if any.
+func (p *gasProfiler) trackTx(tx sdk.Tx, gasUsed uint64) {
+	if p.current == nil {
+		return
+	}
+
+	p.current.txCount++
+	p.current.gasUsed += gasUsed
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		p.current.fees = p.current.fees.Add(feeTx.GetFee()...)
+	}
+}
+
+// trackMsg records the gas consumed by the execution of a message of the block.
+func (p *gasProfiler) trackMsg(msg sdk.Msg, gasUsed uint64) {
+	if p.current == nil {
+		return
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	usage, ok := p.current.msgs[typeURL]
+	if !ok {
+		usage = &gasprofile.MsgGasUsage{TypeUrl: typeURL}
+		p.current.msgs[typeURL] = usage
+	}
+
+	usage.Count++
+	usage.GasUsed += gasUsed
+}
+
+// endBlock stops recording the gas profile of the block being finalized, keeps
+// it in memory, reports it to telemetry and returns its events.
+func (p *gasProfiler) endBlock() []abci.Event {
+	if p.current == nil {
+		return nil
+	}
+
+	profile := gasprofile.BlockGasProfile{
+		Height:  p.current.height,
+		TxCount: p.current.txCount,
+		GasUsed: p.current.gasUsed,
+		Fees:    p.current.fees,
+	}
+	for _, usage := range p.current.msgs {
+		profile.Msgs = append(profile.Msgs, *usage)
+	}
+	sort.Slice(profile.Msgs, func(i, j int) bool { return profile.Msgs[i].TypeUrl < profile.Msgs[j].TypeUrl })
+	for store, gasUsed := range p.current.stores {
+		profile.Stores = append(profile.Stores, gasprofile.StoreGasUsage{Store: store, GasUsed: gasUsed})
+	}
+	sort.Slice(profile.Stores, func(i, j int) bool { return profile.Stores[i].Store < profile.Stores[j].Store })
+	p.current = nil
+
+	p.mtx.Lock()
+	p.profiles = append(p.profiles, profile)
+	if len(p.profiles) > p.retainBlocks {
+		p.profiles = p.profiles[len(p.profiles)-p.retainBlocks:]
+	}
+	p.mtx.Unlock()
+
+	emitGasProfileTelemetry(profile)
+
+	return gasProfileEvents(profile)
+}
+
+// lastProfiles returns at most limit of the last profiles, most recent first.
+// All the profiles are returned if limit is 0.
+func (p *gasProfiler) lastProfiles(limit uint32) []gasprofile.BlockGasProfile {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	n := len(p.profiles)
+	if limit > 0 && int(limit) < n {
+		n = int(limit)
+	}
+
+	profiles := make([]gasprofile.BlockGasProfile, 0, n)
+	for i := len(p.profiles) - 1; i >= len(p.profiles)-n; i-- {
+		profiles = append(profiles, p.profiles[i])
+	}
+
+	return profiles
+}
+
+func gasProfileEvents(profile gasprofile.BlockGasProfile) []abci.Event {
+	events := sdk.Events{
+		sdk.NewEvent(
+			EventTypeGasProfile,
+			sdk.NewAttribute(AttributeKeyTxCount, strconv.FormatUint(profile.TxCount, 10)),
+			sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(profile.GasUsed, 10)),
+			sdk.NewAttribute(AttributeKeyFees, profile.Fees.String()),
+		),
+	}
+	for _, usage := range profile.Msgs {
+		events = events.AppendEvent(sdk.NewEvent(
+			EventTypeMsgGasProfile,
+			sdk.NewAttribute(AttributeKeyTypeURL, usage.TypeUrl),
+			sdk.NewAttribute(AttributeKeyCount, strconv.FormatUint(usage.Count, 10)),
+			sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(usage.GasUsed, 10)),
+		))
+	}
+	for _, usage := range profile.Stores {
+		events = events.AppendEvent(sdk.NewEvent(
+			EventTypeStoreGasProfile,
+			sdk.NewAttribute(AttributeKeyStore, usage.Store),
+			sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(usage.GasUsed, 10)),
+		))
+	}
+
+	return events.ToABCIEvents()
+}
+
+func emitGasProfileTelemetry(profile gasprofile.BlockGasProfile) {
+	telemetry.SetGauge(float32(profile.GasUsed), "block", "gas_profile", "gas_used")
+	telemetry.SetGauge(float32(profile.TxCount), "block", "gas_profile", "tx_count")
+	for _, usage := range profile.Msgs {
+		telemetry.SetGaugeWithLabels(
+			[]string{"block", "gas_profile", "msg", "gas_used"},
+			float32(usage.GasUsed),
+			[]metrics.Label{telemetry.NewLabel("type_url", usage.TypeUrl)},
+		)
+	}
+	for _, usage := range profile.Stores {
+		telemetry.SetGaugeWithLabels(
+			[]string{"block", "gas_profile", "store", "gas_used"},
+			float32(usage.GasUsed),
+			[]metrics.Label{telemetry.NewLabel("store", usage.Store)},
+		)
+	}
+}
+
+// gasProfileQueryServer implements the gasprofile.QueryServer interface with
+// the gas profiles recorded by a gasProfiler.
+type gasProfileQueryServer struct {
+	profiler *gasProfiler
+}
+
+var _ gasprofile.QueryServer = gasProfileQueryServer{}
+
+// GasProfiles implements the gasprofile.QueryServer interface.
+func (s gasProfileQueryServer) GasProfiles(_ context.Context, req *gasprofile.QueryGasProfilesRequest) (*gasprofile.QueryGasProfilesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &gasprofile.QueryGasProfilesResponse{Profiles: s.profiler.lastProfiles(req.Limit)}, nil
+}
@@ -46,6 +46,17 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		// Get the query gas limit header from the request context, if present.
+		var gasLimit uint64
+		if gasLimitHeaders := md.Get(grpctypes.GRPCQueryGasLimitHeader); len(gasLimitHeaders) == 1 {
+			gasLimit, err = strconv.ParseUint(gasLimitHeaders[0], 10, 64)
+			if err != nil {
+				return nil, errorsmod.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"Baseapp.RegisterGRPCServer: invalid gas limit header %q: %v", grpctypes.GRPCQueryGasLimitHeader, err)
+			}
+		}
+
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
 		sdkCtx, err := app.CreateQueryContext(height, false)
@@ -53,6 +64,11 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			return nil, err
 		}
 
+		// A client may only lower the gas limit configured on the node.
+		if gasLimit > 0 && (app.queryGasLimit == 0 || gasLimit < app.queryGasLimit) {
+			sdkCtx = sdkCtx.WithGasMeter(newQueryGasMeter(gasLimit))
+		}
+
 		// Add relevant gRPC headers
 		if height == 0 {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
@@ -61,12 +77,19 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		// Attach the sdk.Context into the gRPC's context.Context.
 		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
 
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
-		if err = grpc.SetHeader(grpcCtx, md); err != nil {
+		resp, err = runQueryWithGasLimit(sdkCtx, func() (interface{}, error) {
+			return handler(grpcCtx, req)
+		})
+
+		md = metadata.Pairs(
+			grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10),
+			grpctypes.GRPCQueryGasUsedHeader, strconv.FormatUint(sdkCtx.GasMeter().GasConsumed(), 10),
+		)
+		if err := grpc.SetHeader(grpcCtx, md); err != nil {
 			app.logger.Error("failed to set gRPC header", "err", err)
 		}
 
-		return handler(grpcCtx, req)
+		return resp, err
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
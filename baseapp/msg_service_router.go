@@ -38,6 +38,8 @@ func NewMsgServiceRouter() *MsgServiceRouter {
 	}
 }
 
+// SetCircuit sets the circuit breaker checked on every message dispatch. If it
+// implements MsgCircuitBreaker, the messages are checked with IsMsgAllowed.
 func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
 	msr.circuitBreaker = cb
 }
@@ -133,16 +135,8 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				}
 			}
 
-			if msr.circuitBreaker != nil {
-				msgURL := sdk.MsgTypeURL(msg)
-				isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
-				if err != nil {
-					return nil, err
-				}
-
-				if !isAllowed {
-					return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
-				}
+			if err := msr.checkCircuit(ctx, msg); err != nil {
+				return nil, err
 			}
 
 			// Call the method handler from the service description with the handler object.
@@ -162,6 +156,36 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// checkCircuit returns an error if the circuit breaker disables the execution
+// of the message. It is called on every message dispatch, so that the messages
+// nested in other messages (e.g. authz MsgExec, group and gov proposals) are
+// checked as well as the messages of a transaction.
+func (msr *MsgServiceRouter) checkCircuit(ctx sdk.Context, msg sdk.Msg) error {
+	if msr.circuitBreaker == nil {
+		return nil
+	}
+
+	var (
+		isAllowed bool
+		err       error
+	)
+	msgURL := sdk.MsgTypeURL(msg)
+	if cb, ok := msr.circuitBreaker.(MsgCircuitBreaker); ok {
+		isAllowed, err = cb.IsMsgAllowed(ctx, msg)
+	} else {
+		isAllowed, err = msr.circuitBreaker.IsAllowed(ctx, msgURL)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !isAllowed {
+		return fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
+	}
+
+	return nil
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -5,17 +5,20 @@ import (
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -165,3 +168,66 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code, "res=%+v", res)
 }
+
+type mockCircuitBreaker struct {
+	disabledURLs map[string]bool
+}
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb.disabledURLs[typeURL], nil
+}
+
+type mockMsgCircuitBreaker struct {
+	mockCircuitBreaker
+	disabledDogs map[string]bool
+}
+
+func (cb mockMsgCircuitBreaker) IsMsgAllowed(_ context.Context, msg sdk.Msg) (bool, error) {
+	if m, ok := msg.(*testdata.MsgCreateDog); ok && cb.disabledDogs[m.Dog.Name] {
+		return false, nil
+	}
+
+	return cb.IsAllowed(context.Background(), sdk.MsgTypeURL(msg))
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(registry)
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	msgURL := sdk.MsgTypeURL(&testdata.MsgCreateDog{})
+	newMsg := func(name string) sdk.Msg {
+		return &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: name}}
+	}
+
+	testCases := []struct {
+		name   string
+		cb     baseapp.CircuitBreaker
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"no circuit breaker", nil, newMsg("Spot"), false},
+		{"allowed type url", mockCircuitBreaker{}, newMsg("Spot"), false},
+		{"disabled type url", mockCircuitBreaker{disabledURLs: map[string]bool{msgURL: true}}, newMsg("Spot"), true},
+		{"allowed msg", mockMsgCircuitBreaker{disabledDogs: map[string]bool{"Rex": true}}, newMsg("Spot"), false},
+		{"disabled msg", mockMsgCircuitBreaker{disabledDogs: map[string]bool{"Rex": true}}, newMsg("Rex"), true},
+		{"disabled type url of msg circuit breaker", mockMsgCircuitBreaker{mockCircuitBreaker: mockCircuitBreaker{disabledURLs: map[string]bool{msgURL: true}}}, newMsg("Spot"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router.SetCircuit(tc.cb)
+
+			_, err := router.Handler(tc.msg)(ctx, tc.msg)
+			if tc.expErr {
+				require.ErrorContains(t, err, "circuit breaker disables execution of this message: "+msgURL)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -52,6 +52,19 @@ func SetMinRetainBlocks(minRetainBlocks uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinRetainBlocks(minRetainBlocks) }
 }
 
+// SetQueryGasLimit returns a BaseApp option function that sets the maximum gas
+// a single query may consume. A limit of 0 means queries are not gas limited.
+func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setQueryGasLimit(queryGasLimit) }
+}
+
+// SetGasProfileBlocks returns a BaseApp option function that enables recording
+// the gas profiles of the finalized blocks, keeping the profiles of the given
+// number of last blocks in memory. A value of 0 disables gas profiling.
+func SetGasProfileBlocks(gasProfileBlocks uint32) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setGasProfileBlocks(gasProfileBlocks) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -179,6 +192,16 @@ func (app *BaseApp) SetPrecommiter(precommiter sdk.Precommiter) {
 	app.precommiter = precommiter
 }
 
+// SetMsgEventHandler sets the handler of the events emitted by each message,
+// e.g. to dispatch typed events to the handlers registered by other modules.
+func (app *BaseApp) SetMsgEventHandler(msgEventHandler sdk.MsgEventHandler) {
+	if app.sealed {
+		panic("SetMsgEventHandler() on sealed BaseApp")
+	}
+
+	app.msgEventHandler = msgEventHandler
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
@@ -326,3 +349,9 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
+
+// AddABCIListener adds an ABCIListener to the BaseApp's streaming manager,
+// alongside the listeners already registered.
+func (app *BaseApp) AddABCIListener(listener storetypes.ABCIListener) {
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listener)
+}
@@ -0,0 +1,304 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RegisterCollectionsService registers the collections gRPC query service on
+// the provided gRPC router. The schemas map the store key names to the
+// collections schemas of the modules.
+func RegisterCollectionsService(server gogogrpc.Server, schemas map[string]collections.Schema) {
+	RegisterQueryServer(server, NewQueryServer(schemas))
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	schemas map[string]collections.Schema
+}
+
+// NewQueryServer returns a new collections gRPC query server, querying the
+// given schemas by store key name.
+func NewQueryServer(schemas map[string]collections.Schema) QueryServer {
+	return queryServer{
+		schemas: schemas,
+	}
+}
+
+// Collections implements the QueryServer.Collections method.
+func (s queryServer) Collections(_ context.Context, req *QueryCollectionsRequest) (*QueryCollectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, err := s.schema(req.Store)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &QueryCollectionsResponse{}
+	for _, coll := range schema.ListCollections() {
+		descriptor := &CollectionDescriptor{
+			Name:      coll.GetName(),
+			Prefix:    coll.GetPrefix(),
+			KeyType:   coll.KeyCodec().KeyType(),
+			ValueType: coll.ValueCodec().ValueType(),
+		}
+
+		if info, ok := schema.LookupIndex(coll.GetName()); ok {
+			descriptor.Indexed = info.Indexed
+		}
+
+		res.Collections = append(res.Collections, descriptor)
+	}
+
+	return res, nil
+}
+
+// Get implements the QueryServer.Get method.
+func (s queryServer) Get(ctx context.Context, req *QueryGetRequest) (*QueryGetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, coll, err := s.collection(req.Store, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := encodeJSONKey(coll, req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := getEntry(ctx, schema, coll, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryGetResponse{Entry: entry}, nil
+}
+
+// Entries implements the QueryServer.Entries method.
+func (s queryServer) Entries(ctx context.Context, req *QueryEntriesRequest) (*QueryEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, coll, err := s.collection(req.Store, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyPrefix []byte
+	if req.Prefix != "" {
+		if keyPrefix, err = coll.KeyCodec().EncodeJSONPrefix([]byte(req.Prefix)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid prefix: %s", err)
+		}
+	}
+
+	res := &QueryEntriesResponse{}
+	res.Pagination, err = paginate(ctx, schema, coll, keyPrefix, req.Pagination, func(key, value []byte) error {
+		entry, err := decodeEntry(coll, key, value)
+		if err != nil {
+			return err
+		}
+
+		res.Entries = append(res.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ByIndex implements the QueryServer.ByIndex method.
+func (s queryServer) ByIndex(ctx context.Context, req *QueryByIndexRequest) (*QueryByIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, index, err := s.collection(req.Store, req.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	info, ok := schema.LookupIndex(req.Index)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "collection %s is not an index", req.Index)
+	}
+
+	indexed, err := schema.LookupCollection(info.Indexed)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Multipart index keys are matched by their first part, the reference key,
+	// other index keys are matched exactly.
+	keyPrefix, err := index.KeyCodec().EncodeJSONPrefix([]byte(req.Key))
+	if errors.Is(err, collections.ErrEncoding) {
+		keyPrefix, err = encodeJSONKey(index, req.Key)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid index key: %s", err)
+	}
+
+	res := &QueryByIndexResponse{}
+	res.Pagination, err = paginate(ctx, schema, index, keyPrefix, req.Pagination, func(key, value []byte) error {
+		indexKey, err := index.KeyCodec().Decode(key)
+		if err != nil {
+			return err
+		}
+
+		indexValue, err := index.ValueCodec().Decode(value)
+		if err != nil {
+			return err
+		}
+
+		pk, err := info.PrimaryKey(indexKey, indexValue)
+		if err != nil {
+			return err
+		}
+
+		pkBytes, err := indexed.KeyCodec().Encode(pk)
+		if err != nil {
+			return err
+		}
+
+		entry, err := getEntry(ctx, schema, indexed, pkBytes)
+		if err != nil {
+			return err
+		}
+
+		res.Entries = append(res.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// schema returns the schema of the given store.
+func (s queryServer) schema(store string) (collections.Schema, error) {
+	schema, ok := s.schemas[store]
+	if !ok {
+		return collections.Schema{}, status.Errorf(codes.NotFound, "no collections schema registered for store %s", store)
+	}
+
+	return schema, nil
+}
+
+// collection returns the schema of the given store and its collection with
+// the given name.
+func (s queryServer) collection(store, name string) (collections.Schema, collections.Collection, error) {
+	schema, err := s.schema(store)
+	if err != nil {
+		return collections.Schema{}, nil, err
+	}
+
+	coll, err := schema.LookupCollection(name)
+	if err != nil {
+		return collections.Schema{}, nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return schema, coll, nil
+}
+
+// encodeJSONKey returns the binary representation of a JSON encoded key.
+func encodeJSONKey(coll collections.Collection, jsonKey string) ([]byte, error) {
+	key, err := coll.KeyCodec().DecodeJSON([]byte(jsonKey))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid key: %s", err)
+	}
+
+	bz, err := coll.KeyCodec().Encode(key)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid key: %s", err)
+	}
+
+	return bz, nil
+}
+
+// getEntry returns the entry of a collection with the given binary key.
+func getEntry(ctx context.Context, schema collections.Schema, coll collections.Collection, key []byte) (*Entry, error) {
+	value, err := schema.OpenKVStore(ctx).Get(append(append([]byte{}, coll.GetPrefix()...), key...))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if value == nil {
+		return nil, status.Errorf(codes.NotFound, "%s: %s", collections.ErrNotFound, coll.GetName())
+	}
+
+	return decodeEntry(coll, key, value)
+}
+
+// decodeEntry decodes the binary key and value of an entry of a collection,
+// and returns them JSON encoded.
+func decodeEntry(coll collections.Collection, key, value []byte) (*Entry, error) {
+	k, err := coll.KeyCodec().Decode(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key of %s: %w", coll.GetName(), err)
+	}
+
+	jsonKey, err := coll.KeyCodec().EncodeJSON(k)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := coll.ValueCodec().Decode(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode value of %s: %w", coll.GetName(), err)
+	}
+
+	jsonValue, err := coll.ValueCodec().EncodeJSON(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{Key: string(jsonKey), Value: string(jsonValue)}, nil
+}
+
+// paginate paginates over the entries of a collection whose binary key starts
+// with the given prefix. The onResult callback is given the full binary key of
+// the entries, without the collection prefix.
+func paginate(
+	ctx context.Context,
+	schema collections.Schema,
+	coll collections.Collection,
+	keyPrefix []byte,
+	pageReq *query.PageRequest,
+	onResult func(key, value []byte) error,
+) (*query.PageResponse, error) {
+	store := runtime.KVStoreAdapter(schema.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, append(append([]byte{}, coll.GetPrefix()...), keyPrefix...))
+
+	pageRes, err := query.Paginate(prefixStore, pageReq, func(key, value []byte) error {
+		return onResult(append(append([]byte{}, keyPrefix...), key...), value)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return pageRes, nil
+}
@@ -0,0 +1,175 @@
+package collections_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/collections/indexes"
+
+	collectionsservice "github.com/cosmos/cosmos-sdk/client/grpc/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+type account struct {
+	Owner  string
+	Number uint64
+}
+
+type accountIndexes struct {
+	Owner  *indexes.Multi[string, collections.Pair[string, uint64], account]
+	Number *indexes.Unique[uint64, collections.Pair[string, uint64], account]
+}
+
+func (a accountIndexes) IndexesList() []collections.Index[collections.Pair[string, uint64], account] {
+	return []collections.Index[collections.Pair[string, uint64], account]{a.Owner, a.Number}
+}
+
+func setupServer(t *testing.T) (collectionsservice.QueryServer, context.Context) {
+	t.Helper()
+
+	sk, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(sk)
+
+	pairKey := collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+	accounts := collections.NewIndexedMap(sb, collections.NewPrefix(0), "accounts", pairKey, colltest.MockValueCodec[account](),
+		accountIndexes{
+			Owner: indexes.NewMulti(sb, collections.NewPrefix(1), "accounts_by_owner", collections.StringKey, pairKey, func(_ collections.Pair[string, uint64], value account) (string, error) {
+				return value.Owner, nil
+			}),
+			Number: indexes.NewUnique(sb, collections.NewPrefix(2), "accounts_by_number", collections.Uint64Key, pairKey, func(_ collections.Pair[string, uint64], value account) (uint64, error) {
+				return value.Number, nil
+			}),
+		},
+	)
+	params := collections.NewItem(sb, collections.NewPrefix(3), "params", collections.Uint64Value)
+
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, params.Set(ctx, 10))
+	for _, acc := range []struct {
+		region string
+		account
+	}{
+		{"eu", account{Owner: "alice", Number: 1}},
+		{"eu", account{Owner: "bob", Number: 2}},
+		{"us", account{Owner: "alice", Number: 3}},
+	} {
+		require.NoError(t, accounts.Set(ctx, collections.Join(acc.region, acc.Number), acc.account))
+	}
+
+	return collectionsservice.NewQueryServer(map[string]collections.Schema{"test": schema}), ctx
+}
+
+// mockJSON returns the JSON encoding of an account by the mock value codec.
+func mockJSON(value string) string {
+	return `{"type_name":"github.com/cosmos/cosmos-sdk/client/grpc/collections_test.account","value":` + value + `}`
+}
+
+func keys(entries []*collectionsservice.Entry) []string {
+	res := make([]string, len(entries))
+	for i, entry := range entries {
+		res[i] = entry.Key
+	}
+
+	return res
+}
+
+func TestCollections(t *testing.T) {
+	server, ctx := setupServer(t)
+
+	res, err := server.Collections(ctx, &collectionsservice.QueryCollectionsRequest{Store: "test"})
+	require.NoError(t, err)
+	require.Len(t, res.Collections, 4)
+
+	require.Equal(t, "accounts", res.Collections[0].Name)
+	require.Equal(t, []byte{0}, res.Collections[0].Prefix)
+	require.Equal(t, "Pair[string, uint64]", res.Collections[0].KeyType)
+	require.Empty(t, res.Collections[0].Indexed)
+	require.Equal(t, "accounts_by_number", res.Collections[1].Name)
+	require.Equal(t, "accounts", res.Collections[1].Indexed)
+	require.Equal(t, "params", res.Collections[3].Name)
+	require.Equal(t, "uint64", res.Collections[3].ValueType)
+
+	_, err = server.Collections(ctx, &collectionsservice.QueryCollectionsRequest{Store: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGet(t *testing.T) {
+	server, ctx := setupServer(t)
+
+	res, err := server.Get(ctx, &collectionsservice.QueryGetRequest{Store: "test", Collection: "accounts", Key: `["eu","2"]`})
+	require.NoError(t, err)
+	require.Equal(t, `["eu","2"]`, res.Entry.Key)
+	require.JSONEq(t, mockJSON(`{"Owner":"bob","Number":2}`), res.Entry.Value)
+
+	res, err = server.Get(ctx, &collectionsservice.QueryGetRequest{Store: "test", Collection: "params", Key: `"item"`})
+	require.NoError(t, err)
+	require.Equal(t, `"10"`, res.Entry.Value)
+
+	_, err = server.Get(ctx, &collectionsservice.QueryGetRequest{Store: "test", Collection: "accounts", Key: `["us","2"]`})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.Get(ctx, &collectionsservice.QueryGetRequest{Store: "test", Collection: "accounts", Key: `"eu"`})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.Get(ctx, &collectionsservice.QueryGetRequest{Store: "test", Collection: "unknown", Key: `"item"`})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestEntries(t *testing.T) {
+	server, ctx := setupServer(t)
+
+	res, err := server.Entries(ctx, &collectionsservice.QueryEntriesRequest{Store: "test", Collection: "accounts"})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["eu","1"]`, `["eu","2"]`, `["us","3"]`}, keys(res.Entries))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = server.Entries(ctx, &collectionsservice.QueryEntriesRequest{
+		Store:      "test",
+		Collection: "accounts",
+		Prefix:     `"eu"`,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["eu","1"]`}, keys(res.Entries))
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = server.Entries(ctx, &collectionsservice.QueryEntriesRequest{
+		Store:      "test",
+		Collection: "accounts",
+		Prefix:     `"eu"`,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["eu","2"]`}, keys(res.Entries))
+
+	_, err = server.Entries(ctx, &collectionsservice.QueryEntriesRequest{Store: "test", Collection: "accounts_by_number", Prefix: `"1"`})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestByIndex(t *testing.T) {
+	server, ctx := setupServer(t)
+
+	res, err := server.ByIndex(ctx, &collectionsservice.QueryByIndexRequest{Store: "test", Index: "accounts_by_owner", Key: `"alice"`})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["eu","1"]`, `["us","3"]`}, keys(res.Entries))
+	require.JSONEq(t, mockJSON(`{"Owner":"alice","Number":3}`), res.Entries[1].Value)
+
+	res, err = server.ByIndex(ctx, &collectionsservice.QueryByIndexRequest{Store: "test", Index: "accounts_by_number", Key: `"2"`})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["eu","2"]`}, keys(res.Entries))
+
+	res, err = server.ByIndex(ctx, &collectionsservice.QueryByIndexRequest{Store: "test", Index: "accounts_by_owner", Key: `"carol"`})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	_, err = server.ByIndex(ctx, &collectionsservice.QueryByIndexRequest{Store: "test", Index: "accounts", Key: `"eu"`})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
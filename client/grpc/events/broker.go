@@ -0,0 +1,262 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBufferSize is the default number of events buffered per subscriber.
+const DefaultBufferSize = 1000
+
+// ErrSubscriberTooSlow is returned to subscribers which do not consume their
+// events fast enough, and are disconnected in order not to slow down the node.
+var ErrSubscriberTooSlow = errors.New("events subscriber too slow, its buffer is full")
+
+var _ storetypes.ABCIListener = (*Broker)(nil)
+
+// Broker dispatches the typed events of committed blocks to subscribers. It
+// implements the ABCIListener interface: the events of a block are gathered
+// when it is finalized, and dispatched once it is committed.
+type Broker struct {
+	txDecoder  sdk.TxDecoder
+	bufferSize int
+
+	mtx         sync.Mutex
+	subscribers map[*Subscription]struct{}
+	pending     []pendingEvent
+}
+
+// pendingEvent is a typed event of the block being finalized, waiting for the
+// block to be committed.
+type pendingEvent struct {
+	event abci.Event
+	res   *SubscribeResponse
+}
+
+// Subscription is a subscription to the typed events matching a request.
+type Subscription struct {
+	req *SubscribeRequest
+	out chan *SubscribeResponse
+	err error
+}
+
+// Out returns the channel on which the subscribed events are sent. The channel
+// is closed when the subscription is canceled by the broker, in which case
+// Err returns the reason.
+func (s *Subscription) Out() <-chan *SubscribeResponse {
+	return s.out
+}
+
+// Err returns the reason for which the broker canceled the subscription, if any.
+// It must only be called once the Out channel is closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// NewBroker returns a new events broker. The tx decoder is used to match the
+// transactions of a block with their results, which only exist for the
+// transactions the application was able to decode. A bufferSize of 0 defaults
+// to DefaultBufferSize.
+func NewBroker(txDecoder sdk.TxDecoder, bufferSize int) *Broker {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	return &Broker{
+		txDecoder:   txDecoder,
+		bufferSize:  bufferSize,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe subscribes to the typed events matching the given request.
+func (b *Broker) Subscribe(req *SubscribeRequest) *Subscription {
+	if req == nil {
+		req = &SubscribeRequest{}
+	}
+
+	sub := &Subscription{
+		req: req,
+		out: make(chan *SubscribeResponse, b.bufferSize),
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe cancels the given subscription. It is a no-op if the subscription
+// was already canceled.
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.cancel(sub, nil)
+}
+
+// cancel removes a subscription and closes its channel. It must be called with
+// the lock held.
+func (b *Broker) cancel(sub *Subscription, err error) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(b.subscribers, sub)
+	sub.err = err
+	close(sub.out)
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface. It decodes the
+// typed events of the finalized block, to be dispatched once it is committed.
+func (b *Broker) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.pending = nil
+	if len(b.subscribers) == 0 {
+		return nil
+	}
+
+	b.addPending(req.Height, "", res.Events)
+
+	txHashes := b.txHashes(req.Txs)
+	for i, txResult := range res.TxResults {
+		if txResult == nil {
+			continue
+		}
+
+		var txHash string
+		if i < len(txHashes) {
+			txHash = txHashes[i]
+		}
+
+		b.addPending(req.Height, txHash, txResult.Events)
+	}
+
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. It dispatches the typed
+// events of the committed block to the matching subscribers.
+func (b *Broker) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, p := range b.pending {
+		for sub := range b.subscribers {
+			if !matches(sub.req, p.event) {
+				continue
+			}
+
+			select {
+			case sub.out <- p.res:
+			default:
+				b.cancel(sub, ErrSubscriberTooSlow)
+			}
+		}
+	}
+
+	b.pending = nil
+	return nil
+}
+
+// addPending decodes the typed events among the given events and adds them to
+// the pending events. Events which are not typed events are skipped.
+func (b *Broker) addPending(height int64, txHash string, events []abci.Event) {
+	for _, event := range events {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			continue
+		}
+
+		b.pending = append(b.pending, pendingEvent{
+			event: event,
+			res: &SubscribeResponse{
+				Height: height,
+				TxHash: txHash,
+				Event:  any,
+			},
+		})
+	}
+}
+
+// txHashes returns the hashes of the transactions having a result, i.e. the
+// ones the application was able to decode, in the order of their results.
+func (b *Broker) txHashes(txs [][]byte) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		if b.txDecoder != nil {
+			if _, err := b.txDecoder(tx); err != nil {
+				continue
+			}
+		}
+
+		hashes = append(hashes, fmt.Sprintf("%X", cmttypes.Tx(tx).Hash()))
+	}
+
+	return hashes
+}
+
+// matches returns true if the given event matches the filters of a request.
+func matches(req *SubscribeRequest, event abci.Event) bool {
+	if len(req.TypeUrls) > 0 {
+		found := false
+		for _, typeURL := range req.TypeUrls {
+			if typeURL == "/"+event.Type {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	for _, filter := range req.Attributes {
+		if filter == nil {
+			continue
+		}
+
+		found := false
+		for _, attr := range event.Attributes {
+			if attr.Key == filter.Key && attributeValue(attr.Value) == filter.Value {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// attributeValue returns the value of a typed event attribute, which is JSON
+// encoded, without its quotes if it is a string.
+func attributeValue(value string) string {
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		return unquoted
+	}
+
+	return value
+}
@@ -0,0 +1,116 @@
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/events"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func typedEvent(t *testing.T, dog *testdata.Dog) abci.Event {
+	t.Helper()
+
+	event, err := sdk.TypedEventToEvent(dog)
+	require.NoError(t, err)
+
+	return abci.Event(event)
+}
+
+func finalizeAndCommit(t *testing.T, broker *events.Broker, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) {
+	t.Helper()
+
+	require.NoError(t, broker.ListenFinalizeBlock(context.Background(), req, res))
+	require.NoError(t, broker.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestBroker(t *testing.T) {
+	broker := events.NewBroker(nil, 10)
+
+	all := broker.Subscribe(nil)
+	bigDogs := broker.Subscribe(&events.SubscribeRequest{
+		TypeUrls:   []string{"/testpb.Dog"},
+		Attributes: []*events.AttributeFilter{{Key: "size", Value: "big"}},
+	})
+	cats := broker.Subscribe(&events.SubscribeRequest{
+		TypeUrls: []string{"/testpb.Cat"},
+	})
+
+	tx := []byte("tx")
+	finalizeAndCommit(t, broker,
+		abci.RequestFinalizeBlock{Height: 3, Txs: [][]byte{tx}},
+		abci.ResponseFinalizeBlock{
+			Events: []abci.Event{
+				typedEvent(t, &testdata.Dog{Name: "spot", Size_: "big"}),
+				{Type: "message", Attributes: []abci.EventAttribute{{Key: "sender", Value: "foo"}}},
+			},
+			TxResults: []*abci.ExecTxResult{{
+				Events: []abci.Event{typedEvent(t, &testdata.Dog{Name: "rex", Size_: "small"})},
+			}},
+		},
+	)
+
+	// untyped events are skipped
+	require.Len(t, all.Out(), 2)
+	res := <-all.Out()
+	require.Equal(t, int64(3), res.Height)
+	require.Empty(t, res.TxHash)
+	require.Equal(t, &testdata.Dog{Name: "spot", Size_: "big"}, res.Event.GetCachedValue())
+
+	res = <-all.Out()
+	require.Equal(t, fmt.Sprintf("%X", cmttypes.Tx(tx).Hash()), res.TxHash)
+	require.Equal(t, &testdata.Dog{Name: "rex", Size_: "small"}, res.Event.GetCachedValue())
+
+	require.Len(t, bigDogs.Out(), 1)
+	res = <-bigDogs.Out()
+	require.Equal(t, &testdata.Dog{Name: "spot", Size_: "big"}, res.Event.GetCachedValue())
+
+	require.Len(t, cats.Out(), 0)
+
+	// unsubscribing closes the subscription without error
+	broker.Unsubscribe(cats)
+	_, ok := <-cats.Out()
+	require.False(t, ok)
+	require.NoError(t, cats.Err())
+}
+
+func TestBroker_SlowSubscriber(t *testing.T) {
+	broker := events.NewBroker(nil, 1)
+	sub := broker.Subscribe(nil)
+
+	finalizeAndCommit(t, broker,
+		abci.RequestFinalizeBlock{Height: 1},
+		abci.ResponseFinalizeBlock{
+			Events: []abci.Event{
+				typedEvent(t, &testdata.Dog{Name: "spot"}),
+				typedEvent(t, &testdata.Dog{Name: "rex"}),
+			},
+		},
+	)
+
+	_, ok := <-sub.Out()
+	require.True(t, ok)
+	_, ok = <-sub.Out()
+	require.False(t, ok)
+	require.ErrorIs(t, sub.Err(), events.ErrSubscriberTooSlow)
+}
+
+func TestBroker_NoSubscriber(t *testing.T) {
+	broker := events.NewBroker(nil, 1)
+
+	// events of blocks finalized before subscribing are not dispatched
+	require.NoError(t, broker.ListenFinalizeBlock(context.Background(),
+		abci.RequestFinalizeBlock{Height: 1},
+		abci.ResponseFinalizeBlock{Events: []abci.Event{typedEvent(t, &testdata.Dog{Name: "spot"})}},
+	))
+
+	sub := broker.Subscribe(nil)
+	require.NoError(t, broker.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	require.Len(t, sub.Out(), 0)
+}
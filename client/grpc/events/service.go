@@ -0,0 +1,61 @@
+package events
+
+import (
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterEventsService registers the events gRPC service on the provided gRPC
+// router. It returns the ABCIListener feeding the service, which must be added
+// to the application's streaming manager.
+func RegisterEventsService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) storetypes.ABCIListener {
+	var txDecoder sdk.TxDecoder
+	if clientCtx.TxConfig != nil {
+		txDecoder = clientCtx.TxConfig.TxDecoder()
+	}
+
+	broker := NewBroker(txDecoder, int(cfg.Events.BufferSize))
+	RegisterServiceServer(server, NewServiceServer(broker))
+	return broker
+}
+
+var _ ServiceServer = serviceServer{}
+
+type serviceServer struct {
+	broker *Broker
+}
+
+// NewServiceServer returns a new events gRPC service streaming the events
+// dispatched by the given broker.
+func NewServiceServer(broker *Broker) ServiceServer {
+	return serviceServer{
+		broker: broker,
+	}
+}
+
+// Subscribe implements the ServiceServer.Subscribe method.
+func (s serviceServer) Subscribe(req *SubscribeRequest, stream Service_SubscribeServer) error {
+	sub := s.broker.Subscribe(req)
+	defer s.broker.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case res, ok := <-sub.Out():
+			if !ok {
+				return sub.Err()
+			}
+
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,7 @@ const (
 	flagIndex       = "index"
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
+	flagAggregated  = "multisig-aggregated"
 	flagHDPath      = "hd-path"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
@@ -57,6 +59,8 @@ multisig transactions.
 You can create and store a multisig key by passing the list of key names stored in a keyring
 and the minimum number of signatures required through --multisig-threshold. The keys are
 sorted by address, unless the flag --nosort is set.
+With --multisig-aggregated, the keys must be bls12_381 keys and the multisig key verifies a
+single signature aggregated from the signatures of the signers.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
@@ -68,6 +72,7 @@ Example:
 	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
+	f.Bool(flagAggregated, false, "Construct a bls12_381 multisig key verifying an aggregated signature. For use in conjunction with --multisig")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
@@ -178,7 +183,14 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 				})
 			}
 
-			pk := multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			var pk cryptotypes.PubKey = multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			if aggregated, _ := cmd.Flags().GetBool(flagAggregated); aggregated {
+				pk, err = bls12_381.NewAggregatedPubKey(multisigThreshold, pks)
+				if err != nil {
+					return err
+				}
+			}
+
 			k, err := kb.SaveMultisig(name, pk)
 			if err != nil {
 				return err
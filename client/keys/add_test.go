@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -119,6 +120,92 @@ func Test_runAddCmdBasic(t *testing.T) {
 	require.Error(t, cmd.ExecuteContext(ctx))
 }
 
+func Test_runAddCmdKeyTypes(t *testing.T) {
+	mnemonic := "decide praise business actor peasant farm drastic weather extend front hurt later song give verb rhythm worry fun pond reform school tumble august one"
+
+	for _, keyType := range []hd.PubKeyType{hd.Secp256r1Type, hd.Ed25519Type, hd.Bls12_381Type} {
+		t.Run(string(keyType), func(t *testing.T) {
+			cmd := AddKeyCommand()
+			cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+			mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+			kbHome := t.TempDir()
+
+			cdc := moduletestutil.MakeTestEncodingConfig().Codec
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd.SetArgs([]string{
+				"keyname",
+				fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyType, keyType),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+				fmt.Sprintf("--%s=true", flagRecover),
+			})
+			mockIn.Reset(mnemonic + "\n")
+			require.NoError(t, cmd.ExecuteContext(ctx))
+
+			k, err := kb.Key("keyname")
+			require.NoError(t, err)
+			pubKey, err := k.GetPubKey()
+			require.NoError(t, err)
+			require.Equal(t, string(keyType), pubKey.Type())
+
+			// the key is derived from the mnemonic
+			algo, err := keyring.NewSigningAlgoFromString(string(keyType), keyring.SigningAlgoList{hd.Secp256r1, hd.Ed25519, hd.Bls12_381})
+			require.NoError(t, err)
+			_, err = kb.NewAccount("recovered", mnemonic, "", sdk.FullFundraiserPath, algo)
+			require.ErrorIs(t, err, keyring.ErrDuplicatedAddress)
+		})
+	}
+}
+
+func Test_runAddCmdMultisigAggregated(t *testing.T) {
+	kbHome := t.TempDir()
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	_, _, err = kb.NewMnemonic("bls1", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Bls12_381)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("bls2", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Bls12_381)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("secp256k1", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	runAddCmd := func(args ...string) error {
+		cmd := AddKeyCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+		clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			fmt.Sprintf("--%s=true", flagAggregated),
+		))
+
+		return cmd.ExecuteContext(ctx)
+	}
+
+	require.Error(t, runAddCmd("mixed", fmt.Sprintf("--%s=bls1,secp256k1", flagMultisig)))
+	require.NoError(t, runAddCmd("aggregated", fmt.Sprintf("--%s=bls1,bls2", flagMultisig), fmt.Sprintf("--%s=2", flagMultiSigThreshold)))
+
+	k, err := kb.Key("aggregated")
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	aggregated, ok := pubKey.(*bls12_381.AggregatedPubKey)
+	require.True(t, ok)
+	require.Equal(t, uint(2), aggregated.GetThreshold())
+	require.Len(t, aggregated.GetPubKeys(), 2)
+}
+
 func Test_runAddCmdDryRun(t *testing.T) {
 	pubkey1 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtObiFVE4s+9+RX5SP8TN9r2mxpoaT4eGj9CJfK7VRzN"}`
 	pubkey2 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A/se1vkqgdQ7VJQCM4mxN+L+ciGhnnJ4XYsQCRBMrdRi"}`
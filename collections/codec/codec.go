@@ -124,6 +124,46 @@ type UntypedValueCodec struct {
 	ValueType  func() string
 }
 
+// NewUntypedKeyCodec returns an UntypedKeyCodec for the provided KeyCodec.
+func NewUntypedKeyCodec[K any](k KeyCodec[K]) UntypedKeyCodec {
+	v := NewUntypedValueCodec(KeyToValueCodec(k))
+	return UntypedKeyCodec{
+		Decode:     v.Decode,
+		Encode:     v.Encode,
+		DecodeJSON: v.DecodeJSON,
+		EncodeJSON: v.EncodeJSON,
+		EncodeJSONPrefix: func(b []byte) ([]byte, error) {
+			prefixer, ok := k.(jsonPrefixEncoder)
+			if !ok {
+				return nil, fmt.Errorf("%w: key type %s does not support prefixes", ErrEncoding, k.KeyType())
+			}
+			return prefixer.EncodeJSONPrefix(b)
+		},
+		Stringify: v.Stringify,
+		KeyType:   k.KeyType,
+	}
+}
+
+// UntypedKeyCodec wraps a KeyCodec to expose an untyped API for encoding and decoding keys.
+// Encode and Decode operate on the full binary representation of the key.
+type UntypedKeyCodec struct {
+	Decode     func(b []byte) (interface{}, error)
+	Encode     func(key interface{}) ([]byte, error)
+	DecodeJSON func(b []byte) (interface{}, error)
+	EncodeJSON func(key interface{}) ([]byte, error)
+	// EncodeJSONPrefix encodes the JSON representation of the first part of a
+	// multipart key into the binary prefix shared by all the keys starting with it.
+	// It returns an error for keys which are not multipart.
+	EncodeJSONPrefix func(b []byte) ([]byte, error)
+	Stringify        func(key interface{}) (string, error)
+	KeyType          func() string
+}
+
+// jsonPrefixEncoder is implemented by the codecs of multipart keys.
+type jsonPrefixEncoder interface {
+	EncodeJSONPrefix(b []byte) ([]byte, error)
+}
+
 // KeyToValueCodec converts a KeyCodec into a ValueCodec.
 func KeyToValueCodec[K any](keyCodec KeyCodec[K]) ValueCodec[K] { return keyToValueCodec[K]{keyCodec} }
 
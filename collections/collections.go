@@ -89,9 +89,23 @@ type Collection interface {
 	// ValueCodec returns the codec used to encode/decode values of the collection.
 	ValueCodec() codec.UntypedValueCodec
 
+	// KeyCodec returns the codec used to encode/decode keys of the collection.
+	KeyCodec() codec.UntypedKeyCodec
+
 	genesisHandler
 }
 
+// UntypedIndex is implemented by the indexes of an IndexedMap whose entries can
+// be resolved to the primary keys they reference through an untyped API. It
+// allows clients to query an IndexedMap through the collections of its indexes.
+type UntypedIndex interface {
+	// GetName returns the name of the collection storing the index entries.
+	GetName() string
+	// UntypedPrimaryKey returns the primary key referenced by an entry of the
+	// index collection, given its decoded key and value.
+	UntypedPrimaryKey(key, value interface{}) (interface{}, error)
+}
+
 // Prefix defines a segregation bytes namespace for specific collections objects.
 type Prefix []byte
 
@@ -142,6 +156,10 @@ func (c collectionImpl[K, V]) ValueCodec() codec.UntypedValueCodec {
 	return codec.NewUntypedValueCodec(c.m.vc)
 }
 
+func (c collectionImpl[K, V]) KeyCodec() codec.UntypedKeyCodec {
+	return codec.NewUntypedKeyCodec(c.m.kc)
+}
+
 func (c collectionImpl[K, V]) GetName() string { return c.m.name }
 
 func (c collectionImpl[K, V]) GetPrefix() []byte { return NewPrefix(c.m.prefix) }
@@ -157,3 +175,15 @@ func (c collectionImpl[K, V]) exportGenesis(ctx context.Context, w io.Writer) er
 }
 
 func (c collectionImpl[K, V]) defaultGenesis(w io.Writer) error { return c.m.defaultGenesis(w) }
+
+func (c collectionImpl[K, V]) validateJSONEntry(entry jsonMapEntry) error {
+	return c.m.validateJSONEntry(entry)
+}
+
+func (c collectionImpl[K, V]) importJSONEntry(ctx context.Context, entry jsonMapEntry) error {
+	return c.m.importJSONEntry(ctx, entry)
+}
+
+func (c collectionImpl[K, V]) exportJSONEntries(ctx context.Context, onEntry func(entry jsonMapEntry) error) error {
+	return c.m.exportJSONEntries(ctx, onEntry)
+}
@@ -1,8 +1,10 @@
 package collections
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 )
@@ -12,6 +14,10 @@ type genesisHandler interface {
 	importGenesis(ctx context.Context, r io.Reader) error
 	exportGenesis(ctx context.Context, w io.Writer) error
 	defaultGenesis(w io.Writer) error
+
+	validateJSONEntry(entry jsonMapEntry) error
+	importJSONEntry(ctx context.Context, entry jsonMapEntry) error
+	exportJSONEntries(ctx context.Context, onEntry func(entry jsonMapEntry) error) error
 }
 
 type jsonMapEntry struct {
@@ -19,6 +25,13 @@ type jsonMapEntry struct {
 	Value json.RawMessage `json:"value,omitempty"`
 }
 
+// jsonSchemaEntry is a map entry of a collection of a schema, as encoded in
+// the NDJSON representation of the schema.
+type jsonSchemaEntry struct {
+	Collection string `json:"collection"`
+	jsonMapEntry
+}
+
 func (m Map[K, V]) validateGenesis(reader io.Reader) error {
 	return m.doDecodeJSON(reader, func(key K, value V) error {
 		return nil
@@ -37,24 +50,46 @@ func (m Map[K, V]) exportGenesis(ctx context.Context, writer io.Writer) error {
 		return err
 	}
 
-	it, err := m.Iterate(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer it.Close()
-
 	first := true
-	for ; it.Valid(); it.Next() {
+	err = m.exportJSONEntries(ctx, func(entry jsonMapEntry) error {
 		// add a comma before encoding the object
 		// for all objects besides the first one.
 		if !first {
-			_, err = writer.Write([]byte(","))
+			_, err := writer.Write([]byte(","))
 			if err != nil {
 				return err
 			}
 		}
 		first = false
 
+		bz, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(bz)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write([]byte("]"))
+	return err
+}
+
+func (m Map[K, V]) exportJSONEntries(ctx context.Context, onEntry func(entry jsonMapEntry) error) error {
+	it, err := m.Iterate(ctx, nil)
+	// an empty collection has no entries to export
+	if errors.Is(err, ErrInvalidIterator) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
 		key, err := it.Key()
 		if err != nil {
 			return err
@@ -75,26 +110,42 @@ func (m Map[K, V]) exportGenesis(ctx context.Context, writer io.Writer) error {
 			return err
 		}
 
-		entry := jsonMapEntry{
+		err = onEntry(jsonMapEntry{
 			Key:   keyBz,
 			Value: valueBz,
-		}
-
-		bz, err := json.Marshal(entry)
-		if err != nil {
-			return err
-		}
-
-		_, err = writer.Write(bz)
+		})
 		if err != nil {
 			return err
 		}
 	}
 
-	_, err = writer.Write([]byte("]"))
+	return nil
+}
+
+func (m Map[K, V]) validateJSONEntry(entry jsonMapEntry) error {
+	_, _, err := m.decodeJSONEntry(entry)
 	return err
 }
 
+func (m Map[K, V]) importJSONEntry(ctx context.Context, entry jsonMapEntry) error {
+	key, value, err := m.decodeJSONEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	return m.Set(ctx, key, value)
+}
+
+func (m Map[K, V]) decodeJSONEntry(entry jsonMapEntry) (key K, value V, err error) {
+	key, err = m.kc.DecodeJSON(entry.Key)
+	if err != nil {
+		return key, value, err
+	}
+
+	value, err = m.vc.DecodeJSON(entry.Value)
+	return key, value, err
+}
+
 func (m Map[K, V]) doDecodeJSON(reader io.Reader, onEntry func(key K, value V) error) error {
 	decoder := json.NewDecoder(reader)
 	token, err := decoder.Token()
@@ -119,12 +170,7 @@ func (m Map[K, V]) doDecodeJSON(reader io.Reader, onEntry func(key K, value V) e
 			return err
 		}
 
-		key, err := m.kc.DecodeJSON(mapEntry.Key)
-		if err != nil {
-			return err
-		}
-
-		value, err := m.vc.DecodeJSON(mapEntry.Value)
+		key, value, err := m.decodeJSONEntry(mapEntry)
 		if err != nil {
 			return err
 		}
@@ -151,3 +197,213 @@ func (m Map[K, V]) defaultGenesis(writer io.Writer) error {
 	_, err := writer.Write([]byte(`[]`))
 	return err
 }
+
+// DefaultJSON writes the default JSON representation of the schema, an object
+// mapping the name of every collection of the schema to an empty list.
+func (s Schema) DefaultJSON(w io.Writer) error {
+	return s.writeJSONObject(w, func(coll Collection, w io.Writer) error {
+		return coll.defaultGenesis(w)
+	})
+}
+
+// ExportJSON writes the JSON representation of the state of the schema: an
+// object mapping the name of every collection of the schema, in alphabetical
+// order, to the list of its entries in key order. Every entry is an object with
+// the JSON encoded key of the entry and, unless the collection is a KeySet,
+// its JSON encoded value. The representation is stable, hence it can be used as
+// the genesis state of a module.
+func (s Schema) ExportJSON(ctx context.Context, w io.Writer) error {
+	return s.writeJSONObject(w, func(coll Collection, w io.Writer) error {
+		return coll.exportGenesis(ctx, w)
+	})
+}
+
+// ValidateJSON validates the JSON representation of the state of a schema, as
+// written by ExportJSON. Collections missing from the representation are valid,
+// unknown collections are not.
+func (s Schema) ValidateJSON(r io.Reader) error {
+	return s.readJSONObject(r, func(coll Collection, r io.Reader) error {
+		return coll.validateGenesis(r)
+	})
+}
+
+// ImportJSON imports the JSON representation of the state of a schema, as
+// written by ExportJSON. Collections missing from the representation are left
+// untouched.
+func (s Schema) ImportJSON(ctx context.Context, r io.Reader) error {
+	return s.readJSONObject(r, func(coll Collection, r io.Reader) error {
+		return coll.importGenesis(ctx, r)
+	})
+}
+
+// WalkJSON calls the provided function with the name of the collection and the
+// JSON encoded key and value of every entry of the schema, in the order of
+// ExportJSON. The value of the entries of KeySet collections is nil. If the
+// function returns an error, the walk stops and the error is returned.
+func (s Schema) WalkJSON(ctx context.Context, onEntry func(collection string, key, value json.RawMessage) error) error {
+	for _, name := range s.collectionsOrdered {
+		coll, err := s.getCollection(name)
+		if err != nil {
+			return err
+		}
+
+		err = coll.exportJSONEntries(ctx, func(entry jsonMapEntry) error {
+			return onEntry(name, entry.Key, entry.Value)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// ExportNDJSON writes the newline delimited JSON representation of the state of
+// the schema, which can be streamed: every line is an object with the name of
+// the collection and the JSON encoded key and value of an entry of the schema,
+// in the order of ExportJSON.
+func (s Schema) ExportNDJSON(ctx context.Context, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	return s.WalkJSON(ctx, func(collection string, key, value json.RawMessage) error {
+		return encoder.Encode(jsonSchemaEntry{
+			Collection:   collection,
+			jsonMapEntry: jsonMapEntry{Key: key, Value: value},
+		})
+	})
+}
+
+// ValidateNDJSON validates the newline delimited JSON representation of the
+// state of a schema, as written by ExportNDJSON.
+func (s Schema) ValidateNDJSON(r io.Reader) error {
+	return s.readNDJSON(r, func(coll Collection, entry jsonMapEntry) error {
+		return coll.validateJSONEntry(entry)
+	})
+}
+
+// ImportNDJSON imports the newline delimited JSON representation of the state
+// of a schema, as written by ExportNDJSON.
+func (s Schema) ImportNDJSON(ctx context.Context, r io.Reader) error {
+	return s.readNDJSON(r, func(coll Collection, entry jsonMapEntry) error {
+		return coll.importJSONEntry(ctx, entry)
+	})
+}
+
+// readNDJSON reads newline delimited JSON schema entries, calling the provided
+// function with the collection and the map entry of every line.
+func (s Schema) readNDJSON(r io.Reader, onEntry func(coll Collection, entry jsonMapEntry) error) error {
+	decoder := json.NewDecoder(r)
+	for {
+		var entry jsonSchemaEntry
+		err := decoder.Decode(&entry)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		coll, err := s.getCollection(entry.Collection)
+		if err != nil {
+			return err
+		}
+
+		err = onEntry(coll, entry.jsonMapEntry)
+		if err != nil {
+			return fmt.Errorf("%s entry %s: %w", entry.Collection, entry.Key, err)
+		}
+	}
+}
+
+// writeJSONObject writes a JSON object mapping the name of every collection of
+// the schema to the JSON value written by the provided function.
+func (s Schema) writeJSONObject(w io.Writer, writeCollection func(coll Collection, w io.Writer) error) error {
+	_, err := w.Write([]byte("{"))
+	if err != nil {
+		return err
+	}
+
+	for i, name := range s.collectionsOrdered {
+		if i > 0 {
+			_, err = w.Write([]byte(","))
+			if err != nil {
+				return err
+			}
+		}
+
+		nameBz, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(append(nameBz, ':'))
+		if err != nil {
+			return err
+		}
+
+		coll, err := s.getCollection(name)
+		if err != nil {
+			return err
+		}
+
+		err = writeCollection(coll, w)
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %w", name, err)
+		}
+	}
+
+	_, err = w.Write([]byte("}"))
+	return err
+}
+
+// readJSONObject reads a JSON object mapping collection names to JSON values,
+// calling the provided function with the collection and its JSON value.
+func (s Schema) readJSONObject(r io.Reader, readCollection func(coll Collection, r io.Reader) error) error {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != json.Delim('{') {
+		return fmt.Errorf("expected { got %s", token)
+	}
+
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		name, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("expected collection name got %s", token)
+		}
+
+		coll, err := s.getCollection(name)
+		if err != nil {
+			return err
+		}
+
+		var rawJSON json.RawMessage
+		err = decoder.Decode(&rawJSON)
+		if err != nil {
+			return err
+		}
+
+		err = readCollection(coll, bytes.NewReader(rawJSON))
+		if err != nil {
+			return fmt.Errorf("collection %s: %w", name, err)
+		}
+	}
+
+	token, err = decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != json.Delim('}') {
+		return fmt.Errorf("expected } got %s", token)
+	}
+
+	return nil
+}
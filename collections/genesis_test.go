@@ -80,6 +80,67 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, expectedSequenceGenesis, writers[3].Buffer.String())
 }
 
+func TestSchemaJSON(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.schema.InitGenesis(f.ctx, createTestGenesisSource(t)))
+
+	expected := `{"item":` + expectedItemGenesis + `,"key_set":` + expectedKeySetGenesis +
+		`,"map":` + expectedMapGenesis + `,"sequence":` + expectedSequenceGenesis + `}`
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, f.schema.ExportJSON(f.ctx, buf))
+	require.Equal(t, expected, buf.String())
+	require.NoError(t, f.schema.ValidateJSON(bytes.NewBufferString(expected)))
+
+	// import in a new store, a partial representation is valid
+	f2 := initFixture(t)
+	require.NoError(t, f2.schema.ImportJSON(f2.ctx, bytes.NewBufferString(`{"map":`+expectedMapGenesis+`}`)))
+	value, err := f2.m.Get(f2.ctx, "def")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), value)
+	_, err = f2.i.Get(f2.ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// empty collections are exported as empty lists
+	buf.Reset()
+	require.NoError(t, f2.schema.ExportJSON(f2.ctx, buf))
+	require.Equal(t, `{"item":[],"key_set":[],"map":`+expectedMapGenesis+`,"sequence":[]}`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, f2.schema.DefaultJSON(buf))
+	require.Equal(t, `{"item":[],"key_set":[],"map":[],"sequence":[]}`, buf.String())
+
+	require.ErrorContains(t, f2.schema.ValidateJSON(bytes.NewBufferString(`{"unknown":[]}`)), "unknown collection")
+	require.ErrorContains(t, f2.schema.ValidateJSON(bytes.NewBufferString(`{"map":[{"key":"abc","value":"x"}]}`)), "collection map")
+}
+
+func TestSchemaNDJSON(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.schema.InitGenesis(f.ctx, createTestGenesisSource(t)))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, f.schema.ExportNDJSON(f.ctx, buf))
+	require.Equal(t, `{"collection":"item","key":"item","value":"superCoolItem"}
+{"collection":"key_set","key":"0"}
+{"collection":"key_set","key":"1"}
+{"collection":"key_set","key":"2"}
+{"collection":"map","key":"abc","value":"1"}
+{"collection":"map","key":"def","value":"2"}
+{"collection":"sequence","key":"item","value":"1000"}
+`, buf.String())
+	require.NoError(t, f.schema.ValidateNDJSON(bytes.NewReader(buf.Bytes())))
+
+	// round trip through a new store
+	f2 := initFixture(t)
+	require.NoError(t, f2.schema.ImportNDJSON(f2.ctx, bytes.NewReader(buf.Bytes())))
+	exported := new(bytes.Buffer)
+	require.NoError(t, f2.schema.ExportNDJSON(f2.ctx, exported))
+	require.Equal(t, buf.String(), exported.String())
+
+	require.ErrorContains(t, f2.schema.ValidateNDJSON(bytes.NewBufferString(`{"collection":"unknown","key":"a"}`)), "unknown collection")
+	require.ErrorIs(t, f2.schema.ValidateNDJSON(bytes.NewBufferString(`{"collection":"key_set","key":"a","value":"b"}`)), ErrEncoding)
+}
+
 type testFixture struct {
 	schema Schema
 	ctx    context.Context
@@ -50,6 +50,12 @@ func NewIndexedMap[PrimaryKey, Value any, Idx Indexes[PrimaryKey, Value]](
 	valueCodec codec.ValueCodec[Value],
 	indexes Idx,
 ) *IndexedMap[PrimaryKey, Value, Idx] {
+	for _, index := range indexes.IndexesList() {
+		if untyped, ok := index.(UntypedIndex); ok {
+			schema.addIndex(untyped.GetName(), IndexInfo{Indexed: name, PrimaryKey: untyped.UntypedPrimaryKey})
+		}
+	}
+
 	return &IndexedMap[PrimaryKey, Value, Idx]{
 		Indexes: indexes,
 		m:       NewMap(schema, prefix, name, pkCodec, valueCodec),
@@ -103,3 +103,42 @@ func TestIndexedMap(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, company{"milan", 4}, v)
 }
+
+func TestIndexedMap_LookupIndex(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(sk)
+
+	im := newTestIndexedMap(sb)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan", Vat: 10}))
+
+	_, ok := schema.LookupIndex("companies")
+	require.False(t, ok)
+
+	info, ok := schema.LookupIndex("companies_by_city")
+	require.True(t, ok)
+	require.Equal(t, "companies", info.Indexed)
+	pk, err := info.PrimaryKey(collections.Join("milan", "1"), collections.NoValue{})
+	require.NoError(t, err)
+	require.Equal(t, "1", pk)
+
+	info, ok = schema.LookupIndex("companies_by_vat")
+	require.True(t, ok)
+	require.Equal(t, "companies", info.Indexed)
+	pk, err = info.PrimaryKey(uint64(10), "1")
+	require.NoError(t, err)
+	require.Equal(t, "1", pk)
+
+	// the keys of the collections are exposed through their untyped codecs
+	coll, err := schema.LookupCollection("companies_by_vat")
+	require.NoError(t, err)
+	key, err := coll.KeyCodec().DecodeJSON([]byte(`"10"`))
+	require.NoError(t, err)
+	bz, err := coll.KeyCodec().Encode(key)
+	require.NoError(t, err)
+	value, err := schema.OpenKVStore(ctx).Get(append(coll.GetPrefix(), bz...))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+}
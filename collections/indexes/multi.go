@@ -3,6 +3,7 @@ package indexes
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/codec"
@@ -94,6 +95,21 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context,
 	return m.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
 }
 
+// GetName implements collections.UntypedIndex.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) GetName() string {
+	return (collections.Map[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue])(m.refKeys).GetName()
+}
+
+// UntypedPrimaryKey implements collections.UntypedIndex, the primary key is
+// the second part of the index key.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) UntypedPrimaryKey(key, _ interface{}) (interface{}, error) {
+	pair, ok := key.(collections.Pair[ReferenceKey, PrimaryKey])
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected index key type %T", codec.ErrEncoding, key)
+	}
+	return pair.K2(), nil
+}
+
 func (m *Multi[K1, K2, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K1, K2]] {
 	return m.refKeys.KeyCodec()
 }
@@ -2,6 +2,7 @@ package indexes
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/codec"
@@ -40,6 +41,21 @@ func NewReversePair[Value, K1, K2 any](
 	return mi
 }
 
+// GetName implements collections.UntypedIndex.
+func (i *ReversePair[K1, K2, Value]) GetName() string {
+	return (collections.Map[collections.Pair[K2, K1], collections.NoValue])(i.refKeys).GetName()
+}
+
+// UntypedPrimaryKey implements collections.UntypedIndex, the primary key is
+// the index key with its parts reversed.
+func (i *ReversePair[K1, K2, Value]) UntypedPrimaryKey(key, _ interface{}) (interface{}, error) {
+	pair, ok := key.(collections.Pair[K2, K1])
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected index key type %T", codec.ErrEncoding, key)
+	}
+	return collections.Join(pair.K2(), pair.K1()), nil
+}
+
 // Iterate exposes the raw iterator API.
 func (i *ReversePair[K1, K2, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[K2, K1]]) (iter ReversePairIterator[K2, K1], err error) {
 	sIter, err := i.refKeys.Iterate(ctx, ranger)
@@ -44,6 +44,11 @@ func TestReversePair(t *testing.T) {
 	err := indexedMap.Set(ctx, collections.Join("address1", "atom"), 100)
 	require.NoError(t, err)
 
+	// the index resolves its keys to the primary keys through the untyped API
+	pk, err := indexedMap.Indexes.Denom.UntypedPrimaryKey(collections.Join("atom", "address1"), collections.NoValue{})
+	require.NoError(t, err)
+	require.Equal(t, collections.Join("address1", "atom"), pk)
+
 	err = indexedMap.Set(ctx, collections.Join("address1", "osmo"), 200)
 	require.NoError(t, err)
 
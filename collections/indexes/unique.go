@@ -78,6 +78,21 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Contex
 	return i.refKeys.Remove(ctx, refKey)
 }
 
+// GetName implements collections.UntypedIndex.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) GetName() string {
+	return i.refKeys.GetName()
+}
+
+// UntypedPrimaryKey implements collections.UntypedIndex, the primary key is
+// the value of the index entry.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) UntypedPrimaryKey(_, value interface{}) (interface{}, error) {
+	pk, ok := value.(PrimaryKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected index value type %T", codec.ErrEncoding, value)
+	}
+	return pk, nil
+}
+
 func (i *Unique[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, ref ReferenceKey) (PrimaryKey, error) {
 	return i.refKeys.Get(ctx, ref)
 }
@@ -216,6 +216,23 @@ func (p pairKeyCodec[K1, K2]) DecodeJSON(b []byte) (Pair[K1, K2], error) {
 	return Join(k1, k2), nil
 }
 
+// EncodeJSONPrefix decodes the JSON representation of the first part of the pair,
+// and returns the binary prefix of all the pairs starting with it.
+func (p pairKeyCodec[K1, K2]) EncodeJSONPrefix(b []byte) ([]byte, error) {
+	k1, err := p.keyCodec1.DecodeJSON(b)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := PairPrefix[K1, K2](k1)
+	buffer := make([]byte, p.Size(prefix))
+	n, err := p.Encode(buffer, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return buffer[:n], nil
+}
+
 // NewPrefixUntilPairRange defines a collection query which ranges until the provided Pair prefix.
 // Unstable: this API might change in the future.
 func NewPrefixUntilPairRange[K1, K2 any](prefix K1) *PairRange[K1, K2] {
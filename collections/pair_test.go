@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
 )
 
 func TestPair(t *testing.T) {
@@ -22,6 +24,19 @@ func TestPair(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, []byte(`["k1","k2"]`), b)
 	})
+
+	t.Run("json prefix", func(t *testing.T) {
+		prefix, err := codec.NewUntypedKeyCodec(keyCodec).EncodeJSONPrefix([]byte(`"k1"`))
+		require.NoError(t, err)
+
+		expected := make([]byte, keyCodec.Size(PairPrefix[string, string]("k1")))
+		n, err := keyCodec.Encode(expected, PairPrefix[string, string]("k1"))
+		require.NoError(t, err)
+		require.Equal(t, expected[:n], prefix)
+
+		_, err = codec.NewUntypedKeyCodec(StringKey).EncodeJSONPrefix([]byte(`"k1"`))
+		require.ErrorIs(t, err, ErrEncoding)
+	})
 }
 
 func TestPairRange(t *testing.T) {
@@ -27,6 +27,7 @@ func NewSchemaBuilderFromAccessor(accessorFunc func(ctx context.Context) store.K
 			storeAccessor:       accessorFunc,
 			collectionsByName:   map[string]Collection{},
 			collectionsByPrefix: map[string]Collection{},
+			indexes:             map[string]IndexInfo{},
 		},
 	}
 }
@@ -107,6 +108,10 @@ func (s *SchemaBuilder) addCollection(collection Collection) {
 	s.schema.collectionsByName[name] = collection
 }
 
+func (s *SchemaBuilder) addIndex(name string, info IndexInfo) {
+	s.schema.indexes[name] = info
+}
+
 func (s *SchemaBuilder) appendError(err error) {
 	if s.err == nil {
 		s.err = err
@@ -130,6 +135,16 @@ type Schema struct {
 	collectionsOrdered  []string
 	collectionsByPrefix map[string]Collection
 	collectionsByName   map[string]Collection
+	indexes             map[string]IndexInfo
+}
+
+// IndexInfo describes the collection of an index of an IndexedMap.
+type IndexInfo struct {
+	// Indexed is the name of the collection of the IndexedMap.
+	Indexed string
+	// PrimaryKey returns the primary key of the indexed collection referenced
+	// by an entry of the index collection, given its decoded key and value.
+	PrimaryKey func(key, value interface{}) (interface{}, error)
 }
 
 // NewSchema creates a new schema for the provided KVStoreService.
@@ -159,6 +174,7 @@ func NewSchemaFromAccessor(accessor func(context.Context) store.KVStore) Schema
 		storeAccessor:       accessor,
 		collectionsByName:   map[string]Collection{},
 		collectionsByPrefix: map[string]Collection{},
+		indexes:             map[string]IndexInfo{},
 	}
 }
 
@@ -279,6 +295,23 @@ func (s Schema) exportGenesis(ctx context.Context, target appmodule.GenesisTarge
 	return coll.exportGenesis(ctx, wc)
 }
 
+// OpenKVStore returns the store holding the collections of the schema.
+func (s Schema) OpenKVStore(ctx context.Context) store.KVStore {
+	return s.storeAccessor(ctx)
+}
+
+// LookupCollection returns the collection with the provided name.
+func (s Schema) LookupCollection(name string) (Collection, error) {
+	return s.getCollection(name)
+}
+
+// LookupIndex returns the information of the index stored in the collection
+// with the provided name, if the collection is the index of an IndexedMap.
+func (s Schema) LookupIndex(name string) (IndexInfo, bool) {
+	info, ok := s.indexes[name]
+	return info, ok
+}
+
 func (s Schema) getCollection(name string) (Collection, error) {
 	coll, ok := s.collectionsByName[name]
 	if !ok {
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// Handler handles a typed event emitted by a message.
+type Handler func(ctx context.Context, event protoiface.MessageV1) error
+
+// Router represents an event service on which modules can register handlers for
+// the typed events (as described in ADR 032) emitted by other modules. It lets
+// modules react to the events of other modules in the same block, without
+// depending on bespoke hook interfaces.
+//
+// Handlers are invoked by the runtime after the message which emitted the event
+// completes, with the context of the message, in the order the events were
+// emitted and, for a given event, in the order the handlers were registered.
+// The events emitted by the handlers are themselves dispatched to their handlers.
+// An error returned by a handler fails the message.
+//
+// Handlers MUST be deterministic, and adding, removing or changing a handler
+// SHOULD be considered state-machine breaking.
+type Router interface {
+	// RegisterHandler registers a handler for the events of the type of event.
+	// Handlers must be registered while the app is being built, e.g. in the
+	// constructor of the keeper.
+	RegisterHandler(event protoiface.MessageV1, handler Handler) error
+}
+
+// ErrHandlersNotSupported is returned when registering an event handler on an
+// event service which is not a Router.
+var ErrHandlersNotSupported = errors.New("event service does not support event handlers")
+
+// RegisterHandler registers a handler for the events of type E on the event
+// service, which must be a Router.
+func RegisterHandler[E protoiface.MessageV1](service Service, handler func(ctx context.Context, event E) error) error {
+	router, ok := service.(Router)
+	if !ok {
+		return ErrHandlersNotSupported
+	}
+
+	var zero E
+	return router.RegisterHandler(zero, func(ctx context.Context, event protoiface.MessageV1) error {
+		typed, ok := event.(E)
+		if !ok {
+			return fmt.Errorf("expected event of type %T, got %T", zero, event)
+		}
+
+		return handler(ctx, typed)
+	})
+}
@@ -0,0 +1,29 @@
+//go:build cgo
+
+package codec
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// registerBls12381Interfaces registers the bls12_381 keys, which are only
+// supported when built with cgo.
+func registerBls12381Interfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &bls12_381.PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &bls12_381.AggregatedPubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &bls12_381.PrivKey{})
+}
+
+// registerBls12381Amino registers the bls12_381 keys with the amino codec, they
+// are only supported when built with cgo.
+func registerBls12381Amino(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&bls12_381.PubKey{},
+		bls12_381.PubKeyName, nil)
+	cdc.RegisterConcrete(&bls12_381.AggregatedPubKey{},
+		bls12_381.AggregatedPubKeyName, nil)
+	cdc.RegisterConcrete(&bls12_381.PrivKey{},
+		bls12_381.PrivKeyName, nil)
+}
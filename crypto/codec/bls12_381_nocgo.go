@@ -0,0 +1,16 @@
+//go:build !cgo
+
+package codec
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// registerBls12381Interfaces does not register the bls12_381 keys, their
+// signatures cannot be verified without cgo.
+func registerBls12381Interfaces(codectypes.InterfaceRegistry) {}
+
+// registerBls12381Amino does not register the bls12_381 keys, their signatures
+// cannot be verified without cgo.
+func registerBls12381Amino(*codec.LegacyAmino) {}
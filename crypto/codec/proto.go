@@ -22,4 +22,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(priv, &secp256k1.PrivKey{})
 	registry.RegisterImplementations(priv, &ed25519.PrivKey{})
 	secp256r1.RegisterInterfaces(registry)
+	registerBls12381Interfaces(registry)
 }
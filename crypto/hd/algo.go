@@ -1,9 +1,14 @@
 package hd
 
 import (
+	stded25519 "crypto/ed25519"
+
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -15,15 +20,29 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters, as used by passkeys.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Ed25519Type represents the Ed25519Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It is not supported by ledgers.
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Bls12_381Type represents the BLS signature system over the BLS12-381 curve.
+	// It is not supported by ledgers.
+	Bls12_381Type = PubKeyType("bls12_381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters, with the SLIP-10 derivation.
+	Secp256r1 = secp256r1Algo{}
+	// Ed25519 uses the Ed25519 signature system, with the SLIP-10 derivation.
+	Ed25519 = ed25519Algo{}
+	// Bls12_381 uses the BLS signature system over the BLS12-381 curve, with the
+	// EIP-2333 derivation.
+	Bls12_381 = bls12381Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -68,3 +87,109 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type secp256r1Algo struct{}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given seed and HD path,
+// following SLIP-10.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return DeriveSecp256r1KeyForPath(seed, hdPath)
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		key, err := secp256r1.NewPrivKeyFromSecret(bz)
+		if err != nil {
+			// the derived keys are valid scalars of the curve
+			panic(err)
+		}
+
+		return key
+	}
+}
+
+type ed25519Algo struct{}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives and returns the ed25519 private key seed for the given seed and HD path,
+// following SLIP-10. All the levels of the HD path are hardened.
+func (s ed25519Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return DeriveEd25519KeyForPath(seed, hdPath)
+	}
+}
+
+// Generate generates an ed25519 private key from the given seed.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		seed := make([]byte, ed25519.SeedSize)
+		copy(seed, bz)
+
+		return &ed25519.PrivKey{Key: stded25519.NewKeyFromSeed(seed)}
+	}
+}
+
+type bls12381Algo struct{}
+
+func (s bls12381Algo) Name() PubKeyType {
+	return Bls12_381Type
+}
+
+// Derive derives and returns the bls12_381 private key for the given seed and HD path,
+// following EIP-2333. The levels of the HD path are all hardened by the derivation.
+func (s bls12381Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		var path []uint32
+		if len(hdPath) != 0 {
+			levels, err := parsePath(hdPath)
+			if err != nil {
+				return nil, err
+			}
+
+			path = make([]uint32, len(levels))
+			for i, level := range levels {
+				path[i] = level.index
+			}
+		}
+
+		return bls12_381.DeriveKey(seed, path)
+	}
+}
+
+// Generate generates a bls12_381 private key from the given bytes.
+func (s bls12381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		key, err := bls12_381.NewPrivKeyFromSecret(bz)
+		if err != nil {
+			// the derived keys are valid scalars of the curve
+			panic(err)
+		}
+
+		return key
+	}
+}
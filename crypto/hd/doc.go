@@ -10,4 +10,12 @@
 //
 // In particular, this package (together with bip39) provides all necessary functionality to derive
 // keys from mnemonics generated during the cosmos fundraiser.
+//
+// The secp256r1 and ed25519 keys are derived following SLIP-10, which generalizes BIP 32 to these curves:
+//
+//	https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+//
+// The bls12_381 keys are derived following EIP-2333, where all the levels are hardened:
+//
+//	https://eips.ethereum.org/EIPS/eip-2333
 package hd
@@ -166,10 +166,37 @@ func ComputeMastersFromSeed(seed []byte) (secret, chainCode [32]byte) {
 // DerivePrivateKeyForPath derives the private key by following the BIP 32/44 path from privKeyBytes,
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	levels, err := parsePath(path)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	data := privKeyBytes
+	for _, level := range levels {
+		data, chainCode = derivePrivateKey(data, chainCode, level.index, level.harden)
+	}
+
+	derivedKey := make([]byte, 32)
+	n := copy(derivedKey, data[:])
+
+	if n != 32 || len(data) != 32 {
+		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
+	}
+
+	return derivedKey, nil
+}
+
+// pathLevel is a level of a BIP 32 path.
+type pathLevel struct {
+	index  uint32
+	harden bool
+}
+
+// parsePath parses the levels of a BIP 32 path, such as m/44'/118'/0'/0/0.
+func parsePath(path string) ([]pathLevel, error) {
 	// First step is to trim the right end path separator lest we panic.
 	// See issue https://github.com/cosmos/cosmos-sdk/issues/8557
 	path = strings.TrimRightFunc(path, func(r rune) bool { return r == filepath.Separator })
-	data := privKeyBytes
 	parts := strings.Split(path, "/")
 
 	switch {
@@ -179,6 +206,7 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		parts = parts[1:]
 	}
 
+	levels := make([]pathLevel, 0, len(parts))
 	for i, part := range parts {
 		if part == "" {
 			return nil, fmt.Errorf("path %q with split element #%d is an empty string", part, i)
@@ -195,20 +223,13 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		// index values are in the range [0, 1<<31-1] aka [0, max(int32)]
 		idx, err := strconv.ParseUint(part, 10, 31)
 		if err != nil {
-			return []byte{}, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
+			return nil, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
 		}
 
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
+		levels = append(levels, pathLevel{index: uint32(idx), harden: harden})
 	}
 
-	derivedKey := make([]byte, 32)
-	n := copy(derivedKey, data[:])
-
-	if n != 32 || len(data) != 32 {
-		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
-	}
-
-	return derivedKey, nil
+	return levels, nil
 }
 
 // derivePrivateKey derives the private key with index and chainCode.
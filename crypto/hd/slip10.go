@@ -0,0 +1,105 @@
+package hd
+
+import (
+	"crypto/elliptic"
+	"math/big"
+)
+
+// SLIP-10 generalizes the BIP 32 derivation to other curves than secp256k1, see
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+var (
+	slip10Ed25519Seed   = []byte("ed25519 seed")
+	slip10Nist256p1Seed = []byte("Nist256p1 seed")
+)
+
+const hardenedIndex = uint32(0x80000000)
+
+// DeriveEd25519KeyForPath derives the ed25519 private key seed by following the
+// SLIP-10 path from the BIP 39 seed. An empty path returns the master key.
+// SLIP-10 only defines the hardened derivation of ed25519 keys, hence all the
+// levels of the path are hardened, i.e. m/44'/118'/0'/0/0 derives the key of
+// m/44'/118'/0'/0'/0'.
+func DeriveEd25519KeyForPath(seed []byte, path string) ([]byte, error) {
+	key, chainCode := i64(slip10Ed25519Seed, seed)
+	if len(path) == 0 {
+		return key[:], nil
+	}
+
+	levels, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, level := range levels {
+		data := append([]byte{0}, key[:]...)
+		data = append(data, uint32ToBytes(level.index|hardenedIndex)...)
+		key, chainCode = i64(chainCode[:], data)
+	}
+
+	return key[:], nil
+}
+
+// DeriveSecp256r1KeyForPath derives the secp256r1 (NIST P-256) private key by
+// following the SLIP-10 path from the BIP 39 seed. An empty path returns the
+// master key.
+func DeriveSecp256r1KeyForPath(seed []byte, path string) ([]byte, error) {
+	curve := elliptic.P256()
+	order := curve.Params().N
+
+	// the master key is derived again from the HMAC output until it is valid
+	data := seed
+	key, chainCode := i64(slip10Nist256p1Seed, data)
+	for !validScalar(key[:], order) {
+		data = append(key[:], chainCode[:]...)
+		key, chainCode = i64(slip10Nist256p1Seed, data)
+	}
+
+	if len(path) == 0 {
+		return key[:], nil
+	}
+
+	levels, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, level := range levels {
+		index := level.index
+		var data []byte
+		if level.harden {
+			index |= hardenedIndex
+			data = append([]byte{0}, key[:]...)
+		} else {
+			x, y := curve.ScalarBaseMult(key[:])
+			data = elliptic.MarshalCompressed(curve, x, y)
+		}
+		data = append(data, uint32ToBytes(index)...)
+
+		for {
+			il, ir := i64(chainCode[:], data)
+			child := new(big.Int).SetBytes(il[:])
+			if child.Cmp(order) < 0 {
+				child.Add(child, new(big.Int).SetBytes(key[:]))
+				child.Mod(child, order)
+				if child.Sign() != 0 {
+					key = [32]byte{}
+					child.FillBytes(key[:])
+					chainCode = ir
+					break
+				}
+			}
+
+			// the child key is invalid, proceed with the next candidate
+			data = append([]byte{1}, ir[:]...)
+			data = append(data, uint32ToBytes(index)...)
+		}
+	}
+
+	return key[:], nil
+}
+
+// validScalar returns true if the key is a valid private key of the curve of the given order.
+func validScalar(key []byte, order *big.Int) bool {
+	k := new(big.Int).SetBytes(key)
+	return k.Sign() != 0 && k.Cmp(order) < 0
+}
@@ -0,0 +1,57 @@
+package hd_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+// test vector 1 of https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+const slip10Seed = "000102030405060708090a0b0c0d0e0f"
+
+func TestDeriveEd25519KeyForPath(t *testing.T) {
+	seed, err := hex.DecodeString(slip10Seed)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		path string
+		key  string
+	}{
+		{"", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{"m/0'", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{"m/0'/1'", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		// non hardened levels are hardened
+		{"m/0/1", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+	} {
+		key, err := hd.DeriveEd25519KeyForPath(seed, tc.path)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.key, hex.EncodeToString(key), tc.path)
+	}
+
+	_, err = hd.DeriveEd25519KeyForPath(seed, "m/0'/x")
+	require.Error(t, err)
+}
+
+func TestDeriveSecp256r1KeyForPath(t *testing.T) {
+	seed, err := hex.DecodeString(slip10Seed)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		path string
+		key  string
+	}{
+		{"", "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"},
+		{"m/0'", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		{"m/0'/1", "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"},
+	} {
+		key, err := hd.DeriveSecp256r1KeyForPath(seed, tc.path)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.key, hex.EncodeToString(key), tc.path)
+	}
+
+	_, err = hd.DeriveSecp256r1KeyForPath(seed, "m/0'/x")
+	require.Error(t, err)
+}
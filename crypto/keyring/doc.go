@@ -37,4 +37,12 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//
+// # External signers
+//
+// Keys held by an external signer, such as a HSM or a remote signing service, are stored as
+// External records referencing the key in a SignerBackend, configured with WithSignerBackends.
+// The keyring dispatches the signing with these keys to their backend. RemoteSignerBackend
+// implements a SignerBackend over the RemoteSigner gRPC service, and LocalRemoteSigner is a
+// local implementation of this service, e.g. for tests.
 package keyring
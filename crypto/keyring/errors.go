@@ -31,6 +31,12 @@ var (
 	ErrNotLedgerObj = errors.New("not a ledger object")
 	// ErrLedgerInvalidSignature is raised when ledger generates an invalid signature.
 	ErrLedgerInvalidSignature = errors.New("Ledger generated an invalid signature. Perhaps you have multiple ledgers and need to try another one")
+	// ErrUnknownSignerBackend is raised when an external signer backend is not configured in the keyring options.
+	ErrUnknownSignerBackend = errors.New("unknown signer backend")
+	// ErrExternalPubKey is raised when an external signer backend can't return the public key of a key.
+	ErrExternalPubKey = errors.New("failed to get external signer public key")
+	// ErrExternalInvalidSignature is raised when an external signer backend generates an invalid signature.
+	ErrExternalInvalidSignature = errors.New("external signer generated an invalid signature")
 	// ErrLegacyToRecord is raised when cannot be converted to a Record
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -90,6 +91,10 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveExternalKey retrieves the public key of a key held by an external signer
+	// backend and persists a reference to it.
+	SaveExternalKey(uid, backend, keyID string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -157,6 +162,8 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// external signer backends holding the keys of the External records
+	SignerBackends []SignerBackend
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -218,9 +225,12 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 	// Default options for keybase, these can be overwritten using the
 	// Option function
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1, hd.Ed25519},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
+	if bls12_381.Supported {
+		options.SupportedAlgos = append(options.SupportedAlgos, hd.Bls12_381)
+	}
 
 	for _, optionFn := range opts {
 		optionFn(&options)
@@ -383,6 +393,9 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetExternal() != nil:
+		return ks.signWithExternal(k, msg, signMode)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -426,6 +439,25 @@ func (ks keystore) writeLedgerKey(name string, pk types.PubKey, path *hd.BIP44Pa
 	return k, ks.writeRecord(k)
 }
 
+func (ks keystore) SaveExternalKey(uid, backend, keyID string) (*Record, error) {
+	b, err := ks.signerBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := b.PubKey(keyID)
+	if err != nil {
+		return nil, errors.CombineErrors(ErrExternalPubKey, err)
+	}
+
+	k, err := NewExternalRecord(uid, pk, backend, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) SaveMultisig(uid string, pubkey types.PubKey) (*Record, error) {
 	return ks.writeMultisigKey(uid, pubkey)
 }
@@ -22,6 +22,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cosmosbcrypt "github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -640,6 +641,70 @@ func TestExportImportPrivKey(t *testing.T) {
 	}
 }
 
+func TestAccountKeyAlgos(t *testing.T) {
+	cdc := getCodec()
+	msg := []byte("some message")
+
+	testCases := []struct {
+		algo    SignatureAlgo
+		keyType string
+	}{
+		{hd.Secp256k1, "secp256k1"},
+		{hd.Secp256r1, "secp256r1"},
+		{hd.Ed25519, "ed25519"},
+	}
+	if bls12_381.Supported {
+		testCases = append(testCases, struct {
+			algo    SignatureAlgo
+			keyType string
+		}{hd.Bls12_381, "bls12_381"})
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.algo.Name()), func(t *testing.T) {
+			kb, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+			require.NoError(t, err)
+
+			k, mnemonic, err := kb.NewMnemonic("key", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, tc.algo)
+			require.NoError(t, err)
+			pubKey, err := k.GetPubKey()
+			require.NoError(t, err)
+			require.Equal(t, tc.keyType, pubKey.Type())
+
+			// the derivation is deterministic
+			recovered, err := kb.NewAccount("recovered", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, tc.algo)
+			require.ErrorIs(t, err, ErrDuplicatedAddress)
+			require.Nil(t, recovered)
+
+			other, err := kb.NewAccount("other", mnemonic, DefaultBIP39Passphrase, hd.CreateHDPath(sdk.CoinType, 0, 1).String(), tc.algo)
+			require.NoError(t, err)
+			otherPubKey, err := other.GetPubKey()
+			require.NoError(t, err)
+			require.False(t, pubKey.Equals(otherPubKey))
+
+			sig, signPubKey, err := kb.Sign("key", msg, signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			require.True(t, pubKey.Equals(signPubKey))
+			require.True(t, pubKey.VerifySignature(msg, sig))
+
+			armor, err := kb.ExportPrivKeyArmor("key", "apassphrase")
+			require.NoError(t, err)
+			require.NoError(t, kb.Delete("key"))
+			require.NoError(t, kb.ImportPrivKey("imported", armor, "apassphrase"))
+
+			imported, err := kb.Key("imported")
+			require.NoError(t, err)
+			importedPubKey, err := imported.GetPubKey()
+			require.NoError(t, err)
+			require.True(t, pubKey.Equals(importedPubKey))
+
+			sig, _, err = kb.Sign("imported", msg, signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			require.True(t, pubKey.VerifySignature(msg, sig))
+		})
+	}
+}
+
 func TestImportExportPrivKeyByAddress(t *testing.T) {
 	cdc := getCodec()
 	tests := []struct {
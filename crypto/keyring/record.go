@@ -53,6 +53,14 @@ func (rl *Record_Ledger) GetPath() *hd.BIP44Params {
 	return rl.Path
 }
 
+// NewExternalRecord creates a new Record with external item, referencing the
+// key keyID held by the signer backend.
+func NewExternalRecord(name string, pk cryptotypes.PubKey, backend, keyID string) (*Record, error) {
+	recordExternal := &Record_External{Backend: backend, KeyId: keyID}
+	recordExternalItem := &Record_External_{recordExternal}
+	return newRecord(name, pk, recordExternalItem)
+}
+
 // NewOfflineRecord creates a new Record with offline item
 func NewOfflineRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	recordOffline := &Record_Offline{}
@@ -97,6 +105,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetExternal() != nil:
+		return TypeExternal
 	default:
 		panic("unrecognized record type")
 	}
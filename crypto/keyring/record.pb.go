@@ -38,6 +38,7 @@ type Record struct {
 	//	*Record_Ledger_
 	//	*Record_Multi_
 	//	*Record_Offline_
+	//	*Record_External_
 	Item isRecord_Item `protobuf_oneof:"item"`
 }
 
@@ -92,11 +93,15 @@ type Record_Multi_ struct {
 type Record_Offline_ struct {
 	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof" json:"offline,omitempty"`
 }
+type Record_External_ struct {
+	External *Record_External `protobuf:"bytes,7,opt,name=external,proto3,oneof" json:"external,omitempty"`
+}
 
-func (*Record_Local_) isRecord_Item()   {}
-func (*Record_Ledger_) isRecord_Item()  {}
-func (*Record_Multi_) isRecord_Item()   {}
-func (*Record_Offline_) isRecord_Item() {}
+func (*Record_Local_) isRecord_Item()    {}
+func (*Record_Ledger_) isRecord_Item()   {}
+func (*Record_Multi_) isRecord_Item()    {}
+func (*Record_Offline_) isRecord_Item()  {}
+func (*Record_External_) isRecord_Item() {}
 
 func (m *Record) GetItem() isRecord_Item {
 	if m != nil {
@@ -133,6 +138,13 @@ func (m *Record) GetOffline() *Record_Offline {
 	return nil
 }
 
+func (m *Record) GetExternal() *Record_External {
+	if x, ok := m.GetItem().(*Record_External_); ok {
+		return x.External
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Record) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -140,6 +152,7 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 		(*Record_Ledger_)(nil),
 		(*Record_Multi_)(nil),
 		(*Record_Offline_)(nil),
+		(*Record_External_)(nil),
 	}
 }
 
@@ -294,12 +307,55 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// External item
+type Record_External struct {
+	// backend is the name of the signer backend holding the key, e.g. a HSM
+	// or a remote signing service.
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// key_id identifies the key in the signer backend.
+	KeyId string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (m *Record_External) Reset()         { *m = Record_External{} }
+func (m *Record_External) String() string { return proto.CompactTextString(m) }
+func (*Record_External) ProtoMessage()    {}
+func (*Record_External) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{0, 4}
+}
+func (m *Record_External) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record_External) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record_External.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Record_External) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record_External.Merge(m, src)
+}
+func (m *Record_External) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record_External) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record_External.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record_External proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*Record_External)(nil), "cosmos.crypto.keyring.v1.Record.External")
 }
 
 func init() {
@@ -307,33 +363,37 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x8b, 0xd3, 0x4e,
+	0x1c, 0xc7, 0x93, 0xff, 0x3f, 0x0f, 0xed, 0xcf, 0xdb, 0xb0, 0xc2, 0x18, 0x24, 0x14, 0x41, 0xad,
+	0xc8, 0xce, 0xb0, 0xda, 0x83, 0x20, 0x2c, 0x6c, 0x51, 0xec, 0xb2, 0x2e, 0x2e, 0x73, 0xf4, 0xb2,
+	0xe4, 0x61, 0x9a, 0x84, 0x3c, 0x4c, 0x98, 0x26, 0xc5, 0x79, 0x17, 0x1e, 0x7d, 0x49, 0x7b, 0xdc,
+	0xa3, 0x47, 0x6d, 0xdf, 0x80, 0x2f, 0x41, 0x32, 0x49, 0x04, 0x17, 0xb4, 0x9e, 0x3a, 0x43, 0x3f,
+	0xdf, 0x87, 0x99, 0xf9, 0x05, 0x1e, 0x47, 0x62, 0x53, 0x8a, 0x0d, 0x8d, 0xa4, 0xaa, 0x1b, 0x41,
+	0x73, 0xae, 0x64, 0x56, 0x25, 0x74, 0x7b, 0x42, 0x25, 0x8f, 0x84, 0x8c, 0x49, 0x2d, 0x45, 0x23,
+	0x10, 0xee, 0x31, 0xd2, 0x63, 0x64, 0xc0, 0xc8, 0xf6, 0xc4, 0x3b, 0x4a, 0x44, 0x22, 0x34, 0x44,
+	0xbb, 0x55, 0xcf, 0x7b, 0x0f, 0x12, 0x21, 0x92, 0x82, 0x53, 0xbd, 0x0b, 0xdb, 0x35, 0x0d, 0x2a,
+	0x35, 0xfc, 0xf5, 0xf0, 0xf7, 0xc4, 0x34, 0xee, 0xc2, 0xd2, 0x21, 0xe8, 0xd1, 0x0f, 0x0b, 0x1c,
+	0xa6, 0x93, 0x11, 0x02, 0xab, 0x0a, 0x4a, 0x8e, 0xcd, 0x99, 0x39, 0x9f, 0x32, 0xbd, 0x46, 0xc7,
+	0xe0, 0xd6, 0x6d, 0x78, 0x9d, 0x73, 0x85, 0xff, 0x9b, 0x99, 0xf3, 0x7b, 0x2f, 0x8e, 0x48, 0x9f,
+	0x44, 0xc6, 0x24, 0x72, 0x56, 0x29, 0xe6, 0xd4, 0x6d, 0x78, 0xc1, 0x15, 0x3a, 0x05, 0xbb, 0x10,
+	0x51, 0x50, 0xe0, 0xff, 0x35, 0xfc, 0x84, 0xfc, 0xe9, 0x18, 0xa4, 0xcf, 0x24, 0xef, 0x3b, 0x7a,
+	0x65, 0xb0, 0x5e, 0x86, 0xce, 0xc0, 0x29, 0x78, 0x9c, 0x70, 0x89, 0x2d, 0x6d, 0xf0, 0xf4, 0xb0,
+	0x81, 0xc6, 0x57, 0x06, 0x1b, 0x84, 0x5d, 0x85, 0xb2, 0x2d, 0x9a, 0x0c, 0xdb, 0xff, 0x58, 0xe1,
+	0xb2, 0xa3, 0xbb, 0x0a, 0x5a, 0x86, 0xde, 0x80, 0x2b, 0xd6, 0xeb, 0x22, 0xab, 0x38, 0x76, 0xb4,
+	0xc3, 0xfc, 0xa0, 0xc3, 0x87, 0x9e, 0x5f, 0x19, 0x6c, 0x94, 0xa2, 0x77, 0x30, 0xe1, 0x9f, 0x1a,
+	0x2e, 0xab, 0xa0, 0xc0, 0xae, 0xb6, 0x79, 0x76, 0xd0, 0xe6, 0xed, 0x20, 0x58, 0x19, 0xec, 0x97,
+	0xd8, 0x7b, 0x05, 0xb6, 0xbe, 0x23, 0x44, 0x61, 0x52, 0xcb, 0x6c, 0xab, 0x9f, 0xc2, 0xfc, 0xcb,
+	0x53, 0xb8, 0x1d, 0x75, 0xc1, 0x95, 0x77, 0x0a, 0x4e, 0x7f, 0x39, 0x68, 0x01, 0x56, 0x1d, 0x34,
+	0xe9, 0x20, 0x9b, 0xdd, 0x29, 0x92, 0xc6, 0x5d, 0x87, 0xe5, 0xf9, 0xd5, 0x62, 0x71, 0x15, 0xc8,
+	0xa0, 0xdc, 0x30, 0x4d, 0x7b, 0x2e, 0xd8, 0xfa, 0x6a, 0xbc, 0x29, 0xb8, 0xc3, 0x09, 0xbd, 0xd7,
+	0x30, 0x19, 0x5b, 0x22, 0x0c, 0x6e, 0x18, 0x44, 0x39, 0xaf, 0xe2, 0x61, 0x62, 0xc6, 0x2d, 0xba,
+	0x0f, 0x4e, 0xce, 0xd5, 0x75, 0x16, 0xeb, 0x99, 0x99, 0x32, 0x3b, 0xe7, 0xea, 0x3c, 0x5e, 0x3a,
+	0x60, 0x65, 0x0d, 0x2f, 0x97, 0x97, 0x37, 0xdf, 0x7d, 0xe3, 0x66, 0xe7, 0x9b, 0xb7, 0x3b, 0xdf,
+	0xfc, 0xb6, 0xf3, 0xcd, 0xcf, 0x7b, 0xdf, 0xf8, 0xb2, 0xf7, 0x8d, 0xdb, 0xbd, 0x6f, 0x7c, 0xdd,
+	0xfb, 0xc6, 0xc7, 0xe7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x51, 0xd2, 0x71, 0x7a, 0xf5, 0xcf,
+	0xf1, 0x26, 0xce, 0xef, 0x7c, 0x3a, 0xa1, 0xa3, 0x8f, 0xff, 0xf2, 0xe7, 0x00, 0x2b, 0xd1, 0x7f,
+	0x6e, 0x5a, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -471,6 +531,27 @@ func (m *Record_Offline_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Record_External_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_External_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.External != nil {
+		{
+			size, err := m.External.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Record_Local) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -587,6 +668,43 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Record_External) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record_External) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_External) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Backend) > 0 {
+		i -= len(m.Backend)
+		copy(dAtA[i:], m.Backend)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Backend)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRecord(v)
 	base := offset
@@ -666,6 +784,18 @@ func (m *Record_Offline_) Size() (n int) {
 	}
 	return n
 }
+func (m *Record_External_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.External != nil {
+		l = m.External.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
 func (m *Record_Local) Size() (n int) {
 	if m == nil {
 		return 0
@@ -710,6 +840,23 @@ func (m *Record_Offline) Size() (n int) {
 	return n
 }
 
+func (m *Record_External) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
 func sovRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -953,6 +1100,41 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &Record_Offline_{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Record_External{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &Record_External_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -1246,6 +1428,120 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Record_External) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: External: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: External: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
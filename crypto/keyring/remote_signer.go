@@ -0,0 +1,97 @@
+package keyring
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ SignerBackend = RemoteSignerBackend{}
+
+// RemoteSignerBackend is a SignerBackend dispatching to a remote signing
+// service which implements the RemoteSigner gRPC service.
+type RemoteSignerBackend struct {
+	name   string
+	client RemoteSignerClient
+	cdc    codec.Codec
+}
+
+// NewRemoteSignerBackend returns a SignerBackend of the given name, signing
+// through the RemoteSigner gRPC service of the connection.
+func NewRemoteSignerBackend(name string, conn gogogrpc.ClientConn, cdc codec.Codec) RemoteSignerBackend {
+	return RemoteSignerBackend{
+		name:   name,
+		client: NewRemoteSignerClient(conn),
+		cdc:    cdc,
+	}
+}
+
+// Name implements SignerBackend.
+func (b RemoteSignerBackend) Name() string {
+	return b.name
+}
+
+// PubKey implements SignerBackend.
+func (b RemoteSignerBackend) PubKey(keyID string) (types.PubKey, error) {
+	res, err := b.client.PubKey(context.Background(), &RemoteSignerPubKeyRequest{KeyId: keyID})
+	if err != nil {
+		return nil, err
+	}
+
+	var pk types.PubKey
+	if err := b.cdc.UnpackAny(res.PubKey, &pk); err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
+// Sign implements SignerBackend.
+func (b RemoteSignerBackend) Sign(keyID string, msg []byte, signMode signing.SignMode) ([]byte, error) {
+	res, err := b.client.Sign(context.Background(), &RemoteSignerSignRequest{KeyId: keyID, Msg: msg, SignMode: signMode})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Signature, nil
+}
+
+var _ RemoteSignerServer = LocalRemoteSigner{}
+
+// LocalRemoteSigner is a local stand-in for a remote signing service, e.g. for
+// tests. It implements the RemoteSigner gRPC service with the keys of a
+// keyring, identified by their names.
+type LocalRemoteSigner struct {
+	kr Keyring
+}
+
+// NewLocalRemoteSigner returns a RemoteSignerServer signing with the keys of the keyring.
+func NewLocalRemoteSigner(kr Keyring) LocalRemoteSigner {
+	return LocalRemoteSigner{kr: kr}
+}
+
+// PubKey implements RemoteSignerServer.
+func (s LocalRemoteSigner) PubKey(_ context.Context, req *RemoteSignerPubKeyRequest) (*RemoteSignerPubKeyResponse, error) {
+	k, err := s.kr.Key(req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoteSignerPubKeyResponse{PubKey: k.PubKey}, nil
+}
+
+// Sign implements RemoteSignerServer.
+func (s LocalRemoteSigner) Sign(_ context.Context, req *RemoteSignerSignRequest) (*RemoteSignerSignResponse, error) {
+	sig, _, err := s.kr.Sign(req.KeyId, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to sign with %s", req.KeyId)
+	}
+
+	return &RemoteSignerSignResponse{Signature: sig}, nil
+}
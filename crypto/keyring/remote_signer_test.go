@@ -0,0 +1,105 @@
+package keyring
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/99designs/keyring"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// startLocalRemoteSigner serves a LocalRemoteSigner with the keys of kr, and
+// returns a connection to it.
+func startLocalRemoteSigner(t *testing.T, kr Keyring) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	RegisterRemoteSignerServer(srv, NewLocalRemoteSigner(kr))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestExternalSigner(t *testing.T) {
+	cdc := getCodec()
+
+	remote := NewInMemory(cdc)
+	remoteRecord, _, err := remote.NewMnemonic("remote", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	remotePub, err := remoteRecord.GetPubKey()
+	require.NoError(t, err)
+
+	backend := NewRemoteSignerBackend("remote-signer", startLocalRemoteSigner(t, remote), cdc)
+	db := keyring.NewArrayKeyring(nil)
+	kb := NewInMemoryWithKeyring(db, cdc, WithSignerBackends(backend))
+
+	_, err = kb.SaveExternalKey("external", "unknown", "remote")
+	require.ErrorIs(t, err, ErrUnknownSignerBackend)
+
+	_, err = kb.SaveExternalKey("external", "remote-signer", "unknown")
+	require.ErrorIs(t, err, ErrExternalPubKey)
+
+	k, err := kb.SaveExternalKey("external", "remote-signer", "remote")
+	require.NoError(t, err)
+	require.Equal(t, TypeExternal, k.GetType())
+	require.Equal(t, "external", k.GetType().String())
+
+	// the record references the key of the backend
+	k, err = kb.Key("external")
+	require.NoError(t, err)
+	require.Equal(t, &Record_External{Backend: "remote-signer", KeyId: "remote"}, k.GetExternal())
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, remotePub.Equals(pub))
+
+	msg := []byte("message")
+	sig, pub, err := kb.Sign("external", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, remotePub.Equals(pub))
+	require.True(t, remotePub.VerifySignature(msg, sig))
+
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	sig, _, err = kb.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, remotePub.VerifySignature(msg, sig))
+
+	// the backend must be configured to sign
+	_, _, err = NewInMemoryWithKeyring(db, cdc).Sign("external", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrUnknownSignerBackend)
+
+	// the signatures of the backend are verified
+	_, _, err = NewInMemoryWithKeyring(db, cdc, WithSignerBackends(invalidSignerBackend{backend})).Sign("external", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrExternalInvalidSignature)
+}
+
+// invalidSignerBackend is a SignerBackend returning invalid signatures.
+type invalidSignerBackend struct {
+	SignerBackend
+}
+
+func (b invalidSignerBackend) PubKey(keyID string) (types.PubKey, error) {
+	return b.SignerBackend.PubKey(keyID)
+}
+
+func (invalidSignerBackend) Sign(string, []byte, signing.SignMode) ([]byte, error) {
+	return []byte("invalid"), nil
+}
@@ -0,0 +1,68 @@
+package keyring
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignerBackend is an external signer holding private keys, such as a HSM
+// (e.g. through PKCS#11) or a remote signing service. The keyring dispatches
+// to it the signing with the keys of the External records referencing it.
+type SignerBackend interface {
+	// Name returns the name of the backend, referenced by the External records.
+	Name() string
+
+	// PubKey returns the public key of the key identified by keyID.
+	PubKey(keyID string) (types.PubKey, error)
+
+	// Sign signs msg, produced with the given sign mode, with the key identified
+	// by keyID.
+	Sign(keyID string, msg []byte, signMode signing.SignMode) ([]byte, error)
+}
+
+// WithSignerBackends adds external signer backends to the keyring options.
+func WithSignerBackends(backends ...SignerBackend) Option {
+	return func(options *Options) {
+		options.SignerBackends = append(options.SignerBackends, backends...)
+	}
+}
+
+// signerBackend returns the external signer backend of the given name.
+func (ks keystore) signerBackend(name string) (SignerBackend, error) {
+	for _, b := range ks.options.SignerBackends {
+		if b.Name() == name {
+			return b, nil
+		}
+	}
+
+	return nil, errorsmod.Wrap(ErrUnknownSignerBackend, name)
+}
+
+// signWithExternal signs a binary message with the external signer backend
+// referenced by the record, and returns the signed bytes and the public key.
+func (ks keystore) signWithExternal(k *Record, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	external := k.GetExternal()
+
+	b, err := ks.signerBackend(external.Backend)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := b.Sign(external.KeyId, msg, signMode)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to sign with %s", external.Backend)
+	}
+
+	if !pub.VerifySignature(msg, sig) {
+		return nil, nil, ErrExternalInvalidSignature
+	}
+
+	return sig, pub, nil
+}
@@ -0,0 +1,246 @@
+package bls12_381
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// coefficientBits is the size of the coefficients of the public keys and
+	// the signatures in the aggregation.
+	coefficientBits = 128
+	// coefficientDST is the domain separation tag of the hash of the coefficients.
+	coefficientDST = "COSMOS_BLS12381_AGGREGATED_PUBKEY_COEFFICIENT_"
+)
+
+var _ multisigtypes.PubKey = &AggregatedPubKey{}
+
+// NewAggregatedPubKey returns a threshold multisig public key of bls12_381
+// public keys, verified with a single aggregated signature.
+func NewAggregatedPubKey(threshold int, pubKeys []cryptotypes.PubKey) (*AggregatedPubKey, error) {
+	if threshold <= 0 {
+		return nil, fmt.Errorf("threshold k of n multisignature: k <= 0")
+	}
+	if len(pubKeys) < threshold {
+		return nil, fmt.Errorf("threshold k of n multisignature: len(pubKeys) < k")
+	}
+
+	keys := make([]*PubKey, len(pubKeys))
+	for i, pk := range pubKeys {
+		key, ok := pk.(*PubKey)
+		if !ok {
+			return nil, fmt.Errorf("expected bls12_381 public key, got %T", pk)
+		}
+
+		if err := validatePubKey(key.Key); err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	return &AggregatedPubKey{Threshold: uint32(threshold), PubKeys: keys}, nil
+}
+
+// Address returns the ADR-28 address of the public key.
+func (m *AggregatedPubKey) Address() cryptotypes.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoded public key.
+func (m *AggregatedPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifyMultisignature verifies the aggregated signature of the signers set in
+// the bit array of the multisignature, which must contain a single signature.
+func (m *AggregatedPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	signers, err := m.signers(sig)
+	if err != nil {
+		return err
+	}
+
+	if len(sig.Signatures) != 1 {
+		return fmt.Errorf("expected a single aggregated signature, got %d", len(sig.Signatures))
+	}
+
+	single, ok := sig.Signatures[0].(*signing.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("improper aggregated signature data type %T", sig.Signatures[0])
+	}
+
+	msg, err := getSignBytes(single.SignMode)
+	if err != nil {
+		return err
+	}
+
+	pubKeys, coefficients := m.signersKeys(signers)
+	if !verifyAggregatedSignature(pubKeys, coefficients, msg, single.Signature) {
+		return fmt.Errorf("unable to verify aggregated signature")
+	}
+
+	return nil
+}
+
+// AggregateSignatures returns the multisignature made of the aggregated
+// signature of the signatures of the multisignature, as built by
+// multisig.AddSignatureV2. All the signatures must use the same sign mode.
+func (m *AggregatedPubKey) AggregateSignatures(sig *signing.MultiSignatureData) (*signing.MultiSignatureData, error) {
+	signers, err := m.signers(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sig.Signatures) != len(signers) {
+		return nil, fmt.Errorf("expected %d signatures, got %d", len(signers), len(sig.Signatures))
+	}
+
+	sigs := make([][]byte, len(sig.Signatures))
+	var signMode signing.SignMode
+	for i, s := range sig.Signatures {
+		single, ok := s.(*signing.SingleSignatureData)
+		if !ok {
+			return nil, fmt.Errorf("improper signature data type for index %d", i)
+		}
+
+		if i == 0 {
+			signMode = single.SignMode
+		} else if single.SignMode != signMode {
+			return nil, fmt.Errorf("signatures must use the same sign mode, got %s and %s", signMode, single.SignMode)
+		}
+		sigs[i] = single.Signature
+	}
+
+	_, coefficients := m.signersKeys(signers)
+	aggregated, err := aggregateSignatures(sigs, coefficients)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signing.MultiSignatureData{
+		BitArray:   sig.BitArray,
+		Signatures: []signing.SignatureData{&signing.SingleSignatureData{SignMode: signMode, Signature: aggregated}},
+	}, nil
+}
+
+// VerifySignature returns false, the signature of an AggregatedPubKey is a
+// multisignature verified by VerifyMultisignature.
+func (m *AggregatedPubKey) VerifySignature(msg, sig []byte) bool {
+	return false
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (m *AggregatedPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m == nil {
+		return nil
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+	for i, pk := range m.PubKeys {
+		pubKeys[i] = pk
+	}
+
+	return pubKeys
+}
+
+// GetThreshold implements the PubKey.GetThreshold method
+func (m *AggregatedPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Equals returns true if other is an AggregatedPubKey with the same threshold
+// and the same keys, in the same order.
+func (m *AggregatedPubKey) Equals(other cryptotypes.PubKey) bool {
+	otherKey, ok := other.(*AggregatedPubKey)
+	if !ok || m.Threshold != otherKey.Threshold || len(m.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+
+	for i, pk := range m.PubKeys {
+		if !pk.Equals(otherKey.PubKeys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Type returns the key type name.
+func (m *AggregatedPubKey) Type() string {
+	return "AggregatedPubKeyBls12_381"
+}
+
+// String returns the threshold and the keys of the public key.
+func (m *AggregatedPubKey) String() string {
+	keys := make([]string, len(m.PubKeys))
+	for i, pk := range m.PubKeys {
+		keys[i] = fmt.Sprintf("%X", pk.Key)
+	}
+
+	return fmt.Sprintf("AggregatedPubKeyBls12_381{%d, [%s]}", m.Threshold, strings.Join(keys, ", "))
+}
+
+// signers returns the indexes of the signers set in the bit array of the multisignature.
+func (m *AggregatedPubKey) signers(sig *signing.MultiSignatureData) ([]int, error) {
+	if sig.BitArray == nil {
+		return nil, fmt.Errorf("missing bit array")
+	}
+
+	size := sig.BitArray.Count()
+	if len(m.PubKeys) != size {
+		return nil, fmt.Errorf("bit array size is incorrect, expecting: %d", len(m.PubKeys))
+	}
+
+	var signers []int
+	for i := 0; i < size; i++ {
+		if sig.BitArray.GetIndex(i) {
+			signers = append(signers, i)
+		}
+	}
+
+	if len(signers) < int(m.Threshold) {
+		return nil, fmt.Errorf("not enough signatures set, have %d, expected %d", len(signers), m.Threshold)
+	}
+
+	return signers, nil
+}
+
+// signersKeys returns the public keys of the signers, and their coefficients.
+//
+// The coefficient of a key is derived from the key and all the keys of the
+// multisig, so that an aggregated signature cannot be forged with a rogue
+// public key chosen from the others, see "Compact Multi-Signatures for Smaller
+// Blockchains" by Boneh, Drijvers and Neven.
+func (m *AggregatedPubKey) signersKeys(signers []int) ([][]byte, [][]byte) {
+	all := sha256.New()
+	for _, pk := range m.PubKeys {
+		all.Write(pk.Key)
+	}
+	allHash := all.Sum(nil)
+
+	pubKeys := make([][]byte, len(signers))
+	coefficients := make([][]byte, len(signers))
+	for i, signer := range signers {
+		pubKeys[i] = m.PubKeys[signer].Key
+
+		h := sha256.New()
+		h.Write([]byte(coefficientDST))
+		h.Write(allHash)
+		h.Write(pubKeys[i])
+		coefficients[i] = h.Sum(nil)[:coefficientBits/8]
+	}
+
+	return pubKeys, coefficients
+}
@@ -0,0 +1,278 @@
+//go:build cgo
+
+package bls12_381_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12_381.PubKeySize)
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12_381.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// another message
+	require.False(t, pubKey.VerifySignature(crypto.CRandBytes(1000), sig))
+
+	// another key
+	otherKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	require.False(t, otherKey.PubKey().VerifySignature(msg, sig))
+
+	// mutated signature
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature(msg, sig[1:]))
+}
+
+func TestNewPrivKeyFromSecret(t *testing.T) {
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+
+	key, err := bls12_381.NewPrivKeyFromSecret(privKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, key.Equals(privKey))
+
+	_, err = bls12_381.NewPrivKeyFromSecret(make([]byte, bls12_381.PrivKeySize))
+	require.Error(t, err)
+	_, err = bls12_381.NewPrivKeyFromSecret(privKey.Bytes()[1:])
+	require.Error(t, err)
+}
+
+// TestDeriveKey checks the test case 0 of EIP-2333.
+func TestDeriveKey(t *testing.T) {
+	seed, err := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	require.NoError(t, err)
+
+	master, err := bls12_381.DeriveKey(seed, nil)
+	require.NoError(t, err)
+	require.Equal(t, "6083874454709270928345386274498605044986640685124978867557563392430687146096", new(big.Int).SetBytes(master).String())
+
+	child, err := bls12_381.DeriveKey(seed, []uint32{0})
+	require.NoError(t, err)
+	require.Equal(t, "20397789859736650942317412262472558107875392172444076792671091975210932703118", new(big.Int).SetBytes(child).String())
+
+	_, err = bls12_381.DeriveKey(seed[:31], nil)
+	require.Error(t, err)
+}
+
+func TestAminoMarshal(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cryptocodec.RegisterCrypto(cdc)
+
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+
+	testCases := []struct {
+		desc string
+		msg  interface{}
+		typ  interface{}
+	}{
+		{"private key", privKey, &bls12_381.PrivKey{}},
+		{"public key", pubKey, &bls12_381.PubKey{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			bz, err := cdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.NoError(t, cdc.Unmarshal(bz, tc.typ))
+			require.Equal(t, tc.msg, tc.typ)
+
+			bz, err = cdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.NoError(t, cdc.UnmarshalJSON(bz, tc.typ))
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
+
+func TestNewAggregatedPubKey(t *testing.T) {
+	_, pubKeys := generateKeys(t, 3)
+
+	_, err := bls12_381.NewAggregatedPubKey(0, pubKeys)
+	require.Error(t, err)
+	_, err = bls12_381.NewAggregatedPubKey(4, pubKeys)
+	require.Error(t, err)
+	_, err = bls12_381.NewAggregatedPubKey(1, append(pubKeys, secp256k1.GenPrivKey().PubKey()))
+	require.Error(t, err)
+	_, err = bls12_381.NewAggregatedPubKey(1, append(pubKeys, &bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)}))
+	require.Error(t, err)
+
+	pk, err := bls12_381.NewAggregatedPubKey(2, pubKeys)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), pk.GetThreshold())
+	require.Equal(t, pubKeys, pk.GetPubKeys())
+
+	other, err := bls12_381.NewAggregatedPubKey(3, pubKeys)
+	require.NoError(t, err)
+	require.False(t, pk.Equals(other))
+	require.NotEqual(t, pk.Address(), other.Address())
+
+	var decoded bls12_381.AggregatedPubKey
+	require.NoError(t, decoded.Unmarshal(pk.Bytes()))
+	require.True(t, pk.Equals(&decoded))
+}
+
+func TestAggregatedPubKeyVerifyMultisignature(t *testing.T) {
+	privKeys, pubKeys := generateKeys(t, 4)
+	pk, err := bls12_381.NewAggregatedPubKey(2, pubKeys)
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	signBytes := func(mode signing.SignMode) ([]byte, error) {
+		return msg, nil
+	}
+
+	sign := func(signers ...int) *signing.MultiSignatureData {
+		sig := multisig.NewMultisig(len(pubKeys))
+		for _, i := range signers {
+			s, err := privKeys[i].Sign(msg)
+			require.NoError(t, err)
+			sig.BitArray.SetIndex(i, true)
+			sig.Signatures = append(sig.Signatures, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: s})
+		}
+
+		return sig
+	}
+
+	testCases := []struct {
+		desc    string
+		sig     func() *signing.MultiSignatureData
+		expPass bool
+	}{
+		{
+			"threshold signers",
+			func() *signing.MultiSignatureData {
+				sig, err := pk.AggregateSignatures(sign(1, 3))
+				require.NoError(t, err)
+				return sig
+			},
+			true,
+		},
+		{
+			"all signers",
+			func() *signing.MultiSignatureData {
+				sig, err := pk.AggregateSignatures(sign(0, 1, 2, 3))
+				require.NoError(t, err)
+				return sig
+			},
+			true,
+		},
+		{
+			"not aggregated",
+			func() *signing.MultiSignatureData { return sign(1, 3) },
+			false,
+		},
+		{
+			"below threshold",
+			func() *signing.MultiSignatureData {
+				_, err := pk.AggregateSignatures(sign(1))
+				require.Error(t, err)
+				return sign(1)
+			},
+			false,
+		},
+		{
+			"wrong bit array",
+			func() *signing.MultiSignatureData {
+				sig, err := pk.AggregateSignatures(sign(1, 3))
+				require.NoError(t, err)
+				sig.BitArray.SetIndex(3, false)
+				sig.BitArray.SetIndex(2, true)
+				return sig
+			},
+			false,
+		},
+		{
+			"wrong bit array size",
+			func() *signing.MultiSignatureData {
+				sig, err := pk.AggregateSignatures(sign(1, 3))
+				require.NoError(t, err)
+				sig.BitArray = types.NewCompactBitArray(len(pubKeys) + 1)
+				sig.BitArray.SetIndex(1, true)
+				sig.BitArray.SetIndex(3, true)
+				return sig
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := pk.VerifyMultisignature(signBytes, tc.sig())
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestAggregatedPubKeyRogueKey checks that a signer cannot choose its public
+// key from the public key of another signer to sign alone for both.
+func TestAggregatedPubKeyRogueKey(t *testing.T) {
+	_, pubKeys := generateKeys(t, 1)
+
+	attacker, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+
+	// rogue = attacker - honest, so that rogue + honest = attacker
+	var rogue blst.P1
+	rogue.FromAffine(new(blst.P1Affine).Uncompress(attacker.PubKey().Bytes()))
+	rogue.SubAssign(new(blst.P1Affine).Uncompress(pubKeys[0].Bytes()))
+	rogueKey := &bls12_381.PubKey{Key: rogue.ToAffine().Compress()}
+
+	pk, err := bls12_381.NewAggregatedPubKey(2, []types.PubKey{pubKeys[0], rogueKey})
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	s, err := attacker.Sign(msg)
+	require.NoError(t, err)
+
+	sig := multisig.NewMultisig(2)
+	sig.BitArray.SetIndex(0, true)
+	sig.BitArray.SetIndex(1, true)
+	sig.Signatures = []signing.SignatureData{&signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: s}}
+
+	err = pk.VerifyMultisignature(func(signing.SignMode) ([]byte, error) { return msg, nil }, sig)
+	require.Error(t, err)
+}
+
+func generateKeys(t *testing.T, n int) ([]*bls12_381.PrivKey, []types.PubKey) {
+	t.Helper()
+
+	privKeys := make([]*bls12_381.PrivKey, n)
+	pubKeys := make([]types.PubKey, n)
+	for i := 0; i < n; i++ {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey()
+	}
+
+	return privKeys, pubKeys
+}
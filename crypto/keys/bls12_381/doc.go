@@ -0,0 +1,10 @@
+// Package bls12_381 implements BLS signatures over the BLS12-381 curve, with
+// public keys in G1 and signatures in G2, and threshold multisig public keys
+// verified with a single aggregated signature.
+//
+// The cryptographic operations are implemented with the blst library, which
+// requires cgo. When built without cgo, Supported is false, the keys are not
+// registered in the crypto codecs, the node refuses to start and the operations
+// requiring the library fail with ErrNotSupported, or panic for the signature
+// verifications.
+package bls12_381
@@ -0,0 +1,163 @@
+//go:build cgo
+
+package bls12_381
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	blst "github.com/supranational/blst/bindings/go"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Supported reports whether the package is built with the blst library.
+const Supported = true
+
+// dst is the domain separation tag of the minimal-pubkey-size proof of
+// possession ciphersuite of the IETF BLS signature draft, as used by Ethereum.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// GenPrivKey generates a new bls12_381 private key. It uses operating system randomness.
+func GenPrivKey() (*PrivKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := rand.Read(ikm); err != nil {
+		return nil, err
+	}
+
+	return &PrivKey{Key: blst.KeyGen(ikm).Serialize()}, nil
+}
+
+// NewPrivKeyFromSecret creates a bls12_381 private key from its big-endian
+// encoded scalar.
+func NewPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	if sk := new(blst.SecretKey).Deserialize(secret); sk == nil || !sk.Valid() {
+		return nil, fmt.Errorf("invalid bls12_381 secret")
+	}
+
+	return &PrivKey{Key: secret}, nil
+}
+
+// DeriveKey derives the private key at the path of child indexes from the
+// seed, following EIP-2333. The seed must be at least 32 bytes long.
+func DeriveKey(seed []byte, path []uint32) ([]byte, error) {
+	sk := blst.DeriveMasterEip2333(seed)
+	if sk == nil {
+		return nil, fmt.Errorf("seed must be at least 32 bytes long, got %d", len(seed))
+	}
+
+	for _, index := range path {
+		sk = sk.DeriveChildEip2333(index)
+	}
+
+	return sk.Serialize(), nil
+}
+
+// Sign signs the message with the private key.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk := new(blst.SecretKey).Deserialize(privKey.Key)
+	if sk == nil {
+		return nil, fmt.Errorf("invalid bls12_381 private key")
+	}
+
+	return new(blst.P2Affine).Sign(sk, msg, dst).Compress(), nil
+}
+
+// PubKey returns the public key of the private key.
+// Panics if the private key is invalid.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	sk := new(blst.SecretKey).Deserialize(privKey.Key)
+	if sk == nil {
+		panic("invalid bls12_381 private key")
+	}
+
+	return &PubKey{Key: new(blst.P1Affine).From(sk).Compress()}
+}
+
+// VerifySignature verifies the signature of the message with the public key.
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	pk := new(blst.P1Affine).Uncompress(pubKey.Key)
+	if pk == nil {
+		return false
+	}
+
+	s := new(blst.P2Affine).Uncompress(sig)
+	if s == nil {
+		return false
+	}
+
+	return s.Verify(true, pk, true, msg, dst)
+}
+
+// validatePubKey checks that the public key is a point of G1 other than the
+// point at infinity.
+func validatePubKey(key []byte) error {
+	pk := new(blst.P1Affine).Uncompress(key)
+	if pk == nil || !pk.KeyValidate() {
+		return fmt.Errorf("invalid bls12_381 public key %X", key)
+	}
+
+	return nil
+}
+
+// verifyAggregatedSignature verifies the signature of the message aggregated
+// from the signatures of the public keys weighted by their coefficients.
+func verifyAggregatedSignature(pubKeys, coefficients [][]byte, msg, sig []byte) bool {
+	if len(pubKeys) == 0 || len(sig) != SignatureSize {
+		return false
+	}
+
+	var aggregated blst.P1
+	for i, key := range pubKeys {
+		pk := new(blst.P1Affine).Uncompress(key)
+		if pk == nil || !pk.KeyValidate() {
+			return false
+		}
+
+		var p blst.P1
+		p.FromAffine(pk)
+		p.MultAssign(coefficients[i], coefficientBits)
+		if i == 0 {
+			aggregated = p
+		} else {
+			aggregated.AddAssign(&p)
+		}
+	}
+
+	s := new(blst.P2Affine).Uncompress(sig)
+	if s == nil {
+		return false
+	}
+
+	return s.Verify(true, aggregated.ToAffine(), true, msg, dst)
+}
+
+// aggregateSignatures aggregates the signatures weighted by their coefficients.
+func aggregateSignatures(sigs, coefficients [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("no signatures to aggregate")
+	}
+
+	var aggregated blst.P2
+	for i, sig := range sigs {
+		s := new(blst.P2Affine).Uncompress(sig)
+		if s == nil || !s.SigValidate(false) {
+			return nil, fmt.Errorf("invalid bls12_381 signature %X", sig)
+		}
+
+		var p blst.P2
+		p.FromAffine(s)
+		p.MultAssign(coefficients[i], coefficientBits)
+		if i == 0 {
+			aggregated = p
+		} else {
+			aggregated.AddAssign(&p)
+		}
+	}
+
+	return aggregated.ToAffine().Compress(), nil
+}
@@ -0,0 +1,54 @@
+//go:build !cgo
+
+package bls12_381
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Supported reports whether the package is built with the blst library.
+const Supported = false
+
+// GenPrivKey returns ErrNotSupported, the blst library requires cgo.
+func GenPrivKey() (*PrivKey, error) {
+	return nil, ErrNotSupported
+}
+
+// NewPrivKeyFromSecret returns ErrNotSupported, the blst library requires cgo.
+func NewPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	return nil, ErrNotSupported
+}
+
+// DeriveKey returns ErrNotSupported, the blst library requires cgo.
+func DeriveKey(seed []byte, path []uint32) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// Sign returns ErrNotSupported, the blst library requires cgo.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// PubKey panics, the blst library requires cgo.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	panic(ErrNotSupported)
+}
+
+// VerifySignature panics, the blst library requires cgo. It does not return
+// false, as rejecting the signatures accepted by the nodes built with cgo would
+// make the node diverge from the network.
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	panic(ErrNotSupported)
+}
+
+func validatePubKey(key []byte) error {
+	return ErrNotSupported
+}
+
+func verifyAggregatedSignature(pubKeys, coefficients [][]byte, msg, sig []byte) bool {
+	panic(ErrNotSupported)
+}
+
+func aggregateSignatures(sigs, coefficients [][]byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
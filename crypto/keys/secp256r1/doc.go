@@ -11,6 +11,9 @@ import (
 )
 
 const (
+	PrivKeyName = "cosmos/PrivKeySecp256r1"
+	PubKeyName  = "cosmos/PubKeySecp256r1"
+
 	// fieldSize is the curve domain size.
 	fieldSize  = 32
 	pubKeySize = fieldSize + 1
@@ -29,7 +32,8 @@ func init() {
 	}
 }
 
-// RegisterInterfaces adds secp256r1 PubKey to pubkey registry
+// RegisterInterfaces adds secp256r1 PubKey and PrivKey to the pubkey and privkey registries
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
 }
@@ -1,6 +1,9 @@
 package secp256r1
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -11,6 +14,21 @@ func GenPrivKey() (*PrivKey, error) {
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromSecret creates a secp256r1 private key from its big-endian
+// encoded secret scalar.
+func NewPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	var sk ecdsaSK
+	if err := sk.Unmarshal(secret); err != nil {
+		return nil, err
+	}
+
+	if sk.D.Sign() == 0 || sk.D.Cmp(secp256r1.Params().N) >= 0 {
+		return nil, fmt.Errorf("invalid secp256r1 secret")
+	}
+
+	return &PrivKey{&sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -48,6 +66,32 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	sk, err := NewPrivKeyFromSecret(bz)
+	if err != nil {
+		return err
+	}
+	m.Secret = sk.Secret
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
@@ -64,3 +108,19 @@ func (sk *ecdsaSK) Size() int {
 func (sk *ecdsaSK) Unmarshal(bz []byte) error {
 	return sk.PrivKey.Unmarshal(bz, secp256r1, fieldSize)
 }
+
+// MarshalJSON implements json.Marshaler interface, the key is encoded as
+// its bytes, as a protobuf bytes field.
+func (sk ecdsaSK) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sk.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (sk *ecdsaSK) UnmarshalJSON(bz []byte) error {
+	var key []byte
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return err
+	}
+
+	return sk.Unmarshal(key)
+}
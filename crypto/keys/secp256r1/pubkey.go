@@ -1,6 +1,8 @@
 package secp256r1
 
 import (
+	"encoding/json"
+
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -45,6 +47,32 @@ func (m *PubKey) VerifySignature(msg, sig []byte) bool {
 	return m.Key.VerifySignature(msg, sig)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PubKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PubKey) UnmarshalAmino(bz []byte) error {
+	var pk ecdsaPK
+	if err := pk.Unmarshal(bz); err != nil {
+		return err
+	}
+	m.Key = &pk
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PubKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaPK struct {
 	ecdsa.PubKey
 }
@@ -61,3 +89,19 @@ func (pk *ecdsaPK) Size() int {
 func (pk *ecdsaPK) Unmarshal(bz []byte) error {
 	return pk.PubKey.Unmarshal(bz, secp256r1, pubKeySize)
 }
+
+// MarshalJSON implements json.Marshaler interface, the key is encoded as
+// its bytes, as a protobuf bytes field.
+func (pk ecdsaPK) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pk.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (pk *ecdsaPK) UnmarshalJSON(bz []byte) error {
+	var key []byte
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return err
+	}
+
+	return pk.Unmarshal(key)
+}
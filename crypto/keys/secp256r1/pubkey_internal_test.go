@@ -118,6 +118,32 @@ func (suite *PKSuite) TestMarshalProto() {
 	require.Error(emptyCodec.UnmarshalInterface(bz, nil), "nil should fail")
 }
 
+func (suite *PKSuite) TestMarshalJSON() {
+	require := suite.Require()
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	bz, err := cdc.MarshalJSON(suite.pk)
+	require.NoError(err)
+
+	var pk PubKey
+	require.NoError(cdc.UnmarshalJSON(bz, &pk))
+	require.True(pk.Equals(suite.pk))
+}
+
+func (suite *PKSuite) TestMarshalAmino() {
+	require := suite.Require()
+
+	bz, err := suite.pk.MarshalAmino()
+	require.NoError(err)
+	require.Equal(suite.pk.Bytes(), bz)
+
+	var pk PubKey
+	require.NoError(pk.UnmarshalAmino(bz))
+	require.True(pk.Equals(suite.pk))
+
+	require.Error(pk.UnmarshalAmino(bz[1:]))
+}
+
 func (suite *PKSuite) TestSize() {
 	require := suite.Require()
 	var pk ecdsaPK
@@ -5,946 +5,707 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"strconv"
-	"strings"
-	"testing"
+
+	"github.com/cockroachdb/apd/v3"
 )
 
-// NOTE: never use new(Dec) or else we will panic unmarshalling into the
-// nil embedded big.Int
-type LegacyDec struct {
-	i *big.Int
+// Dec is an arbitrary-precision decimal number, represented as a coefficient
+// and a base 10 exponent. Unlike LegacyDec, the results of the operations are
+// correctly rounded to a configurable number of significant digits, with an
+// explicit rounding mode, and operations fail rather than silently truncating
+// when their result is out of range. NaN and infinite values are not
+// representable.
+//
+// The zero value of Dec is 0. Values of Dec are immutable: operations always
+// return a new Dec.
+type Dec struct {
+	dec apd.Decimal
 }
 
+// RoundingMode is the rounding mode of the decimal operations whose exact
+// result cannot be represented.
+type RoundingMode uint8
+
 const (
-	// number of decimal places
-	LegacyPrecision = 18
+	// RoundHalfEven rounds to the nearest representable value, and ties to
+	// the value with an even last digit. It is unbiased, hence it is the
+	// default rounding mode.
+	RoundHalfEven RoundingMode = iota
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundTruncate rounds towards zero, as LegacyDec does.
+	RoundTruncate
+)
 
-	// bits required to represent the above precision
-	// Ceiling[Log2[10^Precision - 1]]
-	LegacyDecimalPrecisionBits = 60
+var roundingModeNames = map[RoundingMode]string{
+	RoundHalfEven: "half_even",
+	RoundFloor:    "floor",
+	RoundCeiling:  "ceiling",
+	RoundTruncate: "truncate",
+}
 
-	// decimalTruncateBits is the minimum number of bits removed
-	// by a truncate operation. It is equal to
-	// Floor[Log2[10^Precision - 1]].
-	decimalTruncateBits = LegacyDecimalPrecisionBits - 1
+var roundingModeRounders = map[RoundingMode]apd.Rounder{
+	RoundHalfEven: apd.RoundHalfEven,
+	RoundFloor:    apd.RoundFloor,
+	RoundCeiling:  apd.RoundCeiling,
+	RoundTruncate: apd.RoundDown,
+}
 
-	maxDecBitLen = MaxBitLen + decimalTruncateBits
+// String implements the Stringer interface.
+func (m RoundingMode) String() string {
+	if name, ok := roundingModeNames[m]; ok {
+		return name
+	}
 
-	// max number of iterations in ApproxRoot function
-	maxApproxRootIterations = 300
-)
+	return fmt.Sprintf("RoundingMode(%d)", m)
+}
 
-var (
-	precisionReuse       = new(big.Int).Exp(big.NewInt(10), big.NewInt(LegacyPrecision), nil)
-	fivePrecision        = new(big.Int).Quo(precisionReuse, big.NewInt(2))
-	precisionMultipliers []*big.Int
-	zeroInt              = big.NewInt(0)
-	oneInt               = big.NewInt(1)
-	tenInt               = big.NewInt(10)
+const (
+	// DefaultDecPrecision is the number of significant digits of the results
+	// of the operations of the default decimal context, as in the IEEE 754
+	// decimal128 format.
+	DefaultDecPrecision = 34
+
+	// DefaultDecMaxExponent is the largest exponent of a decimal, in
+	// scientific notation, in the default decimal context.
+	DefaultDecMaxExponent = 1000
+
+	// DefaultDecMinExponent is the smallest exponent of a decimal, in
+	// scientific notation, in the default decimal context.
+	DefaultDecMinExponent = -1000
+
+	// maxPowerDigits is the maximum number of digits of the exact coefficient
+	// computed by a power, before its rounding.
+	maxPowerDigits = 10000
 )
 
 // Decimal errors
 var (
-	ErrLegacyEmptyDecimalStr      = errors.New("decimal string cannot be empty")
-	ErrLegacyInvalidDecimalLength = errors.New("invalid decimal length")
-	ErrLegacyInvalidDecimalStr    = errors.New("invalid decimal string")
+	ErrInvalidDec        = errors.New("invalid decimal")
+	ErrDecOutOfRange     = errors.New("decimal out of range")
+	ErrDecDivisionByZero = errors.New("decimal division by zero")
+	ErrInvalidDecContext = errors.New("invalid decimal context")
 )
 
-// Set precision multipliers
-func init() {
-	precisionMultipliers = make([]*big.Int, LegacyPrecision+1)
-	for i := 0; i <= LegacyPrecision; i++ {
-		precisionMultipliers[i] = calcPrecisionMultiplier(int64(i))
-	}
+// DecContext holds the parameters of the decimal operations: the precision
+// and rounding mode of their results, and the range of their exponents.
+type DecContext struct {
+	// Precision is the maximum number of significant digits of the results
+	// of the operations, which are rounded to this number of digits.
+	Precision uint32
+	// Rounding is the rounding mode of the results of the operations.
+	Rounding RoundingMode
+	// MinExponent is the smallest exponent of a decimal in scientific
+	// notation, i.e. of its most significant digit. Operations with a non
+	// zero result with a smaller exponent fail with ErrDecOutOfRange.
+	MinExponent int32
+	// MaxExponent is the largest exponent of a decimal in scientific
+	// notation. Operations with a result with a larger exponent fail with
+	// ErrDecOutOfRange.
+	MaxExponent int32
 }
 
-func precisionInt() *big.Int {
-	return new(big.Int).Set(precisionReuse)
+// DefaultDecContext is the decimal context used by the methods of Dec.
+var DefaultDecContext = DecContext{
+	Precision:   DefaultDecPrecision,
+	Rounding:    RoundHalfEven,
+	MinExponent: DefaultDecMinExponent,
+	MaxExponent: DefaultDecMaxExponent,
 }
 
-func LegacyZeroDec() LegacyDec     { return LegacyDec{new(big.Int).Set(zeroInt)} }
-func LegacyOneDec() LegacyDec      { return LegacyDec{precisionInt()} }
-func LegacySmallestDec() LegacyDec { return LegacyDec{new(big.Int).Set(oneInt)} }
-
-// calculate the precision multiplier
-func calcPrecisionMultiplier(prec int64) *big.Int {
-	if prec < 0 {
-		panic(fmt.Sprintf("negative precision %v", prec))
-	}
+// WithPrecision returns a copy of the context with the given precision.
+func (c DecContext) WithPrecision(precision uint32) DecContext {
+	c.Precision = precision
+	return c
+}
 
-	if prec > LegacyPrecision {
-		panic(fmt.Sprintf("too much precision, maximum %v, provided %v", LegacyPrecision, prec))
-	}
-	zerosToAdd := LegacyPrecision - prec
-	multiplier := new(big.Int).Exp(tenInt, big.NewInt(zerosToAdd), nil)
-	return multiplier
+// WithRounding returns a copy of the context with the given rounding mode.
+func (c DecContext) WithRounding(rounding RoundingMode) DecContext {
+	c.Rounding = rounding
+	return c
 }
 
-// get the precision multiplier, do not mutate result
-func precisionMultiplier(prec int64) *big.Int {
-	if prec < 0 {
-		panic(fmt.Sprintf("negative precision %v", prec))
+// Validate returns an error if the parameters of the context are invalid.
+func (c DecContext) Validate() error {
+	if c.Precision == 0 {
+		return fmt.Errorf("%w: precision must be positive", ErrInvalidDecContext)
 	}
 
-	if prec > LegacyPrecision {
-		panic(fmt.Sprintf("too much precision, maximum %v, provided %v", LegacyPrecision, prec))
+	if _, ok := roundingModeRounders[c.Rounding]; !ok {
+		return fmt.Errorf("%w: unknown rounding mode %s", ErrInvalidDecContext, c.Rounding)
 	}
-	return precisionMultipliers[prec]
-}
 
-// create a new Dec from integer assuming whole number
-func LegacyNewDec(i int64) LegacyDec {
-	return LegacyNewDecWithPrec(i, 0)
-}
+	if c.MinExponent > 0 || c.MaxExponent < 0 {
+		return fmt.Errorf("%w: exponent range [%d, %d] must contain 0", ErrInvalidDecContext, c.MinExponent, c.MaxExponent)
+	}
 
-// create a new Dec from integer with decimal place at prec
-// CONTRACT: prec <= Precision
-func LegacyNewDecWithPrec(i, prec int64) LegacyDec {
-	return LegacyDec{
-		new(big.Int).Mul(big.NewInt(i), precisionMultiplier(prec)),
+	if c.MinExponent < apd.MinExponent || c.MaxExponent > apd.MaxExponent {
+		return fmt.Errorf("%w: exponent range [%d, %d] exceeds [%d, %d]", ErrInvalidDecContext,
+			c.MinExponent, c.MaxExponent, apd.MinExponent, apd.MaxExponent)
 	}
-}
 
-// create a new Dec from big integer assuming whole numbers
-// CONTRACT: prec <= Precision
-func LegacyNewDecFromBigInt(i *big.Int) LegacyDec {
-	return LegacyNewDecFromBigIntWithPrec(i, 0)
+	return nil
 }
 
-// create a new Dec from big integer assuming whole numbers
-// CONTRACT: prec <= Precision
-func LegacyNewDecFromBigIntWithPrec(i *big.Int, prec int64) LegacyDec {
-	return LegacyDec{
-		new(big.Int).Mul(i, precisionMultiplier(prec)),
+// apdContext returns the apd context of the decimal context.
+func (c DecContext) apdContext() (*apd.Context, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
 	}
-}
 
-// create a new Dec from big integer assuming whole numbers
-// CONTRACT: prec <= Precision
-func LegacyNewDecFromInt(i Int) LegacyDec {
-	return LegacyNewDecFromIntWithPrec(i, 0)
+	return &apd.Context{
+		Precision:   c.Precision,
+		MaxExponent: c.MaxExponent,
+		MinExponent: c.MinExponent,
+		Traps:       apd.DefaultTraps,
+		Rounding:    roundingModeRounders[c.Rounding],
+	}, nil
 }
 
-// create a new Dec from big integer with decimal place at prec
-// CONTRACT: prec <= Precision
-func LegacyNewDecFromIntWithPrec(i Int, prec int64) LegacyDec {
-	return LegacyDec{
-		new(big.Int).Mul(i.BigInt(), precisionMultiplier(prec)),
+// apply computes the result of an operation with the apd context of the
+// decimal context.
+func (c DecContext) apply(op func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error)) (Dec, error) {
+	ctx, err := c.apdContext()
+	if err != nil {
+		return Dec{}, err
 	}
-}
 
-// create a decimal from an input decimal string.
-// valid must come in the form:
-//
-//	(-) whole integers (.) decimal integers
-//
-// examples of acceptable input include:
-//
-//	-123.456
-//	456.7890
-//	345
-//	-456789
-//
-// NOTE - An error will return if more decimal places
-// are provided in the string than the constant Precision.
-//
-// CONTRACT - This function does not mutate the input str.
-func LegacyNewDecFromStr(str string) (LegacyDec, error) {
-	// first extract any negative symbol
-	neg := false
-	if len(str) > 0 && str[0] == '-' {
-		neg = true
-		str = str[1:]
+	var z Dec
+	cond, err := op(ctx, &z.dec)
+	if err != nil {
+		return Dec{}, conditionError(cond, err)
 	}
 
-	if len(str) == 0 {
-		return LegacyDec{}, ErrLegacyEmptyDecimalStr
+	if z.dec.Form != apd.Finite {
+		return Dec{}, fmt.Errorf("%w: non finite result", ErrInvalidDec)
 	}
 
-	strs := strings.Split(str, ".")
-	lenDecs := 0
-	combinedStr := strs[0]
+	return z.normalized(), nil
+}
 
-	if len(strs) == 2 { // has a decimal place
-		lenDecs = len(strs[1])
-		if lenDecs == 0 || len(combinedStr) == 0 {
-			return LegacyDec{}, ErrLegacyInvalidDecimalLength
-		}
-		combinedStr += strs[1]
-	} else if len(strs) > 2 {
-		return LegacyDec{}, ErrLegacyInvalidDecimalStr
+// conditionError converts the error of an apd operation to a decimal error.
+func conditionError(cond apd.Condition, err error) error {
+	switch {
+	case cond&(apd.DivisionByZero|apd.DivisionUndefined) != 0:
+		return fmt.Errorf("%w: %s", ErrDecDivisionByZero, err)
+	case cond&(apd.Overflow|apd.Underflow|apd.Subnormal|apd.SystemOverflow|apd.SystemUnderflow) != 0:
+		return fmt.Errorf("%w: %s", ErrDecOutOfRange, err)
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidDec, err)
 	}
+}
 
-	if lenDecs > LegacyPrecision {
-		return LegacyDec{}, fmt.Errorf("value '%s' exceeds max precision by %d decimal places: max precision %d", str, LegacyPrecision-lenDecs, LegacyPrecision)
+// checkRange returns an error if the exponent of a decimal is out of the range
+// of the context, or if its least significant digit is smaller than the
+// smallest digit of a decimal of the context.
+func (c DecContext) checkRange(x Dec) error {
+	if err := c.Validate(); err != nil {
+		return err
 	}
 
-	// add some extra zero's to correct to the Precision factor
-	zerosToAdd := LegacyPrecision - lenDecs
-	zeros := strings.Repeat("0", zerosToAdd)
-	combinedStr += zeros
-
-	combined, ok := new(big.Int).SetString(combinedStr, 10) // base 10
-	if !ok {
-		return LegacyDec{}, fmt.Errorf("failed to set decimal string with base 10: %s", combinedStr)
-	}
-	if combined.BitLen() > maxDecBitLen {
-		return LegacyDec{}, fmt.Errorf("decimal '%s' out of range; bitLen: got %d, max %d", str, combined.BitLen(), maxDecBitLen)
-	}
-	if neg {
-		combined = new(big.Int).Neg(combined)
+	if x.dec.IsZero() {
+		return nil
 	}
 
-	return LegacyDec{combined}, nil
-}
+	var reduced apd.Decimal
+	reduced.Reduce(&x.dec)
 
-// Decimal from string, panic on error
-func LegacyMustNewDecFromStr(s string) LegacyDec {
-	dec, err := LegacyNewDecFromStr(s)
-	if err != nil {
-		panic(err)
+	adjusted := int64(reduced.Exponent) + reduced.NumDigits() - 1
+	if adjusted > int64(c.MaxExponent) || adjusted < int64(c.MinExponent) {
+		return fmt.Errorf("%w: exponent %d out of range [%d, %d]", ErrDecOutOfRange, adjusted, c.MinExponent, c.MaxExponent)
 	}
-	return dec
-}
-
-func (d LegacyDec) IsNil() bool                { return d.i == nil }                       // is decimal nil
-func (d LegacyDec) IsZero() bool               { return (d.i).Sign() == 0 }                // is equal to zero
-func (d LegacyDec) IsNegative() bool           { return (d.i).Sign() == -1 }               // is negative
-func (d LegacyDec) IsPositive() bool           { return (d.i).Sign() == 1 }                // is positive
-func (d LegacyDec) Equal(d2 LegacyDec) bool    { return (d.i).Cmp(d2.i) == 0 }             // equal decimals
-func (d LegacyDec) GT(d2 LegacyDec) bool       { return (d.i).Cmp(d2.i) > 0 }              // greater than
-func (d LegacyDec) GTE(d2 LegacyDec) bool      { return (d.i).Cmp(d2.i) >= 0 }             // greater than or equal
-func (d LegacyDec) LT(d2 LegacyDec) bool       { return (d.i).Cmp(d2.i) < 0 }              // less than
-func (d LegacyDec) LTE(d2 LegacyDec) bool      { return (d.i).Cmp(d2.i) <= 0 }             // less than or equal
-func (d LegacyDec) Neg() LegacyDec             { return LegacyDec{new(big.Int).Neg(d.i)} } // reverse the decimal sign
-func (d LegacyDec) NegMut() LegacyDec          { d.i.Neg(d.i); return d }                  // reverse the decimal sign, mutable
-func (d LegacyDec) Abs() LegacyDec             { return LegacyDec{new(big.Int).Abs(d.i)} } // absolute value
-func (d LegacyDec) AbsMut() LegacyDec          { d.i.Abs(d.i); return d }                  // absolute value, mutable
-func (d LegacyDec) Set(d2 LegacyDec) LegacyDec { d.i.Set(d2.i); return d }                 // set to existing dec value
-func (d LegacyDec) Clone() LegacyDec           { return LegacyDec{new(big.Int).Set(d.i)} } // clone new dec
-
-// BigInt returns a copy of the underlying big.Int.
-func (d LegacyDec) BigInt() *big.Int {
-	if d.IsNil() {
-		return nil
+
+	if tiny := int64(c.MinExponent) - int64(c.Precision) + 1; int64(reduced.Exponent) < tiny {
+		return fmt.Errorf("%w: digit of exponent %d smaller than %d", ErrDecOutOfRange, reduced.Exponent, tiny)
 	}
 
-	cp := new(big.Int)
-	return cp.Set(d.i)
+	return nil
 }
 
-func (d LegacyDec) ImmutOp(op func(LegacyDec, LegacyDec) LegacyDec, d2 LegacyDec) LegacyDec {
-	return op(d.Clone(), d2)
+// Add returns x + y.
+func (c DecContext) Add(x, y Dec) (Dec, error) {
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		return ctx.Add(d, &x.dec, &y.dec)
+	})
 }
 
-func (d LegacyDec) ImmutOpInt(op func(LegacyDec, Int) LegacyDec, d2 Int) LegacyDec {
-	return op(d.Clone(), d2)
+// Sub returns x - y.
+func (c DecContext) Sub(x, y Dec) (Dec, error) {
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		return ctx.Sub(d, &x.dec, &y.dec)
+	})
 }
 
-func (d LegacyDec) ImmutOpInt64(op func(LegacyDec, int64) LegacyDec, d2 int64) LegacyDec {
-	// TODO: use already allocated operand bigint to avoid
-	// newint each time, add mutex for race condition
-	// Issue: https://github.com/cosmos/cosmos-sdk/issues/11166
-	return op(d.Clone(), d2)
+// Mul returns x * y.
+func (c DecContext) Mul(x, y Dec) (Dec, error) {
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		return ctx.Mul(d, &x.dec, &y.dec)
+	})
 }
 
-func (d LegacyDec) SetInt64(i int64) LegacyDec {
-	d.i.SetInt64(i)
-	d.i.Mul(d.i, precisionReuse)
-	return d
+// Quo returns x / y. It fails with ErrDecDivisionByZero if y is zero.
+func (c DecContext) Quo(x, y Dec) (Dec, error) {
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		return ctx.Quo(d, &x.dec, &y.dec)
+	})
 }
 
-// addition
-func (d LegacyDec) Add(d2 LegacyDec) LegacyDec {
-	return d.ImmutOp(LegacyDec.AddMut, d2)
+// QuoInteger returns the integer part of x / y, truncated towards zero,
+// regardless of the rounding mode of the context. It fails with
+// ErrDecDivisionByZero if y is zero, and with ErrInvalidDec if the integer
+// part has more digits than the precision of the context.
+func (c DecContext) QuoInteger(x, y Dec) (Dec, error) {
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		return ctx.QuoInteger(d, &x.dec, &y.dec)
+	})
 }
 
-// mutable addition
-func (d LegacyDec) AddMut(d2 LegacyDec) LegacyDec {
-	d.i.Add(d.i, d2.i)
-
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
-	}
-	return d
+// Rem returns the remainder of the division of x by y, x - y * QuoInteger(x, y),
+// which has the sign of x. It fails with ErrDecDivisionByZero if y is zero.
+func (c DecContext) Rem(x, y Dec) (Dec, error) {
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		return ctx.Rem(d, &x.dec, &y.dec)
+	})
 }
 
-// subtraction
-func (d LegacyDec) Sub(d2 LegacyDec) LegacyDec {
-	return d.ImmutOp(LegacyDec.SubMut, d2)
-}
-
-// mutable subtraction
-func (d LegacyDec) SubMut(d2 LegacyDec) LegacyDec {
-	d.i.Sub(d.i, d2.i)
+// Power returns x to the given integer power. The result is computed exactly
+// before being rounded once, hence it is correctly rounded. It fails with
+// ErrDecDivisionByZero if x is zero and the power is negative, and with
+// ErrDecOutOfRange if the exact power has more than 10000 digits.
+func (c DecContext) Power(x Dec, power int64) (Dec, error) {
+	var base apd.Decimal
+	base.Reduce(&x.dec)
 
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
+	// magnitude of the power, computed without overflowing for math.MinInt64
+	n := uint64(power)
+	if power < 0 {
+		n = uint64(-(power + 1)) + 1
 	}
-	return d
-}
-
-// multiplication
-func (d LegacyDec) Mul(d2 LegacyDec) LegacyDec {
-	return d.ImmutOp(LegacyDec.MulMut, d2)
-}
-
-// mutable multiplication
-func (d LegacyDec) MulMut(d2 LegacyDec) LegacyDec {
-	d.i.Mul(d.i, d2.i)
-	chopped := chopPrecisionAndRound(d.i)
-
-	if chopped.BitLen() > maxDecBitLen {
-		panic("Int overflow")
+	if !base.IsZero() && n > uint64(maxPowerDigits/base.NumDigits()) {
+		return Dec{}, fmt.Errorf("%w: exact power %d of %s exceeds %d digits", ErrDecOutOfRange, power, x, maxPowerDigits)
 	}
-	*d.i = *chopped
-	return d
-}
-
-// multiplication truncate
-func (d LegacyDec) MulTruncate(d2 LegacyDec) LegacyDec {
-	return d.ImmutOp(LegacyDec.MulTruncateMut, d2)
-}
-
-// mutable multiplication truncage
-func (d LegacyDec) MulTruncateMut(d2 LegacyDec) LegacyDec {
-	d.i.Mul(d.i, d2.i)
-	chopPrecisionAndTruncate(d.i)
 
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
+	// exact power, by squaring
+	exact := apd.New(1, 0)
+	exactCtx := apd.BaseContext
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			if _, err := exactCtx.Mul(exact, exact, &base); err != nil {
+				return Dec{}, conditionError(0, err)
+			}
+		}
+		if n > 1 {
+			if _, err := exactCtx.Mul(&base, &base, &base); err != nil {
+				return Dec{}, conditionError(0, err)
+			}
+		}
 	}
-	return d
-}
 
-// multiplication
-func (d LegacyDec) MulInt(i Int) LegacyDec {
-	return d.ImmutOpInt(LegacyDec.MulIntMut, i)
-}
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		if power < 0 {
+			return ctx.Quo(d, apd.New(1, 0), exact)
+		}
 
-func (d LegacyDec) MulIntMut(i Int) LegacyDec {
-	d.i.Mul(d.i, i.BigInt())
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
-	}
-	return d
+		return ctx.Round(d, exact)
+	})
 }
 
-// MulInt64 - multiplication with int64
-func (d LegacyDec) MulInt64(i int64) LegacyDec {
-	return d.ImmutOpInt64(LegacyDec.MulInt64Mut, i)
+// Round returns x rounded to the precision of the context. It fails with
+// ErrDecOutOfRange if the exponent of x is out of the range of the context.
+func (c DecContext) Round(x Dec) (Dec, error) {
+	return c.apply(func(ctx *apd.Context, d *apd.Decimal) (apd.Condition, error) {
+		return ctx.Round(d, &x.dec)
+	})
 }
 
-func (d LegacyDec) MulInt64Mut(i int64) LegacyDec {
-	d.i.Mul(d.i, big.NewInt(i))
-
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
+// Quantize returns x rounded to the given number of decimal places, with the
+// rounding mode of the context. The result is not limited to the precision of
+// the context. It fails with ErrDecOutOfRange if the exponent of the result is
+// out of the range of the context.
+func (c DecContext) Quantize(x Dec, places uint32) (Dec, error) {
+	if err := c.Validate(); err != nil {
+		return Dec{}, err
 	}
-	return d
-}
-
-// quotient
-func (d LegacyDec) Quo(d2 LegacyDec) LegacyDec {
-	return d.ImmutOp(LegacyDec.QuoMut, d2)
-}
-
-var squaredPrecisionReuse = new(big.Int).Mul(precisionReuse, precisionReuse)
 
-// mutable quotient
-func (d LegacyDec) QuoMut(d2 LegacyDec) LegacyDec {
-	// multiply by precision twice
-	d.i.Mul(d.i, squaredPrecisionReuse)
-	d.i.Quo(d.i, d2.i)
-
-	chopPrecisionAndRound(d.i)
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
+	exp := -int64(places)
+	if exp < apd.MinExponent {
+		return Dec{}, fmt.Errorf("%w: %d decimal places", ErrDecOutOfRange, places)
 	}
-	return d
-}
-
-// quotient truncate
-func (d LegacyDec) QuoTruncate(d2 LegacyDec) LegacyDec {
-	return d.ImmutOp(LegacyDec.QuoTruncateMut, d2)
-}
 
-// mutable quotient truncate
-func (d LegacyDec) QuoTruncateMut(d2 LegacyDec) LegacyDec {
-	// multiply precision twice
-	d.i.Mul(d.i, squaredPrecisionReuse)
-	d.i.Quo(d.i, d2.i)
-
-	chopPrecisionAndTruncate(d.i)
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
+	var z Dec
+	if int64(x.dec.Exponent) >= exp {
+		z.dec.Set(&x.dec)
+	} else {
+		coeff := roundBigInt(x.dec.Coeff.MathBigInt(), x.dec.Negative, uint64(exp-int64(x.dec.Exponent)), c.Rounding)
+		z.dec.Coeff.SetMathBigInt(coeff)
+		z.dec.Exponent = int32(exp)
+		z.dec.Negative = x.dec.Negative
 	}
-	return d
-}
-
-// quotient, round up
-func (d LegacyDec) QuoRoundUp(d2 LegacyDec) LegacyDec {
-	return d.ImmutOp(LegacyDec.QuoRoundupMut, d2)
-}
-
-// mutable quotient, round up
-func (d LegacyDec) QuoRoundupMut(d2 LegacyDec) LegacyDec {
-	// multiply precision twice
-	d.i.Mul(d.i, squaredPrecisionReuse)
-	d.i.Quo(d.i, d2.i)
 
-	chopPrecisionAndRoundUp(d.i)
-	if d.i.BitLen() > maxDecBitLen {
-		panic("Int overflow")
+	z = z.normalized()
+	if err := c.checkRange(z); err != nil {
+		return Dec{}, err
 	}
-	return d
-}
-
-// quotient
-func (d LegacyDec) QuoInt(i Int) LegacyDec {
-	return d.ImmutOpInt(LegacyDec.QuoIntMut, i)
-}
-
-func (d LegacyDec) QuoIntMut(i Int) LegacyDec {
-	d.i.Quo(d.i, i.BigInt())
-	return d
-}
 
-// QuoInt64 - quotient with int64
-func (d LegacyDec) QuoInt64(i int64) LegacyDec {
-	return d.ImmutOpInt64(LegacyDec.QuoInt64Mut, i)
+	return z, nil
 }
 
-func (d LegacyDec) QuoInt64Mut(i int64) LegacyDec {
-	d.i.Quo(d.i, big.NewInt(i))
-	return d
-}
+// roundBigInt returns the absolute value of a decimal coefficient divided by
+// 10^digits, rounded to an integer with the given rounding mode. neg is the
+// sign of the decimal.
+func roundBigInt(coeff *big.Int, neg bool, digits uint64, mode RoundingMode) *big.Int {
+	divisor := new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(digits), nil)
+	quo, rem := new(big.Int).QuoRem(coeff, divisor, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
 
-// ApproxRoot returns an approximate estimation of a Dec's positive real nth root
-// using Newton's method (where n is positive). The algorithm starts with some guess and
-// computes the sequence of improved guesses until an answer converges to an
-// approximate answer.  It returns `|d|.ApproxRoot() * -1` if input is negative.
-// A maximum number of 100 iterations is used a backup boundary condition for
-// cases where the answer never converges enough to satisfy the main condition.
-func (d LegacyDec) ApproxRoot(root uint64) (guess LegacyDec, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			var ok bool
-			err, ok = r.(error)
-			if !ok {
-				err = errors.New("out of bounds")
-			}
+	var addOne bool
+	switch mode {
+	case RoundFloor:
+		addOne = neg
+	case RoundCeiling:
+		addOne = !neg
+	case RoundTruncate:
+		addOne = false
+	default:
+		// compare the remainder to half of the divisor
+		switch rem.Lsh(rem, 1).Cmp(divisor) {
+		case 1:
+			addOne = true
+		case 0:
+			addOne = quo.Bit(0) == 1
 		}
-	}()
-
-	if d.IsNegative() {
-		absRoot, err := d.Neg().ApproxRoot(root)
-		return absRoot.NegMut(), err
 	}
 
-	// One decimal, that we invalidate later. Helps us save a heap allocation.
-	scratchOneDec := LegacyOneDec()
-	if root == 1 || d.IsZero() || d.Equal(scratchOneDec) {
-		return d, nil
+	if addOne {
+		quo.Add(quo, oneInt)
 	}
 
-	if root == 0 {
-		return scratchOneDec, nil
-	}
+	return quo
+}
 
-	guess, delta := scratchOneDec, LegacyOneDec()
-	smallestDec := LegacySmallestDec()
+// NewDecFromInt64 returns a new Dec from an int64.
+func NewDecFromInt64(x int64) Dec {
+	var z Dec
+	z.dec.SetInt64(x)
+	return z
+}
 
-	for iter := 0; delta.AbsMut().GT(smallestDec) && iter < maxApproxRootIterations; iter++ {
-		// Set prev = guess^{root - 1}, with an optimization for sqrt
-		// where root=2 => prev = guess. (And thus no extra heap allocations)
-		prev := guess
-		if root != 2 {
-			prev = guess.Power(root - 1)
-		}
-		if prev.IsZero() {
-			prev = smallestDec
-		}
-		delta.Set(d).QuoMut(prev)
-		delta.SubMut(guess)
-		// delta = delta / root.
-		// We optimize for sqrt, where root=2 => delta = delta >> 1
-		if root == 2 {
-			delta.i.Rsh(delta.i, 1)
-		} else {
-			delta.QuoInt64Mut(int64(root))
-		}
+// NewDecWithExp returns a new Dec with the value coeff * 10^exp. It fails with
+// ErrDecOutOfRange if the exponent of the value is out of the range of the
+// default decimal context.
+func NewDecWithExp(coeff int64, exp int32) (Dec, error) {
+	var z Dec
+	z.dec.SetFinite(coeff, exp)
+	z = z.normalized()
 
-		guess.AddMut(delta)
+	if err := DefaultDecContext.checkRange(z); err != nil {
+		return Dec{}, err
 	}
 
-	return guess, nil
-}
-
-// Power returns a the result of raising to a positive integer power
-func (d LegacyDec) Power(power uint64) LegacyDec {
-	res := LegacyDec{new(big.Int).Set(d.i)}
-	return res.PowerMut(power)
+	return z, nil
 }
 
-func (d LegacyDec) PowerMut(power uint64) LegacyDec {
-	if power == 0 {
-		// Set to 1 with the correct precision.
-		d.i.Set(precisionReuse)
-		return d
+// NewDecFromString parses a decimal string, in plain or scientific notation,
+// e.g. "-123.456" or "1.5E-3". The exact value of the string is kept, it is not
+// rounded. NaN and infinite values are invalid, and it fails with
+// ErrDecOutOfRange if the exponent of the value is out of the range of the
+// default decimal context.
+func NewDecFromString(s string) (Dec, error) {
+	d, _, err := apd.NewFromString(s)
+	if err != nil {
+		return Dec{}, fmt.Errorf("%w: %q: %s", ErrInvalidDec, s, err)
 	}
-	tmp := LegacyOneDec()
 
-	for i := power; i > 1; {
-		if i%2 != 0 {
-			tmp.MulMut(d)
-		}
-		i /= 2
-		d.MulMut(d)
+	if d.Form != apd.Finite {
+		return Dec{}, fmt.Errorf("%w: %q is not finite", ErrInvalidDec, s)
 	}
 
-	return d.MulMut(tmp)
-}
+	var z Dec
+	z.dec.Reduce(d)
+	z = z.normalized()
 
-// ApproxSqrt is a wrapper around ApproxRoot for the common special case
-// of finding the square root of a number. It returns -(sqrt(abs(d)) if input is negative.
-func (d LegacyDec) ApproxSqrt() (LegacyDec, error) {
-	return d.ApproxRoot(2)
-}
+	if err := DefaultDecContext.checkRange(z); err != nil {
+		return Dec{}, err
+	}
 
-// is integer, e.g. decimals are zero
-func (d LegacyDec) IsInteger() bool {
-	return new(big.Int).Rem(d.i, precisionReuse).Sign() == 0
+	return z, nil
 }
 
-// format decimal state
-func (d LegacyDec) Format(s fmt.State, verb rune) {
-	_, err := s.Write([]byte(d.String()))
+// MustNewDecFromString is like NewDecFromString but panics on error.
+func MustNewDecFromString(s string) Dec {
+	d, err := NewDecFromString(s)
 	if err != nil {
 		panic(err)
 	}
+
+	return d
 }
 
-func (d LegacyDec) String() string {
-	if d.i == nil {
-		return d.i.String()
+// NewDecFromInt returns a new Dec with the exact value of an Int.
+func NewDecFromInt(i Int) Dec {
+	var z Dec
+	if i.IsNil() {
+		return z
 	}
 
-	isNeg := d.IsNegative()
+	z.dec.Coeff.SetMathBigInt(new(big.Int).Abs(i.BigInt()))
+	z.dec.Negative = i.IsNegative()
+	return z.normalized()
+}
 
-	if isNeg {
-		d = d.Neg()
+// NewDecFromLegacyDec returns a new Dec with the exact value of a LegacyDec.
+func NewDecFromLegacyDec(d LegacyDec) Dec {
+	var z Dec
+	if d.IsNil() {
+		return z
 	}
 
-	bzInt, err := d.i.MarshalText()
-	if err != nil {
-		return ""
+	z.dec.Coeff.SetMathBigInt(new(big.Int).Abs(d.BigInt()))
+	z.dec.Exponent = -LegacyPrecision
+	z.dec.Negative = d.IsNegative()
+	return z.normalized()
+}
+
+// normalized returns the decimal with a positive zero.
+func (x Dec) normalized() Dec {
+	if x.dec.IsZero() {
+		x.dec.Negative = false
 	}
-	inputSize := len(bzInt)
 
-	var bzStr []byte
+	return x
+}
+
+// Add returns x + y, rounded with the default decimal context.
+func (x Dec) Add(y Dec) (Dec, error) { return DefaultDecContext.Add(x, y) }
 
-	// TODO: Remove trailing zeros
-	// case 1, purely decimal
-	if inputSize <= LegacyPrecision {
-		bzStr = make([]byte, LegacyPrecision+2)
+// Sub returns x - y, rounded with the default decimal context.
+func (x Dec) Sub(y Dec) (Dec, error) { return DefaultDecContext.Sub(x, y) }
 
-		// 0. prefix
-		bzStr[0] = byte('0')
-		bzStr[1] = byte('.')
+// Mul returns x * y, rounded with the default decimal context.
+func (x Dec) Mul(y Dec) (Dec, error) { return DefaultDecContext.Mul(x, y) }
 
-		// set relevant digits to 0
-		for i := 0; i < LegacyPrecision-inputSize; i++ {
-			bzStr[i+2] = byte('0')
-		}
+// Quo returns x / y, rounded with the default decimal context.
+func (x Dec) Quo(y Dec) (Dec, error) { return DefaultDecContext.Quo(x, y) }
 
-		// set final digits
-		copy(bzStr[2+(LegacyPrecision-inputSize):], bzInt)
-	} else {
-		// inputSize + 1 to account for the decimal point that is being added
-		bzStr = make([]byte, inputSize+1)
-		decPointPlace := inputSize - LegacyPrecision
+// QuoInteger returns the integer part of x / y, with the default decimal context.
+func (x Dec) QuoInteger(y Dec) (Dec, error) { return DefaultDecContext.QuoInteger(x, y) }
 
-		copy(bzStr, bzInt[:decPointPlace])                   // pre-decimal digits
-		bzStr[decPointPlace] = byte('.')                     // decimal point
-		copy(bzStr[decPointPlace+1:], bzInt[decPointPlace:]) // post-decimal digits
-	}
+// Rem returns the remainder of x / y, with the default decimal context.
+func (x Dec) Rem(y Dec) (Dec, error) { return DefaultDecContext.Rem(x, y) }
 
-	if isNeg {
-		return "-" + string(bzStr)
-	}
+// Power returns x to the given power, rounded with the default decimal context.
+func (x Dec) Power(power int64) (Dec, error) { return DefaultDecContext.Power(x, power) }
 
-	return string(bzStr)
+// Quantize returns x rounded to the given number of decimal places with the
+// given rounding mode.
+func (x Dec) Quantize(places uint32, mode RoundingMode) (Dec, error) {
+	return DefaultDecContext.WithRounding(mode).Quantize(x, places)
 }
 
-// Float64 returns the float64 representation of a Dec.
-// Will return the error if the conversion failed.
-func (d LegacyDec) Float64() (float64, error) {
-	return strconv.ParseFloat(d.String(), 64)
+// Neg returns -x.
+func (x Dec) Neg() Dec {
+	var z Dec
+	z.dec.Neg(&x.dec)
+	return z.normalized()
 }
 
-// MustFloat64 returns the float64 representation of a Dec.
-// Would panic if the conversion failed.
-func (d LegacyDec) MustFloat64() float64 {
-	if value, err := strconv.ParseFloat(d.String(), 64); err != nil {
-		panic(err)
-	} else {
-		return value
-	}
+// Abs returns the absolute value of x.
+func (x Dec) Abs() Dec {
+	var z Dec
+	z.dec.Abs(&x.dec)
+	return z
 }
 
-//     ____
-//  __|    |__   "chop 'em
-//       ` \     round!"
-// ___||  ~  _     -bankers
-// |         |      __
-// |       | |   __|__|__
-// |_____:  /   | $$$    |
-//              |________|
+// Cmp compares x and y and returns -1 if x < y, 0 if x == y and 1 if x > y.
+func (x Dec) Cmp(y Dec) int { return x.dec.Cmp(&y.dec) }
 
-// Remove a Precision amount of rightmost digits and perform bankers rounding
-// on the remainder (gaussian rounding) on the digits which have been removed.
-//
-// Mutates the input. Use the non-mutative version if that is undesired
-func chopPrecisionAndRound(d *big.Int) *big.Int {
-	// remove the negative and add it back when returning
-	if d.Sign() == -1 {
-		// make d positive, compute chopped value, and then un-mutate d
-		d = d.Neg(d)
-		d = chopPrecisionAndRound(d)
-		d = d.Neg(d)
-		return d
-	}
-
-	// get the truncated quotient and remainder
-	quo, rem := d, big.NewInt(0)
-	quo, rem = quo.QuoRem(d, precisionReuse, rem)
-
-	if rem.Sign() == 0 { // remainder is zero
-		return quo
-	}
+// Equal returns true if x and y have the same value, regardless of their
+// representation: 1.0 and 1 are equal.
+func (x Dec) Equal(y Dec) bool { return x.Cmp(y) == 0 }
 
-	switch rem.Cmp(fivePrecision) {
-	case -1:
-		return quo
-	case 1:
-		return quo.Add(quo, oneInt)
-	default: // bankers rounding must take place
-		// always round to an even number
-		if quo.Bit(0) == 0 {
-			return quo
-		}
-		return quo.Add(quo, oneInt)
-	}
-}
+// IsZero returns true if x is zero.
+func (x Dec) IsZero() bool { return x.dec.IsZero() }
 
-func chopPrecisionAndRoundUp(d *big.Int) *big.Int {
-	// remove the negative and add it back when returning
-	if d.Sign() == -1 {
-		// make d positive, compute chopped value, and then un-mutate d
-		d = d.Neg(d)
-		// truncate since d is negative...
-		chopPrecisionAndTruncate(d)
-		d = d.Neg(d)
-		return d
-	}
+// IsNegative returns true if x is negative.
+func (x Dec) IsNegative() bool { return x.dec.Sign() < 0 }
 
-	// get the truncated quotient and remainder
-	quo, rem := d, big.NewInt(0)
-	quo, rem = quo.QuoRem(d, precisionReuse, rem)
+// IsPositive returns true if x is positive.
+func (x Dec) IsPositive() bool { return x.dec.Sign() > 0 }
 
-	if rem.Sign() == 0 { // remainder is zero
-		return quo
-	}
+// IsInteger returns true if x has no fractional part.
+func (x Dec) IsInteger() bool { return x.NumDecimalPlaces() == 0 }
 
-	return quo.Add(quo, oneInt)
-}
+// NumDecimalPlaces returns the number of significant decimal places of x,
+// ignoring trailing zeros.
+func (x Dec) NumDecimalPlaces() uint32 {
+	var reduced apd.Decimal
+	reduced.Reduce(&x.dec)
+	if reduced.Exponent >= 0 {
+		return 0
+	}
 
-func chopPrecisionAndRoundNonMutative(d *big.Int) *big.Int {
-	tmp := new(big.Int).Set(d)
-	return chopPrecisionAndRound(tmp)
+	return uint32(-reduced.Exponent)
 }
 
-// RoundInt64 rounds the decimal using bankers rounding
-func (d LegacyDec) RoundInt64() int64 {
-	chopped := chopPrecisionAndRoundNonMutative(d.i)
-	if !chopped.IsInt64() {
-		panic("Int64() out of bound")
+// ToInt returns x rounded to an integer with the given rounding mode. It fails
+// with ErrDecOutOfRange if the integer exceeds the range of Int.
+func (x Dec) ToInt(mode RoundingMode) (Int, error) {
+	i, err := x.scaledBigInt(0, mode)
+	if err != nil {
+		return Int{}, err
 	}
-	return chopped.Int64()
-}
-
-// RoundInt round the decimal using bankers rounding
-func (d LegacyDec) RoundInt() Int {
-	return NewIntFromBigInt(chopPrecisionAndRoundNonMutative(d.i))
-}
 
-// chopPrecisionAndTruncate is similar to chopPrecisionAndRound,
-// but always rounds down. It does not mutate the input.
-func chopPrecisionAndTruncate(d *big.Int) {
-	d.Quo(d, precisionReuse)
-}
+	if i.BitLen() > MaxBitLen {
+		return Int{}, fmt.Errorf("%w: %s exceeds the range of Int", ErrDecOutOfRange, x)
+	}
 
-func chopPrecisionAndTruncateNonMutative(d *big.Int) *big.Int {
-	tmp := new(big.Int).Set(d)
-	chopPrecisionAndTruncate(tmp)
-	return tmp
+	return NewIntFromBigInt(i), nil
 }
 
-// TruncateInt64 truncates the decimals from the number and returns an int64
-func (d LegacyDec) TruncateInt64() int64 {
-	chopped := chopPrecisionAndTruncateNonMutative(d.i)
-	if !chopped.IsInt64() {
-		panic("Int64() out of bound")
+// ToLegacyDec returns x rounded to the 18 decimal places of LegacyDec with the
+// given rounding mode. It fails with ErrDecOutOfRange if the value exceeds the
+// range of LegacyDec.
+func (x Dec) ToLegacyDec(mode RoundingMode) (LegacyDec, error) {
+	i, err := x.scaledBigInt(LegacyPrecision, mode)
+	if err != nil {
+		return LegacyDec{}, err
 	}
-	return chopped.Int64()
-}
 
-// TruncateInt truncates the decimals from the number and returns an Int
-func (d LegacyDec) TruncateInt() Int {
-	return NewIntFromBigInt(chopPrecisionAndTruncateNonMutative(d.i))
-}
+	if i.BitLen() > maxDecBitLen {
+		return LegacyDec{}, fmt.Errorf("%w: %s exceeds the range of LegacyDec", ErrDecOutOfRange, x)
+	}
 
-// TruncateDec truncates the decimals from the number and returns a Dec
-func (d LegacyDec) TruncateDec() LegacyDec {
-	return LegacyNewDecFromBigInt(chopPrecisionAndTruncateNonMutative(d.i))
+	return LegacyNewDecFromBigIntWithPrec(i, LegacyPrecision), nil
 }
 
-// Ceil returns the smallest interger value (as a decimal) that is greater than
-// or equal to the given decimal.
-func (d LegacyDec) Ceil() LegacyDec {
-	tmp := new(big.Int).Set(d.i)
+// scaledBigInt returns x * 10^places, rounded to an integer with the given
+// rounding mode.
+func (x Dec) scaledBigInt(places int32, mode RoundingMode) (*big.Int, error) {
+	var reduced apd.Decimal
+	reduced.Reduce(&x.dec)
 
-	quo, rem := tmp, big.NewInt(0)
-	quo, rem = quo.QuoRem(tmp, precisionReuse, rem)
-
-	// no need to round with a zero remainder regardless of sign
-	if rem.Cmp(zeroInt) == 0 {
-		return LegacyNewDecFromBigInt(quo)
+	exp := int64(reduced.Exponent) + int64(places)
+	if exp > maxDecBitLen {
+		// 10^exp has more bits than any Int or LegacyDec
+		return nil, fmt.Errorf("%w: %s exceeds the range of integers", ErrDecOutOfRange, x)
 	}
 
-	if rem.Sign() == -1 {
-		return LegacyNewDecFromBigInt(quo)
+	var i *big.Int
+	if exp >= 0 {
+		i = new(big.Int).Mul(reduced.Coeff.MathBigInt(), new(big.Int).Exp(tenInt, big.NewInt(exp), nil))
+	} else {
+		i = roundBigInt(reduced.Coeff.MathBigInt(), reduced.Negative, uint64(-exp), mode)
 	}
 
-	return LegacyNewDecFromBigInt(quo.Add(quo, oneInt))
-}
-
-// LegacyMaxSortableDec is the largest Dec that can be passed into SortableDecBytes()
-// Its negative form is the least Dec that can be passed in.
-var LegacyMaxSortableDec LegacyDec
-
-func init() {
-	LegacyMaxSortableDec = LegacyOneDec().Quo(LegacySmallestDec())
-}
+	if reduced.Negative {
+		i.Neg(i)
+	}
 
-// ValidSortableDec ensures that a Dec is within the sortable bounds,
-// a Dec can't have a precision of less than 10^-18.
-// Max sortable decimal was set to the reciprocal of SmallestDec.
-func LegacyValidSortableDec(dec LegacyDec) bool {
-	return dec.Abs().LTE(LegacyMaxSortableDec)
+	return i, nil
 }
 
-// SortableDecBytes returns a byte slice representation of a Dec that can be sorted.
-// Left and right pads with 0s so there are 18 digits to left and right of the decimal point.
-// For this reason, there is a maximum and minimum value for this, enforced by ValidSortableDec.
-func LegacySortableDecBytes(dec LegacyDec) []byte {
-	if !LegacyValidSortableDec(dec) {
-		panic("dec must be within bounds")
-	}
-	// Instead of adding an extra byte to all sortable decs in order to handle max sortable, we just
-	// makes its bytes be "max" which comes after all numbers in ASCIIbetical order
-	if dec.Equal(LegacyMaxSortableDec) {
-		return []byte("max")
+// String returns the canonical representation of x in plain notation, without
+// trailing zeros: decimals with the same value have the same representation.
+func (x Dec) String() string {
+	var reduced apd.Decimal
+	reduced.Reduce(&x.dec)
+	if reduced.IsZero() {
+		return "0"
 	}
-	// For the same reason, we make the bytes of minimum sortable dec be --, which comes before all numbers.
-	if dec.Equal(LegacyMaxSortableDec.Neg()) {
-		return []byte("--")
-	}
-	// We move the negative sign to the front of all the left padded 0s, to make negative numbers come before positive numbers
-	if dec.IsNegative() {
-		return append([]byte("-"), []byte(fmt.Sprintf(fmt.Sprintf("%%0%ds", LegacyPrecision*2+1), dec.Abs().String()))...)
-	}
-	return []byte(fmt.Sprintf(fmt.Sprintf("%%0%ds", LegacyPrecision*2+1), dec.String()))
-}
 
-// reuse nil values
-var nilJSON []byte
-
-func init() {
-	empty := new(big.Int)
-	bz, _ := empty.MarshalText()
-	nilJSON, _ = json.Marshal(string(bz))
+	return reduced.Text('f')
 }
 
-// MarshalJSON marshals the decimal
-func (d LegacyDec) MarshalJSON() ([]byte, error) {
-	if d.i == nil {
-		return nilJSON, nil
+// Format implements the fmt.Formatter interface.
+func (x Dec) Format(s fmt.State, verb rune) {
+	if _, err := s.Write([]byte(x.String())); err != nil {
+		panic(err)
 	}
-	return json.Marshal(d.String())
 }
 
-// UnmarshalJSON defines custom decoding scheme
-func (d *LegacyDec) UnmarshalJSON(bz []byte) error {
-	if d.i == nil {
-		d.i = new(big.Int)
-	}
+// MarshalJSON marshals the decimal as a JSON string of its canonical
+// representation.
+func (x Dec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.String())
+}
 
+// UnmarshalJSON unmarshals a decimal from a JSON string.
+func (x *Dec) UnmarshalJSON(bz []byte) error {
 	var text string
-	err := json.Unmarshal(bz, &text)
-	if err != nil {
+	if err := json.Unmarshal(bz, &text); err != nil {
 		return err
 	}
 
-	// TODO: Reuse dec allocation
-	newDec, err := LegacyNewDecFromStr(text)
+	d, err := NewDecFromString(text)
 	if err != nil {
 		return err
 	}
 
-	d.i = newDec.i
+	*x = d
 	return nil
 }
 
-// MarshalYAML returns the YAML representation.
-func (d LegacyDec) MarshalYAML() (interface{}, error) {
-	return d.String(), nil
-}
-
-// Marshal implements the gogo proto custom type interface.
-func (d LegacyDec) Marshal() ([]byte, error) {
-	i := d.i
-	if i == nil {
-		i = new(big.Int)
-	}
-	return i.MarshalText()
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x Dec) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
 }
 
-// MarshalTo implements the gogo proto custom type interface.
-func (d *LegacyDec) MarshalTo(data []byte) (n int, err error) {
-	i := d.i
-	if i == nil {
-		i = new(big.Int)
-	}
-
-	if i.Cmp(zeroInt) == 0 {
-		copy(data, []byte{0x30})
-		return 1, nil
-	}
-
-	bz, err := d.Marshal()
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (x *Dec) UnmarshalText(text []byte) error {
+	d, err := NewDecFromString(string(text))
 	if err != nil {
-		return 0, err
-	}
-
-	copy(data, bz)
-	return len(bz), nil
-}
-
-// Unmarshal implements the gogo proto custom type interface.
-func (d *LegacyDec) Unmarshal(data []byte) error {
-	if len(data) == 0 {
-		d = nil
-		return nil
-	}
-
-	if d.i == nil {
-		d.i = new(big.Int)
-	}
-
-	if err := d.i.UnmarshalText(data); err != nil {
 		return err
 	}
 
-	if d.i.BitLen() > maxDecBitLen {
-		return fmt.Errorf("decimal out of range; got: %d, max: %d", d.i.BitLen(), maxDecBitLen)
-	}
-
+	*x = d
 	return nil
 }
 
-// Size implements the gogo proto custom type interface.
-func (d *LegacyDec) Size() int {
-	bz, _ := d.Marshal()
-	return len(bz)
+// MarshalYAML returns the YAML representation.
+func (x Dec) MarshalYAML() (interface{}, error) {
+	return x.String(), nil
 }
 
-// Override Amino binary serialization by proxying to protobuf.
-func (d LegacyDec) MarshalAmino() ([]byte, error)   { return d.Marshal() }
-func (d *LegacyDec) UnmarshalAmino(bz []byte) error { return d.Unmarshal(bz) }
-
-// helpers
+// Marshal implements the gogo proto custom type interface. The decimal is
+// marshalled as its canonical representation.
+func (x Dec) Marshal() ([]byte, error) {
+	return x.MarshalText()
+}
 
-// test if two decimal arrays are equal
-func LegacyDecsEqual(d1s, d2s []LegacyDec) bool {
-	if len(d1s) != len(d2s) {
-		return false
+// MarshalTo implements the gogo proto custom type interface.
+func (x *Dec) MarshalTo(data []byte) (n int, err error) {
+	bz, err := x.Marshal()
+	if err != nil {
+		return 0, err
 	}
 
-	for i, d1 := range d1s {
-		if !d1.Equal(d2s[i]) {
-			return false
-		}
+	if len(data) < len(bz) {
+		return 0, fmt.Errorf("buffer of size %d too small to marshal decimal of size %d", len(data), len(bz))
 	}
-	return true
-}
 
-// minimum decimal between two
-func LegacyMinDec(d1, d2 LegacyDec) LegacyDec {
-	if d1.LT(d2) {
-		return d1
-	}
-	return d2
+	return copy(data, bz), nil
 }
 
-// maximum decimal between two
-func LegacyMaxDec(d1, d2 LegacyDec) LegacyDec {
-	if d1.LT(d2) {
-		return d2
+// Unmarshal implements the gogo proto custom type interface. Empty data is
+// unmarshalled as zero.
+func (x *Dec) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*x = Dec{}
+		return nil
 	}
-	return d1
-}
 
-// intended to be used with require/assert:  require.True(DecEq(...))
-func LegacyDecEq(t *testing.T, exp, got LegacyDec) (*testing.T, bool, string, string, string) {
-	return t, exp.Equal(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
+	return x.UnmarshalText(data)
 }
 
-func LegacyDecApproxEq(t *testing.T, d1, d2, tol LegacyDec) (*testing.T, bool, string, string, string) {
-	diff := d1.Sub(d2).Abs()
-	return t, diff.LTE(tol), "expected |d1 - d2| <:\t%v\ngot |d1 - d2| = \t\t%v", tol.String(), diff.String()
+// Size implements the gogo proto custom type interface.
+func (x *Dec) Size() int {
+	return len(x.String())
 }
 
-// FormatDec formats a decimal (as encoded in protobuf) into a value-rendered
-// string following ADR-050. This function operates with string manipulation
-// (instead of manipulating the sdk.Dec object).
-func FormatDec(v string) (string, error) {
-	parts := strings.Split(v, ".")
-	if len(parts) > 2 {
-		return "", fmt.Errorf("invalid decimal: too many points in %s", v)
-	}
-
-	intPart, err := FormatInt(parts[0])
-	if err != nil {
-		return "", err
-	}
-
-	if len(parts) == 1 {
-		return intPart, nil
-	}
-
-	decPart := strings.TrimRight(parts[1], "0")
-	if len(decPart) == 0 {
-		return intPart, nil
-	}
-
-	// Ensure that the decimal part has only digits.
-	// https://github.com/cosmos/cosmos-sdk/issues/12811
-	if !hasOnlyDigits(decPart) {
-		return "", fmt.Errorf("non-digits detected after decimal point in: %q", decPart)
-	}
-
-	return intPart + "." + decPart, nil
-}
+// Override Amino binary serialization by proxying to protobuf.
+func (x Dec) MarshalAmino() ([]byte, error)   { return x.Marshal() }
+func (x *Dec) UnmarshalAmino(bz []byte) error { return x.Unmarshal(bz) }
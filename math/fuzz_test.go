@@ -1,6 +1,10 @@
 package math
 
 import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -23,6 +27,242 @@ func FuzzLegacyNewDecFromStr(f *testing.F) {
 	})
 }
 
+func FuzzNewDecFromString(f *testing.F) {
+	if testing.Short() {
+		f.Skip("running in -short mode")
+	}
+
+	f.Add("-123.456")
+	f.Add("1.5e-3")
+	f.Add("00012.3400")
+	f.Add("1E+1000")
+	f.Add("NaN")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		dec, err := NewDecFromString(input)
+		if err != nil {
+			if !errors.Is(err, ErrInvalidDec) && !errors.Is(err, ErrDecOutOfRange) {
+				t.Fatalf("unexpected error for %q: %v", input, err)
+			}
+			return
+		}
+
+		// the string representation is canonical and exact
+		str := dec.String()
+		parsed, err := NewDecFromString(str)
+		if err != nil || parsed.String() != str {
+			t.Fatalf("string %q of %q does not round trip: %v", str, input, err)
+		}
+
+		bz, err := dec.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var unmarshalled Dec
+		if err := unmarshalled.Unmarshal(bz); err != nil || !unmarshalled.Equal(dec) {
+			t.Fatalf("%s does not round trip through proto: %v", str, err)
+		}
+
+		bz, err = json.Marshal(dec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(bz, &unmarshalled); err != nil || !unmarshalled.Equal(dec) {
+			t.Fatalf("%s does not round trip through JSON: %v", str, err)
+		}
+
+		// big.Rat expands exponents, which is only cheap for the plain notation
+		if strings.ContainsAny(input, "eE") {
+			return
+		}
+		if expected, ok := new(big.Rat).SetString(input); ok && expected.Cmp(decToRat(t, dec)) != 0 {
+			t.Fatalf("parsed %q as %s", input, str)
+		}
+	})
+}
+
+// FuzzDecArithmetic compares the results of the operations of a context with
+// the exact results computed with big.Rat, rounded to the precision of the
+// context.
+func FuzzDecArithmetic(f *testing.F) {
+	if testing.Short() {
+		f.Skip("running in -short mode")
+	}
+
+	f.Add(int64(2), int8(0), int64(3), int8(0), uint8(34), uint8(RoundHalfEven))
+	f.Add(int64(-25), int8(-1), int64(1), int8(0), uint8(1), uint8(RoundHalfEven))
+	f.Add(int64(123456789), int8(-20), int64(-987654321), int8(15), uint8(5), uint8(RoundFloor))
+	f.Add(int64(-1), int8(0), int64(7), int8(3), uint8(10), uint8(RoundCeiling))
+	f.Add(int64(99999), int8(-2), int64(-3), int8(-1), uint8(3), uint8(RoundTruncate))
+
+	f.Fuzz(func(t *testing.T, a int64, aExp int8, b int64, bExp int8, precision, mode uint8) {
+		ctx := DefaultDecContext.
+			WithPrecision(uint32(precision%DefaultDecPrecision) + 1).
+			WithRounding(RoundingMode(mode % 4))
+
+		x, err := NewDecWithExp(a, int32(aExp))
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := NewDecWithExp(b, int32(bExp))
+		if err != nil {
+			t.Fatal(err)
+		}
+		xRat, yRat := decToRat(t, x), decToRat(t, y)
+
+		ops := []struct {
+			name  string
+			op    func(x, y Dec) (Dec, error)
+			exact func(x, y *big.Rat) *big.Rat
+		}{
+			{"add", ctx.Add, func(x, y *big.Rat) *big.Rat { return new(big.Rat).Add(x, y) }},
+			{"sub", ctx.Sub, func(x, y *big.Rat) *big.Rat { return new(big.Rat).Sub(x, y) }},
+			{"mul", ctx.Mul, func(x, y *big.Rat) *big.Rat { return new(big.Rat).Mul(x, y) }},
+			{"quo", ctx.Quo, func(x, y *big.Rat) *big.Rat { return new(big.Rat).Quo(x, y) }},
+		}
+
+		for _, op := range ops {
+			res, err := op.op(x, y)
+			if op.name == "quo" && y.IsZero() {
+				if !errors.Is(err, ErrDecDivisionByZero) {
+					t.Fatalf("%s / 0: expected division by zero, got %v", x, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s(%s, %s): %v", op.name, x, y, err)
+			}
+
+			expected := roundRatToPrecision(op.exact(xRat, yRat), ctx.Precision, ctx.Rounding)
+			if expected.Cmp(decToRat(t, res)) != 0 {
+				t.Fatalf("%s(%s, %s) with precision %d and rounding %s: expected %s, got %s",
+					op.name, x, y, ctx.Precision, ctx.Rounding, expected.FloatString(50), res)
+			}
+		}
+
+		// powers of values with few digits, so that exact results stay small
+		base, err := NewDecWithExp(a%1000, int32(aExp%4))
+		if err != nil {
+			t.Fatal(err)
+		}
+		power := int64(bExp % 10)
+		res, err := ctx.Power(base, power)
+		if base.IsZero() && power < 0 {
+			if !errors.Is(err, ErrDecDivisionByZero) {
+				t.Fatalf("0^%d: expected division by zero, got %v", power, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("%s^%d: %v", base, power, err)
+		}
+
+		exact := new(big.Rat).SetInt64(1)
+		baseRat := decToRat(t, base)
+		for i := int64(0); i < power; i++ {
+			exact.Mul(exact, baseRat)
+		}
+		for i := power; i < 0; i++ {
+			exact.Quo(exact, baseRat)
+		}
+
+		expected := roundRatToPrecision(exact, ctx.Precision, ctx.Rounding)
+		if expected.Cmp(decToRat(t, res)) != 0 {
+			t.Fatalf("%s^%d with precision %d and rounding %s: expected %s, got %s",
+				base, power, ctx.Precision, ctx.Rounding, expected.FloatString(50), res)
+		}
+
+		// quantization is not limited by the precision
+		places := uint32(precision % 40)
+		res, err = x.Quantize(places, ctx.Rounding)
+		if err != nil {
+			t.Fatalf("quantize(%s, %d): %v", x, places, err)
+		}
+		expected = roundRat(xRat, int64(places), ctx.Rounding)
+		if expected.Cmp(decToRat(t, res)) != 0 {
+			t.Fatalf("quantize(%s, %d) with rounding %s: expected %s, got %s",
+				x, places, ctx.Rounding, expected.FloatString(50), res)
+		}
+	})
+}
+
+// decToRat converts x to a big.Rat through its string representation.
+func decToRat(t *testing.T, x Dec) *big.Rat {
+	t.Helper()
+
+	r, ok := new(big.Rat).SetString(x.String())
+	if !ok {
+		t.Fatalf("invalid decimal string %q", x.String())
+	}
+
+	return r
+}
+
+// roundRatToPrecision is the reference implementation of the rounding of r to
+// the given number of significant digits.
+func roundRatToPrecision(r *big.Rat, precision uint32, mode RoundingMode) *big.Rat {
+	if r.Sign() == 0 {
+		return r
+	}
+
+	// find e such that 10^e <= |r| < 10^(e+1)
+	abs := new(big.Rat).Abs(r)
+	e := int64(len(abs.Num().String()) - len(abs.Denom().String()))
+	for pow10Rat(e).Cmp(abs) > 0 {
+		e--
+	}
+	for pow10Rat(e+1).Cmp(abs) <= 0 {
+		e++
+	}
+
+	return roundRat(r, int64(precision)-1-e, mode)
+}
+
+// roundRat is the reference implementation of the rounding of r to the given
+// number of decimal places.
+func roundRat(r *big.Rat, places int64, mode RoundingMode) *big.Rat {
+	scale := pow10Rat(places)
+	scaled := new(big.Rat).Mul(new(big.Rat).Abs(r), scale)
+
+	n, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	neg := r.Sign() < 0
+	roundUp := false
+	switch mode {
+	case RoundFloor:
+		roundUp = neg && rem.Sign() != 0
+	case RoundCeiling:
+		roundUp = !neg && rem.Sign() != 0
+	case RoundHalfEven:
+		c := new(big.Int).Lsh(rem, 1).Cmp(scaled.Denom())
+		roundUp = c > 0 || (c == 0 && n.Bit(0) == 1)
+	}
+	if roundUp {
+		n.Add(n, big.NewInt(1))
+	}
+	if neg {
+		n.Neg(n)
+	}
+
+	return new(big.Rat).Quo(new(big.Rat).SetInt(n), scale)
+}
+
+func pow10Rat(e int64) *big.Rat {
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(abs64(e)), nil)
+	if e < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), p)
+	}
+
+	return new(big.Rat).SetInt(p)
+}
+
+func abs64(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
+
 func TestDecNegativePrecision(t *testing.T) {
 	t.Skip("https://github.com/cosmos/cosmos-sdk/issues/14004 is not yet addressed")
 
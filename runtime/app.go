@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	eventsservice "github.com/cosmos/cosmos-sdk/client/grpc/events"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -49,6 +50,7 @@ type App struct {
 	basicManager      module.BasicManager
 	baseAppOptions    []BaseAppOption
 	msgServiceRouter  *baseapp.MsgServiceRouter
+	eventRouter       *EventRouter
 	appConfig         *appv1alpha1.Config
 	logger            log.Logger
 	// initChainer is the init chainer function defined by the app config.
@@ -194,6 +196,12 @@ func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
 }
 
+// RegisterEventsService registers the typed events subscription gRPC service on
+// the app gRPC router, and feeds it with the events of committed blocks.
+func (a *App) RegisterEventsService(clientCtx client.Context, cfg config.Config) {
+	a.AddABCIListener(eventsservice.RegisterEventsService(clientCtx, a.GRPCQueryRouter(), cfg))
+}
+
 // Configurator returns the app's configurator.
 func (a *App) Configurator() module.Configurator {
 	return a.configurator
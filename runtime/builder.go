@@ -25,12 +25,18 @@ func (a *AppBuilder) DefaultGenesis() map[string]json.RawMessage {
 
 // Build builds an *App instance.
 func (a *AppBuilder) Build(db dbm.DB, traceStore io.Writer, baseAppOptions ...func(*baseapp.BaseApp)) *App {
+	// the msg service router is set before the other options, so that the
+	// options configuring it (e.g. the circuit breaker) apply to the router
+	// of the app
+	baseAppOptions = append([]func(*baseapp.BaseApp){
+		func(bApp *baseapp.BaseApp) { bApp.SetMsgServiceRouter(a.app.msgServiceRouter) },
+	}, baseAppOptions...)
 	for _, option := range a.app.baseAppOptions {
 		baseAppOptions = append(baseAppOptions, option)
 	}
 
 	bApp := baseapp.NewBaseApp(a.app.config.AppName, a.app.logger, db, nil, baseAppOptions...)
-	bApp.SetMsgServiceRouter(a.app.msgServiceRouter)
+	bApp.SetMsgEventHandler(a.app.eventRouter.HandleMsgEvents)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(a.app.interfaceRegistry)
@@ -2,17 +2,43 @@ package runtime
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
-	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/protobuf/runtime/protoiface"
 
+	"cosmossdk.io/core/event"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ event.Service = (*EventService)(nil)
+var (
+	_ event.Service = (*EventService)(nil)
+	_ event.Router  = (*EventService)(nil)
+)
 
 type EventService struct {
 	Events
+
+	router *EventRouter
+}
+
+// NewEventService returns an event service registering the event handlers on
+// the given router.
+func NewEventService(router *EventRouter) EventService {
+	return EventService{router: router}
+}
+
+// RegisterHandler implements event.Router.
+func (es EventService) RegisterHandler(event protoiface.MessageV1, handler event.Handler) error {
+	if es.router == nil {
+		return errors.New("event service has no event router")
+	}
+
+	return es.router.RegisterHandler(event, handler)
 }
 
 func (es EventService) EventManager(ctx context.Context) event.Manager {
@@ -57,3 +83,79 @@ func (e Events) EmitKV(ctx context.Context, eventType string, attrs ...event.Att
 func (e Events) EmitNonConsensus(ctx context.Context, event protoiface.MessageV1) error {
 	return e.EventManagerI.EmitTypedEvent(event)
 }
+
+// maxEventHandlerDepth is the maximum depth of the events emitted by the event
+// handlers in response to each other, for a message.
+const maxEventHandlerDepth = 10
+
+// EventRouter dispatches the typed events emitted by messages to the handlers
+// registered for them. Its HandleMsgEvents method must be set as the
+// sdk.MsgEventHandler of the BaseApp.
+type EventRouter struct {
+	handlers map[string][]event.Handler
+}
+
+// NewEventRouter returns a new EventRouter.
+func NewEventRouter() *EventRouter {
+	return &EventRouter{handlers: map[string][]event.Handler{}}
+}
+
+// RegisterHandler registers a handler for the events of the type of event.
+func (r *EventRouter) RegisterHandler(event protoiface.MessageV1, handler event.Handler) error {
+	name := proto.MessageName(event)
+	if name == "" {
+		return fmt.Errorf("cannot register handler for unknown event type %T", event)
+	}
+
+	if handler == nil {
+		return fmt.Errorf("handler of %s cannot be nil", name)
+	}
+
+	r.handlers[name] = append(r.handlers[name], handler)
+	return nil
+}
+
+// HandleMsgEvents invokes the handlers of the typed events emitted by a message,
+// in the order the events were emitted and the handlers were registered. The
+// events emitted by the handlers are dispatched as well, and returned.
+// It implements sdk.MsgEventHandler.
+func (r *EventRouter) HandleMsgEvents(ctx sdk.Context, events []abci.Event) ([]abci.Event, error) {
+	if len(r.handlers) == 0 {
+		return nil, nil
+	}
+
+	var emitted []abci.Event
+	for depth := 0; len(events) > 0; depth++ {
+		if depth == maxEventHandlerDepth {
+			return nil, fmt.Errorf("event handlers exceeded the maximum depth of %d", maxEventHandlerDepth)
+		}
+
+		var next []abci.Event
+		for _, e := range events {
+			handlers := r.handlers[e.Type]
+			if len(handlers) == 0 {
+				continue
+			}
+
+			for _, handler := range handlers {
+				// each handler gets its own copy of the event
+				typed, err := sdk.ParseTypedEvent(e)
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "failed to parse event %s", e.Type)
+				}
+
+				handlerCtx := ctx.WithEventManager(sdk.NewEventManager())
+				if err := handler(handlerCtx, typed); err != nil {
+					return nil, errorsmod.Wrapf(err, "failed to handle event %s", e.Type)
+				}
+
+				next = append(next, handlerCtx.EventManager().ABCIEvents()...)
+			}
+		}
+
+		emitted = append(emitted, next...)
+		events = next
+	}
+
+	return emitted, nil
+}
@@ -0,0 +1,79 @@
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/event"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func typedEvent(t *testing.T, ev proto.Message) abci.Event {
+	t.Helper()
+
+	e, err := sdk.TypedEventToEvent(ev)
+	require.NoError(t, err)
+	return abci.Event(e)
+}
+
+func TestEventRouter(t *testing.T) {
+	require.Error(t, event.RegisterHandler(runtime.EventService{}, func(context.Context, *testdata.Dog) error { return nil }))
+
+	router := runtime.NewEventRouter()
+	service := runtime.NewEventService(router)
+
+	// without handlers, the events are not parsed
+	emitted, err := router.HandleMsgEvents(sdk.Context{}, []abci.Event{{Type: "testpb.Dog"}})
+	require.NoError(t, err)
+	require.Empty(t, emitted)
+
+	var handled []string
+	require.NoError(t, event.RegisterHandler(service, func(ctx context.Context, dog *testdata.Dog) error {
+		handled = append(handled, "dog1:"+dog.Name)
+		return service.EventManager(ctx).Emit(ctx, &testdata.Cat{Moniker: dog.Name})
+	}))
+	require.NoError(t, event.RegisterHandler(service, func(ctx context.Context, dog *testdata.Dog) error {
+		handled = append(handled, "dog2:"+dog.Name)
+		return nil
+	}))
+	require.NoError(t, event.RegisterHandler(service, func(ctx context.Context, cat *testdata.Cat) error {
+		handled = append(handled, "cat:"+cat.Moniker)
+		return nil
+	}))
+
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	emitted, err = router.HandleMsgEvents(ctx, []abci.Event{
+		typedEvent(t, &testdata.Dog{Name: "rex"}),
+		{Type: "other"},
+		typedEvent(t, &testdata.Dog{Name: "spot"}),
+	})
+	require.NoError(t, err)
+
+	// the events are handled in order, then the events emitted by the handlers
+	require.Equal(t, []string{"dog1:rex", "dog2:rex", "dog1:spot", "dog2:spot", "cat:rex", "cat:spot"}, handled)
+	require.Equal(t, []abci.Event{
+		typedEvent(t, &testdata.Cat{Moniker: "rex"}),
+		typedEvent(t, &testdata.Cat{Moniker: "spot"}),
+	}, emitted)
+}
+
+func TestEventRouterMaxDepth(t *testing.T) {
+	router := runtime.NewEventRouter()
+	service := runtime.NewEventService(router)
+
+	// a handler emitting the event it handles loops
+	require.NoError(t, event.RegisterHandler(service, func(ctx context.Context, dog *testdata.Dog) error {
+		return service.EventManager(ctx).Emit(ctx, dog)
+	}))
+
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	_, err := router.HandleMsgEvents(ctx, []abci.Event{typedEvent(t, &testdata.Dog{Name: "rex"})})
+	require.ErrorContains(t, err, "maximum depth")
+}
@@ -114,6 +114,7 @@ func ProvideApp(interfaceRegistry codectypes.InterfaceRegistry) (
 		amino:             amino,
 		basicManager:      module.BasicManager{},
 		msgServiceRouter:  msgServiceRouter,
+		eventRouter:       NewEventRouter(),
 	}
 	appBuilder := &AppBuilder{app}
 
@@ -245,8 +246,8 @@ func ProvideTransientStoreService(key depinject.ModuleKey, app *AppBuilder) stor
 	return transientStoreService{key: storeKey}
 }
 
-func ProvideEventService() event.Service {
-	return EventService{}
+func ProvideEventService(app *AppBuilder) event.Service {
+	return NewEventService(app.app.eventRouter)
 }
 
 func ProvideCometInfoService() comet.BlockInfoService {
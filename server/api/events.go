@@ -0,0 +1,108 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/gorilla/websocket"
+
+	eventsservice "github.com/cosmos/cosmos-sdk/client/grpc/events"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// EventsWebsocketPath defines the path of the websocket endpoint bridging the
+// typed events subscription gRPC service.
+const EventsWebsocketPath = "/cosmos/base/events/v1beta1/subscribe"
+
+// registerEventsWebsocket registers the websocket endpoint bridging the typed
+// events subscription gRPC service.
+//
+// Once connected, a client sends a single JSON encoded SubscribeRequest, then
+// receives every matching event as a JSON encoded SubscribeResponse, until
+// either side closes the connection.
+func (s *Server) registerEventsWebsocket(enableUnsafeCORS bool) {
+	upgrader := websocket.Upgrader{}
+	if enableUnsafeCORS {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+
+	s.Router.HandleFunc(EventsWebsocketPath, func(w http.ResponseWriter, r *http.Request) {
+		if s.ClientCtx.GRPCClient == nil {
+			writeErrorResponse(w, http.StatusServiceUnavailable, "events subscription requires the gRPC server to be enabled")
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader already replied to the client
+			s.logger.Error("failed to upgrade events websocket connection", "err", err)
+			return
+		}
+		defer conn.Close()
+
+		s.serveEventsWebsocket(r.Context(), conn)
+	})
+}
+
+// serveEventsWebsocket relays the events gRPC stream to a websocket connection.
+func (s *Server) serveEventsWebsocket(ctx context.Context, conn *websocket.Conn) {
+	_, bz, err := conn.ReadMessage()
+	if err != nil {
+		return
+	}
+
+	var req eventsservice.SubscribeRequest
+	if err := jsonpb.Unmarshal(bytes.NewReader(bz), &req); err != nil {
+		closeWebsocket(conn, websocket.CloseUnsupportedData, "invalid subscribe request: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Clients are not expected to send anything else, but reading is required
+	// to detect that the connection was closed.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	stream, err := eventsservice.NewServiceClient(s.ClientCtx.GRPCClient).Subscribe(ctx, &req)
+	if err != nil {
+		closeWebsocket(conn, websocket.CloseInternalServerErr, err.Error())
+		return
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				closeWebsocket(conn, websocket.CloseInternalServerErr, err.Error())
+			}
+			return
+		}
+
+		// Events are not registered in the interface registry, hence the
+		// default gogoproto registry is used to resolve them.
+		bz, err := codec.ProtoMarshalJSON(res, nil)
+		if err != nil {
+			closeWebsocket(conn, websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+
+		if err := conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+			return
+		}
+	}
+}
+
+// closeWebsocket sends a close message with the given code and reason.
+func closeWebsocket(conn *websocket.Conn, code int, reason string) {
+	_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason))
+}
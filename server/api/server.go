@@ -53,6 +53,9 @@ func CustomGRPCHeaderMatcher(key string) (string, bool) {
 	case grpctypes.GRPCBlockHeightHeader:
 		return grpctypes.GRPCBlockHeightHeader, true
 
+	case grpctypes.GRPCQueryGasLimitHeader:
+		return grpctypes.GRPCQueryGasLimitHeader, true
+
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
@@ -113,6 +116,11 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	s.listener = listener
 	s.mtx.Unlock()
 
+	// register the typed events websocket endpoint (before the catch-all routes below)
+	if cfg.GRPC.Enable && cfg.Events.Enable {
+		s.registerEventsWebsocket(cfg.API.EnableUnsafeCORS)
+	}
+
 	// configure grpc-web server
 	if cfg.GRPC.Enable && cfg.GRPCWeb.Enable {
 		var options []grpcweb.Option
@@ -72,6 +72,14 @@ type BaseConfig struct {
 	// ResponseCommit.RetainHeight.
 	MinRetainBlocks uint64 `mapstructure:"min-retain-blocks"`
 
+	// QueryGasLimit defines the maximum gas a single gRPC or ABCI query may
+	// consume. A value of 0 indicates that queries are not gas limited.
+	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
+
+	// GasProfileBlocks defines the number of last blocks whose gas profile is
+	// recorded and kept in memory. A value of 0 disables gas profiling.
+	GasProfileBlocks uint32 `mapstructure:"gas-profile-blocks"`
+
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
@@ -158,6 +166,28 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// EventsConfig defines the configuration for the typed events subscription
+// service.
+type EventsConfig struct {
+	// Enable defines if the typed events subscription service should be enabled
+	// on the gRPC server, and bridged to a websocket endpoint on the API server.
+	Enable bool `mapstructure:"enable"`
+
+	// BufferSize defines the number of events buffered per subscriber. A
+	// subscriber whose buffer is full is disconnected.
+	BufferSize uint `mapstructure:"buffer-size"`
+}
+
+// IndexerConfig defines the configuration for the in-process indexer.
+type IndexerConfig struct {
+	// Enable defines if the in-process indexer should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Collections defines the collections whose state changes are indexed, in
+	// the form "<store>/<collection>", "<store>/*" or "*".
+	Collections []string `mapstructure:"collections"`
+}
+
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
 // implementations.
 type MempoolConfig struct {
@@ -165,7 +195,7 @@ type MempoolConfig struct {
 	// the mempool is disabled entirely, zero indicates that the mempool is
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
-	MaxTxs int
+	MaxTxs int `mapstructure:"max-txs"`
 }
 
 // State Streaming configuration
@@ -193,6 +223,8 @@ type Config struct {
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
+	Events    EventsConfig     `mapstructure:"events"`
+	Indexer   IndexerConfig    `mapstructure:"indexer"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
 }
 
@@ -233,6 +265,8 @@ func DefaultConfig() *Config {
 			PruningKeepRecent:   "0",
 			PruningInterval:     "0",
 			MinRetainBlocks:     0,
+			QueryGasLimit:       0,
+			GasProfileBlocks:    0,
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
@@ -270,6 +304,14 @@ func DefaultConfig() *Config {
 				StopNodeOnErr: true,
 			},
 		},
+		Events: EventsConfig{
+			Enable:     false,
+			BufferSize: 1000,
+		},
+		Indexer: IndexerConfig{
+			Enable:      false,
+			Collections: []string{},
+		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
 		},
@@ -60,6 +60,18 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # ResponseCommit.RetainHeight.
 min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 
+# QueryGasLimit defines the maximum gas a single gRPC or ABCI query may consume.
+# gRPC clients may request a lower limit through the x-cosmos-query-gas-limit
+# header. A value of 0 indicates that queries are not gas limited.
+query-gas-limit = {{ .BaseConfig.QueryGasLimit }}
+
+# GasProfileBlocks defines the number of last blocks whose gas profile, the gas
+# consumed by their transactions by message type and by module store, is
+# recorded and kept in memory. Gas profiles are emitted as FinalizeBlock events
+# and telemetry metrics, and served by the cosmos.base.gasprofile.v1beta1.Query
+# gRPC service. A value of 0 disables gas profiling.
+gas-profile-blocks = {{ .BaseConfig.GasProfileBlocks }}
+
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
@@ -166,11 +178,11 @@ address = "{{ .GRPC.Address }}"
 
 # MaxRecvMsgSize defines the max message size in bytes the server can receive.
 # The default value is 10MB.
-max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
+max-recv-msg-size = {{ .GRPC.MaxRecvMsgSize }}
 
 # MaxSendMsgSize defines the max message size in bytes the server can send.
 # The default value is math.MaxInt32.
-max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
+max-send-msg-size = {{ .GRPC.MaxSendMsgSize }}
 
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
@@ -224,6 +236,44 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+###############################################################################
+###                              Events                                     ###
+###############################################################################
+
+# Events allows clients to subscribe to the typed events emitted by committed
+# blocks, through the cosmos.base.events.v1beta1.Service gRPC streaming service
+# and the /cosmos/base/events/v1beta1/subscribe websocket endpoint of the API
+# server.
+[events]
+
+# Enable defines if the typed events subscription service should be enabled.
+# NOTE: the websocket endpoint also requires the API and gRPC servers to be enabled.
+enable = {{ .Events.Enable }}
+
+# BufferSize defines the number of events buffered per subscriber. A subscriber
+# whose buffer is full is disconnected.
+buffer-size = {{ .Events.BufferSize }}
+
+###############################################################################
+###                              Indexer                                    ###
+###############################################################################
+
+# Indexer stores the transactions, events and selected collections state
+# changes of committed blocks in a local database, queryable through the
+# cosmos.base.indexer.v1beta1.Query gRPC service.
+[indexer]
+
+# Enable defines if the in-process indexer should be enabled.
+enable = {{ .Indexer.Enable }}
+
+# List of collections whose state changes are indexed, in the form
+# "<store>/<collection>". The store names MUST match the module's StoreKey name.
+#
+# Example:
+# ["bank/Balances", "gov/*"]
+# ["*"] to index all collections.
+collections = [{{ range .Indexer.Collections }}{{ printf "%q, " . }}{{end}}]
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -235,7 +285,7 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 #
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
-max-txs = "{{ .Mempool.MaxTxs }}"
+max-txs = {{ .Mempool.MaxTxs }}
 `
 
 var configTemplate *template.Template
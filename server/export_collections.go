@@ -0,0 +1,174 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	FlagStores = "stores"
+	FlagNDJSON = "ndjson"
+)
+
+// ExportCollectionsCmd dumps the state of the collections of the app modules to
+// JSON, for debugging purposes. The app must implement types.HasCollectionsSchemas.
+func ExportCollectionsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-collections",
+		Short: "Export the state of the collections of the modules to JSON",
+		Long: `Export the state of the collections of the modules to JSON.
+
+By default, the output is a JSON object mapping the store key names of the modules to
+their collections, and every collection to the list of its entries. With --ndjson, the
+output is streamed as newline delimited JSON, every line holding an entry with the name
+of its store and collection.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			serverCtx.Config.SetRoot(homeDir)
+
+			db, err := openDB(serverCtx.Config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			defer app.Close()
+
+			schemasApp, ok := app.(types.HasCollectionsSchemas)
+			if !ok {
+				return fmt.Errorf("app does not expose the collections schemas of its modules")
+			}
+
+			schemas := schemasApp.CollectionsSchemas()
+			stores, _ := cmd.Flags().GetStringSlice(FlagStores)
+			if len(stores) == 0 {
+				for storeKey := range schemas {
+					stores = append(stores, storeKey)
+				}
+			}
+			sort.Strings(stores)
+
+			for _, storeKey := range stores {
+				if _, ok := schemas[storeKey]; !ok {
+					return fmt.Errorf("no collections schema registered for store %s", storeKey)
+				}
+			}
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == -1 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+
+			ms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("failed to load state at height %d: %w", height, err)
+			}
+			ctx := sdk.NewContext(ms, cmtproto.Header{Height: height}, false, serverCtx.Logger)
+
+			out := cmd.OutOrStdout()
+			if outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument); outputDocument != "" {
+				f, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				out = f
+			}
+
+			w := bufio.NewWriter(out)
+			if ndjson, _ := cmd.Flags().GetBool(FlagNDJSON); ndjson {
+				err = exportCollectionsNDJSON(ctx, w, stores, schemas)
+			} else {
+				err = exportCollectionsJSON(ctx, w, stores, schemas)
+			}
+			if err != nil {
+				return err
+			}
+
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().StringSlice(FlagStores, []string{}, "Comma-separated list of store keys to export. If empty, will export all stores")
+	cmd.Flags().Bool(FlagNDJSON, false, "Stream the entries as newline delimited JSON")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// exportCollectionsJSON writes a JSON object mapping the given store keys to
+// the JSON representation of their schema.
+func exportCollectionsJSON(ctx context.Context, w io.Writer, stores []string, schemas map[string]collections.Schema) error {
+	if _, err := w.Write([]byte("{")); err != nil {
+		return err
+	}
+
+	for i, storeKey := range stores {
+		if i > 0 {
+			if _, err := w.Write([]byte(",")); err != nil {
+				return err
+			}
+		}
+
+		nameBz, err := json.Marshal(storeKey)
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(append(nameBz, ':')); err != nil {
+			return err
+		}
+
+		if err := schemas[storeKey].ExportJSON(ctx, w); err != nil {
+			return fmt.Errorf("failed to export store %s: %w", storeKey, err)
+		}
+	}
+
+	_, err := w.Write([]byte("}\n"))
+	return err
+}
+
+// exportCollectionsNDJSON writes every entry of the schemas of the given store
+// keys as a line of JSON.
+func exportCollectionsNDJSON(ctx context.Context, w io.Writer, stores []string, schemas map[string]collections.Schema) error {
+	type entry struct {
+		Store      string          `json:"store"`
+		Collection string          `json:"collection"`
+		Key        json.RawMessage `json:"key"`
+		Value      json.RawMessage `json:"value,omitempty"`
+	}
+
+	encoder := json.NewEncoder(w)
+	for _, storeKey := range stores {
+		err := schemas[storeKey].WalkJSON(ctx, func(collection string, key, value json.RawMessage) error {
+			return encoder.Encode(entry{Store: storeKey, Collection: collection, Key: key, Value: value})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to export store %s: %w", storeKey, err)
+		}
+	}
+
+	return nil
+}
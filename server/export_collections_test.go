@@ -0,0 +1,101 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/cmdtest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// collectionsApp is an application exposing the collections schemas of its
+// stores, only implementing the methods used by the export-collections command.
+type collectionsApp struct {
+	types.Application
+
+	cms     storetypes.CommitMultiStore
+	schemas map[string]collections.Schema
+}
+
+func (a collectionsApp) CommitMultiStore() storetypes.CommitMultiStore { return a.cms }
+
+func (a collectionsApp) CollectionsSchemas() map[string]collections.Schema { return a.schemas }
+
+func (a collectionsApp) Close() error { return nil }
+
+// newCollectionsApp returns an app with a bank store holding balances at
+// height 1 and 2, and an empty mint store.
+func newCollectionsApp(t *testing.T) collectionsApp {
+	t.Helper()
+
+	bankKey := storetypes.NewKVStoreKey("bank")
+	mintKey := storetypes.NewKVStoreKey("mint")
+	cms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(mintKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	bankSB := collections.NewSchemaBuilder(runtime.NewKVStoreService(bankKey))
+	balances := collections.NewMap(bankSB, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	denoms := collections.NewKeySet(bankSB, collections.NewPrefix(2), "denoms", collections.StringKey)
+	bankSchema, err := bankSB.Build()
+	require.NoError(t, err)
+
+	mintSB := collections.NewSchemaBuilder(runtime.NewKVStoreService(mintKey))
+	collections.NewItem(mintSB, collections.NewPrefix(1), "minter", collections.StringValue)
+	mintSchema, err := mintSB.Build()
+	require.NoError(t, err)
+
+	for height, balance := range []uint64{10, 20} {
+		ms := cms.CacheMultiStore()
+		ctx := sdk.NewContext(ms, cmtproto.Header{Height: int64(height + 1)}, false, log.NewNopLogger())
+		require.NoError(t, balances.Set(ctx, "alice", balance))
+		require.NoError(t, denoms.Set(ctx, "stake"))
+		ms.Write()
+		cms.Commit()
+	}
+
+	return collectionsApp{
+		cms:     cms,
+		schemas: map[string]collections.Schema{"bank": bankSchema, "mint": mintSchema},
+	}
+}
+
+func TestExportCollectionsCmd(t *testing.T) {
+	app := newCollectionsApp(t)
+	homeDir := t.TempDir()
+
+	sys := cmdtest.NewSystem()
+	sys.AddCommands(server.ExportCollectionsCmd(func(log.Logger, dbm.DB, io.Writer, types.AppOptions) types.Application {
+		return app
+	}, homeDir))
+
+	sCtx := server.NewContext(viper.New(), cmtcfg.DefaultConfig(), log.NewNopLogger())
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, sCtx)
+
+	res := sys.MustRunC(t, ctx, "export-collections")
+	require.Equal(t, `{"bank":{"balances":[{"key":"alice","value":"20"}],"denoms":[{"key":"stake"}]},"mint":{"minter":[]}}`+"\n", res.Stdout.String())
+
+	res = sys.MustRunC(t, ctx, "export-collections", "--height=1", "--stores=bank", "--ndjson")
+	require.Equal(t, `{"store":"bank","collection":"balances","key":"alice","value":"10"}
+{"store":"bank","collection":"denoms","key":"stake"}
+`, res.Stdout.String())
+
+	res = sys.RunC(ctx, "export-collections", "--stores=staking")
+	require.ErrorContains(t, res.Err, "no collections schema registered for store staking")
+}
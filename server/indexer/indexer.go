@@ -0,0 +1,372 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keys of the indexer database. Rows are stored under their table prefix,
+// followed by the big endian encoded height of the block and their big endian
+// encoded sequence number within the block.
+var (
+	lastHeightKey = []byte{0x00}
+
+	tablePrefixes = map[Table][]byte{
+		Table_TABLE_TXS:    {0x01},
+		Table_TABLE_EVENTS: {0x02},
+		Table_TABLE_STATE:  {0x03},
+	}
+)
+
+// Column names shared by several tables.
+const (
+	ColumnHeight = "height"
+	ColumnTxHash = "tx_hash"
+
+	// AttributeColumnPrefix is the prefix of the event attribute columns.
+	AttributeColumnPrefix = "attributes."
+
+	// AllCollections selects all the collections of all the registered schemas,
+	// or of a single store when used as "<store>/*".
+	AllCollections = "*"
+)
+
+var _ storetypes.ABCIListener = (*Indexer)(nil)
+
+// Indexer indexes the transactions, events and state changes of committed
+// blocks into a local database, which can be queried with compound filters,
+// ordering and cursor pagination. It implements the ABCIListener interface:
+// the rows of a block are gathered when it is finalized, and written once it
+// is committed.
+//
+// State changes are only indexed for the collections of the registered
+// schemas selected by the indexer, and require the application to listen to
+// the changes of their stores.
+type Indexer struct {
+	db          dbm.DB
+	txConfig    client.TxConfig
+	collections []string
+	schemas     map[string]collections.Schema
+
+	mtx     sync.Mutex
+	height  int64
+	pending []row
+}
+
+// row is a row of a table, along with its key in the database.
+type row struct {
+	key []byte
+	row *Row
+}
+
+// NewIndexer returns a new indexer storing its rows in the given database. The
+// tx config is used to decode the transactions of a block, and to encode them
+// as JSON, it may be nil in which case transactions are not decoded. The
+// indexed collections select the collections whose state is indexed, in the
+// form "<store>/<collection>", "<store>/*" or "*".
+func NewIndexer(db dbm.DB, txConfig client.TxConfig, indexedCollections []string) *Indexer {
+	return &Indexer{
+		db:          db,
+		txConfig:    txConfig,
+		collections: indexedCollections,
+		schemas:     make(map[string]collections.Schema),
+	}
+}
+
+// RegisterSchema registers the collections schema of the store with the given
+// name, so that the state changes of its selected collections are indexed.
+func (i *Indexer) RegisterSchema(storeKey string, schema collections.Schema) {
+	i.schemas[storeKey] = schema
+}
+
+// StoreKeys returns the sorted names of the stores having at least one indexed
+// collection. The application must listen to the changes of these stores.
+func (i *Indexer) StoreKeys() []string {
+	var storeKeys []string
+	for storeKey, schema := range i.schemas {
+		for _, coll := range schema.ListCollections() {
+			if i.isIndexed(storeKey, coll.GetName()) {
+				storeKeys = append(storeKeys, storeKey)
+				break
+			}
+		}
+	}
+
+	sort.Strings(storeKeys)
+	return storeKeys
+}
+
+// Collections returns the sorted list of indexed collections, in the form
+// "<store>/<collection>".
+func (i *Indexer) Collections() []string {
+	var names []string
+	for storeKey, schema := range i.schemas {
+		for _, coll := range schema.ListCollections() {
+			if i.isIndexed(storeKey, coll.GetName()) {
+				names = append(names, storeKey+"/"+coll.GetName())
+			}
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// isIndexed returns true if the given collection is selected by the indexer.
+func (i *Indexer) isIndexed(storeKey, collection string) bool {
+	for _, selected := range i.collections {
+		switch selected {
+		case AllCollections, storeKey + "/" + AllCollections, storeKey + "/" + collection:
+			return true
+		}
+	}
+
+	return false
+}
+
+// LastHeight returns the height of the last indexed block, or 0 if no block
+// was indexed yet.
+func (i *Indexer) LastHeight() (int64, error) {
+	bz, err := i.db.Get(lastHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// Close closes the indexer database.
+func (i *Indexer) Close() error {
+	return i.db.Close()
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface. It gathers the
+// transaction and event rows of the finalized block, to be written once it is
+// committed.
+func (i *Indexer) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	i.height = req.Height
+	i.pending = nil
+
+	var eventIndex uint32
+	addEvents := func(txHash string, events []abci.Event) {
+		for _, event := range events {
+			i.pending = append(i.pending, row{
+				key: rowKey(Table_TABLE_EVENTS, req.Height, eventIndex),
+				row: eventRow(req.Height, txHash, eventIndex, event),
+			})
+			eventIndex++
+		}
+	}
+
+	addEvents("", res.Events)
+
+	txs, err := i.decodeTxs(req.Txs)
+	if err != nil {
+		return err
+	}
+
+	for index, txResult := range res.TxResults {
+		if txResult == nil || index >= len(txs) {
+			continue
+		}
+
+		tx := txs[index]
+		i.pending = append(i.pending, row{
+			key: rowKey(Table_TABLE_TXS, req.Height, uint32(index)),
+			row: txRow(req.Height, index, tx, txResult),
+		})
+
+		addEvents(tx.hash, txResult.Events)
+	}
+
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. It writes the rows of the
+// committed block, along with the changes of its indexed collections.
+func (i *Indexer) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	defer func() { i.pending = nil }()
+
+	var seq uint32
+	for _, pair := range changeSet {
+		r, err := i.stateRow(i.height, pair)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			continue
+		}
+
+		i.pending = append(i.pending, row{
+			key: rowKey(Table_TABLE_STATE, i.height, seq),
+			row: r,
+		})
+		seq++
+	}
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	for _, r := range i.pending {
+		bz, err := r.row.Marshal()
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Set(r.key, bz); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(lastHeightKey, sdk.Uint64ToBigEndian(uint64(i.height))); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// decodedTx is a transaction of a block which was decoded by the application.
+type decodedTx struct {
+	hash string
+	json string
+}
+
+// decodeTxs returns the transactions of a block having a result, i.e. the ones
+// the application was able to decode, in the order of their results.
+func (i *Indexer) decodeTxs(rawTxs [][]byte) ([]decodedTx, error) {
+	txs := make([]decodedTx, 0, len(rawTxs))
+	for _, rawTx := range rawTxs {
+		tx := decodedTx{hash: fmt.Sprintf("%X", cmttypes.Tx(rawTx).Hash())}
+
+		if i.txConfig != nil {
+			decoded, err := i.txConfig.TxDecoder()(rawTx)
+			if err != nil {
+				continue
+			}
+
+			bz, err := i.txConfig.TxJSONEncoder()(decoded)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode tx %s to JSON: %w", tx.hash, err)
+			}
+
+			tx.json = string(bz)
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// stateRow returns the row of a state change, or nil if the change does not
+// belong to an indexed collection.
+func (i *Indexer) stateRow(height int64, pair *storetypes.StoreKVPair) (*Row, error) {
+	schema, ok := i.schemas[pair.StoreKey]
+	if !ok {
+		return nil, nil
+	}
+
+	for _, coll := range schema.ListCollections() {
+		prefix := coll.GetPrefix()
+		if !bytes.HasPrefix(pair.Key, prefix) {
+			continue
+		}
+
+		if !i.isIndexed(pair.StoreKey, coll.GetName()) {
+			return nil, nil
+		}
+
+		columns := map[string]string{
+			ColumnHeight: strconv.FormatInt(height, 10),
+			"store":      pair.StoreKey,
+			"collection": coll.GetName(),
+			"key":        hex.EncodeToString(pair.Key[len(prefix):]),
+			"deleted":    strconv.FormatBool(pair.Delete),
+		}
+
+		if !pair.Delete {
+			valueCodec := coll.ValueCodec()
+			value, err := valueCodec.Decode(pair.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode value of %s/%s: %w", pair.StoreKey, coll.GetName(), err)
+			}
+
+			bz, err := valueCodec.EncodeJSON(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode value of %s/%s to JSON: %w", pair.StoreKey, coll.GetName(), err)
+			}
+
+			columns["value"] = string(bz)
+		}
+
+		return &Row{Columns: columns}, nil
+	}
+
+	return nil, nil
+}
+
+// txRow returns the row of a transaction.
+func txRow(height int64, index int, tx decodedTx, res *abci.ExecTxResult) *Row {
+	columns := map[string]string{
+		ColumnHeight: strconv.FormatInt(height, 10),
+		"index":      strconv.Itoa(index),
+		"hash":       tx.hash,
+		"code":       strconv.FormatUint(uint64(res.Code), 10),
+		"codespace":  res.Codespace,
+		"gas_wanted": strconv.FormatInt(res.GasWanted, 10),
+		"gas_used":   strconv.FormatInt(res.GasUsed, 10),
+		"log":        res.Log,
+	}
+
+	if tx.json != "" {
+		columns["tx"] = tx.json
+	}
+
+	return &Row{Columns: columns}
+}
+
+// eventRow returns the row of an event. Attribute values are stored as they
+// were emitted, i.e. JSON encoded for typed events.
+func eventRow(height int64, txHash string, eventIndex uint32, event abci.Event) *Row {
+	columns := map[string]string{
+		ColumnHeight:  strconv.FormatInt(height, 10),
+		ColumnTxHash:  txHash,
+		"event_index": strconv.FormatUint(uint64(eventIndex), 10),
+		"type":        event.Type,
+	}
+
+	for _, attr := range event.Attributes {
+		columns[AttributeColumnPrefix+attr.Key] = attr.Value
+	}
+
+	return &Row{Columns: columns}
+}
+
+// rowKey returns the database key of a row.
+func rowKey(table Table, height int64, seq uint32) []byte {
+	key := make([]byte, 0, 13)
+	key = append(key, tablePrefixes[table]...)
+	key = binary.BigEndian.AppendUint64(key, uint64(height))
+	return binary.BigEndian.AppendUint32(key, seq)
+}
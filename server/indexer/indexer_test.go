@@ -0,0 +1,258 @@
+package indexer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server/indexer"
+)
+
+func newSchema(t *testing.T) collections.Schema {
+	t.Helper()
+
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) store.KVStore { return nil })
+	collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	collections.NewItem(sb, collections.NewPrefix(2), "supply", collections.Uint64Value)
+
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	return schema
+}
+
+func setupIndexer(t *testing.T) *indexer.Indexer {
+	t.Helper()
+
+	idx := indexer.NewIndexer(dbm.NewMemDB(), nil, []string{"bank/balances"})
+	idx.RegisterSchema("bank", newSchema(t))
+
+	ctx := context.Background()
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, idx.ListenFinalizeBlock(ctx,
+			abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{[]byte(fmt.Sprintf("tx%d", height))}},
+			abci.ResponseFinalizeBlock{
+				Events: []abci.Event{{Type: "begin", Attributes: []abci.EventAttribute{{Key: "module", Value: "mint"}}}},
+				TxResults: []*abci.ExecTxResult{{
+					Code:    uint32(height % 2),
+					GasUsed: 100 * (4 - height),
+					Events: []abci.Event{{
+						Type:       "transfer",
+						Attributes: []abci.EventAttribute{{Key: "amount", Value: fmt.Sprintf("%dstake", height)}},
+					}},
+				}},
+			},
+		))
+
+		balance, err := collections.Uint64Value.Encode(uint64(height))
+		require.NoError(t, err)
+
+		bob := &storetypes.StoreKVPair{StoreKey: "bank", Key: append([]byte{1}, "bob"...), Value: balance}
+		if height == 3 {
+			bob = &storetypes.StoreKVPair{StoreKey: "bank", Key: bob.Key, Delete: true}
+		}
+
+		require.NoError(t, idx.ListenCommit(ctx, abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+			{StoreKey: "bank", Key: append([]byte{1}, "alice"...), Value: balance},
+			{StoreKey: "bank", Key: []byte{2}, Value: balance},
+			{StoreKey: "staking", Key: []byte{1}, Value: balance},
+			bob,
+		}))
+	}
+
+	return idx
+}
+
+func column(rows []*indexer.Row, name string) []string {
+	values := make([]string, len(rows))
+	for i, row := range rows {
+		values[i] = row.Columns[name]
+	}
+
+	return values
+}
+
+func TestIndexer(t *testing.T) {
+	idx := setupIndexer(t)
+
+	height, err := idx.LastHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+	require.Equal(t, []string{"bank/balances"}, idx.Collections())
+	require.Equal(t, []string{"bank"}, idx.StoreKeys())
+
+	res, err := idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_TXS})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3"}, column(res.Rows, "height"))
+	require.Equal(t, []string{"300", "200", "100"}, column(res.Rows, "gas_used"))
+	require.Empty(t, res.NextCursor)
+
+	res, err = idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_EVENTS})
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 6)
+	require.Equal(t, "", res.Rows[0].Columns["tx_hash"])
+	require.Equal(t, "mint", res.Rows[0].Columns["attributes.module"])
+	require.NotEmpty(t, res.Rows[1].Columns["tx_hash"])
+	require.Equal(t, "1stake", res.Rows[1].Columns["attributes.amount"])
+
+	res, err = idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_STATE})
+	require.NoError(t, err)
+	require.Equal(t, []string{"616c696365", "626f62", "616c696365", "626f62", "616c696365", "626f62"}, column(res.Rows, "key"))
+	require.Equal(t, []string{`"1"`, `"1"`, `"2"`, `"2"`, `"3"`, ""}, column(res.Rows, "value"))
+	require.Equal(t, []string{"false", "false", "false", "false", "false", "true"}, column(res.Rows, "deleted"))
+}
+
+func TestIndexer_QueryFilter(t *testing.T) {
+	idx := setupIndexer(t)
+
+	condition := func(column string, operator indexer.Operator, value string) *indexer.Filter {
+		return &indexer.Filter{Condition: &indexer.Condition{Column: column, Operator: operator, Value: value}}
+	}
+
+	testCases := []struct {
+		name    string
+		filter  *indexer.Filter
+		heights []string
+	}{
+		{"equal", condition("code", indexer.Operator_OPERATOR_EQUAL, "0"), []string{"2"}},
+		{"not equal", condition("code", indexer.Operator_OPERATOR_NOT_EQUAL, "0"), []string{"1", "3"}},
+		{"numeric comparison", condition("gas_used", indexer.Operator_OPERATOR_LESS, "250"), []string{"2", "3"}},
+		{"missing column", condition("unknown", indexer.Operator_OPERATOR_NOT_EQUAL, "0"), nil},
+		{"negation", &indexer.Filter{Condition: &indexer.Condition{Column: "height", Operator: indexer.Operator_OPERATOR_GREATER_OR_EQUAL, Value: "2"}, Not: true}, []string{"1"}},
+		{
+			"all",
+			&indexer.Filter{All: []*indexer.Filter{
+				condition("height", indexer.Operator_OPERATOR_GREATER, "1"),
+				condition("code", indexer.Operator_OPERATOR_EQUAL, "1"),
+			}},
+			[]string{"3"},
+		},
+		{
+			"any",
+			&indexer.Filter{
+				Condition: condition("gas_used", indexer.Operator_OPERATOR_LESS_OR_EQUAL, "200").Condition,
+				Any: []*indexer.Filter{
+					condition("height", indexer.Operator_OPERATOR_EQUAL, "1"),
+					condition("height", indexer.Operator_OPERATOR_EQUAL, "3"),
+				},
+			},
+			[]string{"3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_TXS, Filter: tc.filter})
+			require.NoError(t, err)
+			require.Equal(t, len(tc.heights), len(res.Rows))
+			if len(tc.heights) > 0 {
+				require.Equal(t, tc.heights, column(res.Rows, "height"))
+			}
+		})
+	}
+
+	res, err := idx.Query(&indexer.QueryRequest{
+		Table:  indexer.Table_TABLE_EVENTS,
+		Filter: condition("attributes.amount", indexer.Operator_OPERATOR_PREFIX, "2"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2stake"}, column(res.Rows, "attributes.amount"))
+
+	_, err = idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_TXS, Filter: &indexer.Filter{All: []*indexer.Filter{{Condition: &indexer.Condition{Column: "code"}}}}})
+	require.ErrorIs(t, err, indexer.ErrInvalidQuery)
+
+	_, err = idx.Query(&indexer.QueryRequest{})
+	require.ErrorIs(t, err, indexer.ErrInvalidQuery)
+
+	_, err = idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_TXS, Limit: indexer.MaxLimit + 1})
+	require.ErrorIs(t, err, indexer.ErrInvalidQuery)
+}
+
+func TestIndexer_QueryPagination(t *testing.T) {
+	idx := setupIndexer(t)
+
+	// queryAll returns the pages of a query with the given ordering
+	queryAll := func(table indexer.Table, orderBy *indexer.OrderBy, name string) [][]string {
+		var (
+			pages  [][]string
+			cursor []byte
+		)
+		for {
+			res, err := idx.Query(&indexer.QueryRequest{Table: table, OrderBy: orderBy, Limit: 2, Cursor: cursor})
+			require.NoError(t, err)
+
+			pages = append(pages, column(res.Rows, name))
+			if res.NextCursor == nil {
+				return pages
+			}
+
+			cursor = res.NextCursor
+		}
+	}
+
+	require.Equal(t, [][]string{{"1", "2"}, {"3"}}, queryAll(indexer.Table_TABLE_TXS, nil, "height"))
+	require.Equal(t, [][]string{{"3", "2"}, {"1"}}, queryAll(indexer.Table_TABLE_TXS, &indexer.OrderBy{Column: "height", Descending: true}, "height"))
+	require.Equal(t, [][]string{{"100", "200"}, {"300"}}, queryAll(indexer.Table_TABLE_TXS, &indexer.OrderBy{Column: "gas_used"}, "gas_used"))
+	require.Equal(t, [][]string{{"3", "1"}, {"2"}}, queryAll(indexer.Table_TABLE_TXS, &indexer.OrderBy{Column: "code", Descending: true}, "height"))
+
+	res, err := idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_TXS, Limit: 1})
+	require.NoError(t, err)
+
+	_, err = idx.Query(&indexer.QueryRequest{Table: indexer.Table_TABLE_EVENTS, Cursor: res.NextCursor})
+	require.ErrorIs(t, err, indexer.ErrInvalidQuery)
+}
+
+func TestIndexer_QueryMixedValues(t *testing.T) {
+	idx := indexer.NewIndexer(dbm.NewMemDB(), nil, nil)
+
+	var events []abci.Event
+	for _, value := range []string{"10", "b", "9", "", "-1", "1a", "a"} {
+		events = append(events, abci.Event{Type: "mixed", Attributes: []abci.EventAttribute{{Key: "value", Value: value}}})
+	}
+
+	ctx := context.Background()
+	require.NoError(t, idx.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: 1}, abci.ResponseFinalizeBlock{Events: events}))
+	require.NoError(t, idx.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	// integers are ordered before strings, whatever the page size
+	expected := []string{"", "-1", "9", "10", "1a", "a", "b"}
+	for limit := uint64(1); limit <= uint64(len(expected)); limit++ {
+		var (
+			values []string
+			cursor []byte
+		)
+		for {
+			res, err := idx.Query(&indexer.QueryRequest{
+				Table:   indexer.Table_TABLE_EVENTS,
+				OrderBy: &indexer.OrderBy{Column: "attributes.value"},
+				Limit:   limit,
+				Cursor:  cursor,
+			})
+			require.NoError(t, err)
+
+			values = append(values, column(res.Rows, "attributes.value")...)
+			if res.NextCursor == nil {
+				break
+			}
+
+			cursor = res.NextCursor
+		}
+
+		require.Equal(t, expected, values, "limit %d", limit)
+	}
+
+	res, err := idx.Query(&indexer.QueryRequest{
+		Table:  indexer.Table_TABLE_EVENTS,
+		Filter: &indexer.Filter{Condition: &indexer.Condition{Column: "attributes.value", Operator: indexer.Operator_OPERATOR_GREATER, Value: "10"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "1a", "a"}, column(res.Rows, "attributes.value"))
+}
@@ -0,0 +1,395 @@
+package indexer
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	// DefaultLimit is the number of rows returned by a query without limit.
+	DefaultLimit = 100
+
+	// MaxLimit is the maximum number of rows returned by a query.
+	MaxLimit = 1000
+)
+
+// ErrInvalidQuery is returned for queries which cannot be executed.
+var ErrInvalidQuery = errors.New("invalid indexer query")
+
+// Query returns the rows of a table matching the request filter, in the
+// requested order.
+//
+// Rows are scanned in the order of the blocks they belong to. Queries ordered
+// by any other column than height scan all the rows matching the filter of the
+// table before returning a page, hence are more expensive.
+func (i *Indexer) Query(req *QueryRequest) (*QueryResponse, error) {
+	prefix, ok := tablePrefixes[req.Table]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown table %s", ErrInvalidQuery, req.Table)
+	}
+
+	if err := validateFilter(req.Filter); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		return nil, fmt.Errorf("%w: limit %d exceeds the maximum of %d", ErrInvalidQuery, limit, MaxLimit)
+	}
+
+	orderBy := req.OrderBy
+	if orderBy == nil {
+		orderBy = &OrderBy{}
+	}
+
+	var c *cursor
+	if len(req.Cursor) > 0 {
+		var err error
+		if c, err = decodeCursor(req.Cursor, prefix); err != nil {
+			return nil, err
+		}
+	}
+
+	if orderBy.Column == "" || orderBy.Column == ColumnHeight {
+		return i.queryByKey(prefix, req.Filter, orderBy.Descending, int(limit), c)
+	}
+
+	return i.queryByColumn(prefix, req.Filter, orderBy, int(limit), c)
+}
+
+// queryByKey returns the matching rows in the order of their keys, stopping
+// the scan as soon as a page is complete.
+func (i *Indexer) queryByKey(prefix []byte, filter *Filter, descending bool, limit int, c *cursor) (*QueryResponse, error) {
+	start, end := prefix, storetypes.PrefixEndBytes(prefix)
+	if c != nil {
+		if descending {
+			end = c.key
+		} else {
+			start = append(append([]byte{}, c.key...), 0x00)
+		}
+	}
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if descending {
+		it, err = i.db.ReverseIterator(start, end)
+	} else {
+		it, err = i.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	res := &QueryResponse{}
+	var lastKey []byte
+	for ; it.Valid(); it.Next() {
+		r := &Row{}
+		if err := r.Unmarshal(it.Value()); err != nil {
+			return nil, err
+		}
+
+		if !matchFilter(filter, r) {
+			continue
+		}
+
+		if len(res.Rows) == limit {
+			res.NextCursor = encodeCursor(cursor{key: lastKey})
+			break
+		}
+
+		res.Rows = append(res.Rows, r)
+		lastKey = append([]byte{}, it.Key()...)
+	}
+
+	return res, it.Error()
+}
+
+// queryByColumn returns the matching rows ordered by the given column, then by
+// their keys. Rows missing the column are ordered first. All the rows matching
+// the filter are scanned, but only the first page of them is kept in memory.
+func (i *Indexer) queryByColumn(prefix []byte, filter *Filter, orderBy *OrderBy, limit int, c *cursor) (*QueryResponse, error) {
+	it, err := i.db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	// compare returns the position of a row relative to another one, in the
+	// requested order.
+	compare := func(a, b cursor) int {
+		cmp := compareValues(a.value, b.value)
+		if cmp == 0 {
+			cmp = bytes.Compare(a.key, b.key)
+		}
+
+		if orderBy.Descending {
+			return -cmp
+		}
+
+		return cmp
+	}
+
+	// page holds the first limit+1 rows seen so far, the extra row telling
+	// whether there is a next page.
+	page := &rowHeap{compare: compare}
+	for ; it.Valid(); it.Next() {
+		r := &Row{}
+		if err := r.Unmarshal(it.Value()); err != nil {
+			return nil, err
+		}
+
+		if !matchFilter(filter, r) {
+			continue
+		}
+
+		sr := sortedRow{
+			cursor: cursor{key: append([]byte{}, it.Key()...), value: r.Columns[orderBy.Column]},
+			row:    r,
+		}
+
+		if c != nil && compare(sr.cursor, *c) <= 0 {
+			continue
+		}
+
+		if page.Len() <= limit {
+			heap.Push(page, sr)
+		} else if compare(sr.cursor, page.rows[0].cursor) < 0 {
+			page.rows[0] = sr
+			heap.Fix(page, 0)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	rows := page.rows
+	sort.Slice(rows, func(a, b int) bool {
+		return compare(rows[a].cursor, rows[b].cursor) < 0
+	})
+
+	res := &QueryResponse{}
+	for _, sr := range rows {
+		if len(res.Rows) == limit {
+			res.NextCursor = encodeCursor(rows[limit-1].cursor)
+			break
+		}
+
+		res.Rows = append(res.Rows, sr.row)
+	}
+
+	return res, nil
+}
+
+// sortedRow is a row along with its position in the requested order.
+type sortedRow struct {
+	cursor cursor
+	row    *Row
+}
+
+// rowHeap is a heap of rows whose root is the last row in the requested order.
+type rowHeap struct {
+	rows    []sortedRow
+	compare func(a, b cursor) int
+}
+
+var _ heap.Interface = (*rowHeap)(nil)
+
+func (h *rowHeap) Len() int           { return len(h.rows) }
+func (h *rowHeap) Less(a, b int) bool { return h.compare(h.rows[a].cursor, h.rows[b].cursor) > 0 }
+func (h *rowHeap) Swap(a, b int)      { h.rows[a], h.rows[b] = h.rows[b], h.rows[a] }
+func (h *rowHeap) Push(x any)         { h.rows = append(h.rows, x.(sortedRow)) }
+
+func (h *rowHeap) Pop() any {
+	last := h.rows[len(h.rows)-1]
+	h.rows = h.rows[:len(h.rows)-1]
+	return last
+}
+
+// cursor is the position of the last row of a page: its key, and its value of
+// the ordering column, if the rows are not ordered by key.
+type cursor struct {
+	key   []byte
+	value string
+}
+
+// encodeCursor encodes a cursor as the length prefixed column value, followed
+// by the key of the row.
+func encodeCursor(c cursor) []byte {
+	bz := binary.AppendUvarint(nil, uint64(len(c.value)))
+	bz = append(bz, c.value...)
+	return append(bz, c.key...)
+}
+
+// decodeCursor decodes a cursor, checking that it belongs to the table with the
+// given prefix.
+func decodeCursor(bz, prefix []byte) (*cursor, error) {
+	n, read := binary.Uvarint(bz)
+	if read <= 0 || uint64(len(bz)-read) < n {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+
+	c := &cursor{
+		value: string(bz[read : read+int(n)]),
+		key:   bz[read+int(n):],
+	}
+
+	if !bytes.HasPrefix(c.key, prefix) {
+		return nil, fmt.Errorf("%w: cursor does not belong to the queried table", ErrInvalidQuery)
+	}
+
+	return c, nil
+}
+
+// validateFilter checks that all the conditions of a filter are complete.
+func validateFilter(filter *Filter) error {
+	if filter == nil {
+		return nil
+	}
+
+	if cond := filter.Condition; cond != nil {
+		if cond.Column == "" {
+			return fmt.Errorf("%w: condition without column", ErrInvalidQuery)
+		}
+
+		if _, ok := Operator_name[int32(cond.Operator)]; !ok || cond.Operator == Operator_OPERATOR_UNSPECIFIED {
+			return fmt.Errorf("%w: invalid operator %s for column %s", ErrInvalidQuery, cond.Operator, cond.Column)
+		}
+	}
+
+	for _, f := range append(append([]*Filter{}, filter.All...), filter.Any...) {
+		if err := validateFilter(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchFilter returns true if the row matches the filter. A nil filter matches
+// all rows.
+func matchFilter(filter *Filter, r *Row) bool {
+	if filter == nil {
+		return true
+	}
+
+	return matchFilterTerms(filter, r) != filter.Not
+}
+
+// matchFilterTerms returns true if the row matches the condition and the sub
+// filters of a filter, ignoring its negation.
+func matchFilterTerms(filter *Filter, r *Row) bool {
+	if filter.Condition != nil && !matchCondition(filter.Condition, r) {
+		return false
+	}
+
+	for _, f := range filter.All {
+		if !matchFilter(f, r) {
+			return false
+		}
+	}
+
+	if len(filter.Any) == 0 {
+		return true
+	}
+
+	for _, f := range filter.Any {
+		if matchFilter(f, r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchCondition returns true if the row matches the condition.
+func matchCondition(cond *Condition, r *Row) bool {
+	value, ok := r.Columns[cond.Column]
+	if !ok {
+		return false
+	}
+
+	switch cond.Operator {
+	case Operator_OPERATOR_EQUAL:
+		return compareValues(value, cond.Value) == 0
+	case Operator_OPERATOR_NOT_EQUAL:
+		return compareValues(value, cond.Value) != 0
+	case Operator_OPERATOR_LESS:
+		return compareValues(value, cond.Value) < 0
+	case Operator_OPERATOR_LESS_OR_EQUAL:
+		return compareValues(value, cond.Value) <= 0
+	case Operator_OPERATOR_GREATER:
+		return compareValues(value, cond.Value) > 0
+	case Operator_OPERATOR_GREATER_OR_EQUAL:
+		return compareValues(value, cond.Value) >= 0
+	case Operator_OPERATOR_PREFIX:
+		return strings.HasPrefix(value, cond.Value)
+	case Operator_OPERATOR_CONTAINS:
+		return strings.Contains(value, cond.Value)
+	default:
+		return false
+	}
+}
+
+// compareValues compares two column values. Values are ordered by type first:
+// empty values, i.e. missing columns, then integers, then all other strings.
+// Values of the same type are compared numerically for integers, and
+// lexicographically for strings.
+func compareValues(a, b string) int {
+	if ta, tb := valueType(a), valueType(b); ta != tb {
+		if ta < tb {
+			return -1
+		}
+
+		return 1
+	}
+
+	ai, errA := strconv.ParseInt(a, 10, 64)
+	bi, errB := strconv.ParseInt(b, 10, 64)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+
+	switch {
+	case ai < bi:
+		return -1
+	case ai > bi:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Types of column values, in their order.
+const (
+	valueTypeEmpty = iota
+	valueTypeInteger
+	valueTypeString
+)
+
+// valueType returns the type of a column value.
+func valueType(value string) int {
+	if value == "" {
+		return valueTypeEmpty
+	}
+
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return valueTypeInteger
+	}
+
+	return valueTypeString
+}
@@ -0,0 +1,117 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// App options keys of the indexer configuration.
+const (
+	FlagEnable      = "indexer.enable"
+	FlagCollections = "indexer.collections"
+)
+
+// RegisterIndexer creates the indexer if it is enabled in the app options, and
+// registers it on the given app: the indexer listens to the ABCI calls of the
+// app and to the changes of the stores of its indexed collections, and its
+// query service is registered on the app's gRPC query router.
+//
+// The schemas map the store key names to the collections schemas of the
+// modules, only the collections of these schemas can be indexed. The indexer
+// database is stored in the data directory of the node home, using the app's
+// database backend. It returns nil if the indexer is disabled.
+func RegisterIndexer(
+	app *baseapp.BaseApp,
+	appOpts servertypes.AppOptions,
+	txConfig client.TxConfig,
+	keys map[string]*storetypes.KVStoreKey,
+	schemas map[string]collections.Schema,
+) (*Indexer, error) {
+	if !cast.ToBool(appOpts.Get(FlagEnable)) {
+		return nil, nil
+	}
+
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB("indexer", server.GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open indexer database: %w", err)
+	}
+
+	indexer := NewIndexer(db, txConfig, cast.ToStringSlice(appOpts.Get(FlagCollections)))
+	for storeKey, schema := range schemas {
+		indexer.RegisterSchema(storeKey, schema)
+	}
+
+	var listenedKeys []storetypes.StoreKey
+	for _, storeKey := range indexer.StoreKeys() {
+		if key, ok := keys[storeKey]; ok {
+			listenedKeys = append(listenedKeys, key)
+		}
+	}
+
+	app.CommitMultiStore().AddListeners(listenedKeys)
+	app.AddABCIListener(indexer)
+	RegisterQueryServer(app.GRPCQueryRouter(), NewQueryServer(indexer))
+
+	return indexer, nil
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	indexer *Indexer
+}
+
+// NewQueryServer returns a new indexer gRPC query server.
+func NewQueryServer(indexer *Indexer) QueryServer {
+	return queryServer{
+		indexer: indexer,
+	}
+}
+
+// Query implements the QueryServer.Query method.
+func (s queryServer) Query(_ context.Context, req *QueryRequest) (*QueryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := s.indexer.Query(req)
+	if err != nil {
+		if errors.Is(err, ErrInvalidQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// Status implements the QueryServer.Status method.
+func (s queryServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
+	height, err := s.indexer.LastHeight()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &StatusResponse{
+		LastHeight:  height,
+		Collections: s.indexer.Collections(),
+	}, nil
+}
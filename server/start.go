@@ -30,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
@@ -61,6 +62,8 @@ const (
 	FlagPruningInterval     = "pruning-interval"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
+	FlagQueryGasLimit       = "query-gas-limit"
+	FlagGasProfileBlocks    = "gas-profile-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 
@@ -190,6 +193,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a single gRPC or ABCI query may consume (0 means unlimited)")
+	cmd.Flags().Uint32(FlagGasProfileBlocks, 0, "Number of last blocks whose gas profile is recorded in memory (0 disables gas profiling)")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: the API must also be enabled)")
 	cmd.Flags().String(FlagAPIAddress, serverconfig.DefaultAPIAddress, "the API server address to listen on")
@@ -226,6 +231,11 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 }
 
 func start(svrCtx *Context, clientCtx client.Context, appCreator types.AppCreator, withCmt bool, opts StartCmdOptions) error {
+	// a node built without cgo cannot verify the bls12_381 signatures accepted by the rest of the network
+	if !bls12_381.Supported {
+		return fmt.Errorf("cannot start the node: %w", bls12_381.ErrNotSupported)
+	}
+
 	svrCfg, err := getAndValidateConfig(svrCtx)
 	if err != nil {
 		return err
@@ -294,6 +304,13 @@ func startInProcess(svrCtx *Context, svrCfg serverconfig.Config, clientCtx clien
 		svrCfg.GRPC.Enable = true
 	} else {
 		svrCtx.Logger.Info("starting node with ABCI CometBFT in-process")
+
+		// The events service must be registered before CometBFT starts, as it
+		// listens to the blocks finalized by the application.
+		if svrCfg.Events.Enable && (svrCfg.API.Enable || svrCfg.GRPC.Enable) {
+			app.RegisterEventsService(clientCtx, svrCfg)
+		}
+
 		tmNode, cleanupFn, err := startCmtNode(cmtCfg, app, svrCtx)
 		if err != nil {
 			return err
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
@@ -52,6 +53,10 @@ type (
 		// RegisterNodeService registers the node gRPC Query service.
 		RegisterNodeService(client.Context, config.Config)
 
+		// RegisterEventsService registers the typed events subscription gRPC
+		// service, fed with the events of committed blocks.
+		RegisterEventsService(client.Context, config.Config)
+
 		// CommitMultiStore return the multistore instance
 		CommitMultiStore() storetypes.CommitMultiStore
 
@@ -63,6 +68,12 @@ type (
 		Close() error
 	}
 
+	// HasCollectionsSchemas is implemented by applications exposing the
+	// collections schemas of their modules, by store key name.
+	HasCollectionsSchemas interface {
+		CollectionsSchemas() map[string]collections.Schema
+	}
+
 	// AppCreator is a function that allows us to lazily initialize an
 	// application using various configurations.
 	AppCreator func(log.Logger, dbm.DB, io.Writer, AppOptions) Application
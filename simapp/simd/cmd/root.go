@@ -192,11 +192,17 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(server.ExportCollectionsCmd(newApp, simapp.DefaultNodeHome))
+
+	// validate app.toml against the custom app config, including its custom sections
+	_, customAppConfig := initAppConfig()
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, simapp.DefaultNodeHome),
 		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
-		confixcmd.ConfigCommand(),
+		debugCmd,
+		confixcmd.ConfigCommandWithAppConfig(customAppConfig),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
 	)
@@ -0,0 +1,21 @@
+//go:build e2e
+// +build e2e
+
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+)
+
+func TestE2ETestSuite(t *testing.T) {
+	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+	cfg.NumValidators = 4
+	cfg.TimeoutCommit = time.Second
+	suite.Run(t, NewE2ETestSuite(cfg))
+}
@@ -0,0 +1,102 @@
+package slashing
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// E2ETestSuite exercises x/slashing and x/evidence by injecting faults in a
+// network of 4 validators with the same voting power.
+type E2ETestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func NewE2ETestSuite(cfg network.Config) *E2ETestSuite {
+	return &E2ETestSuite{cfg: cfg}
+}
+
+func (s *E2ETestSuite) SetupSuite() {
+	s.T().Log("setting up e2e test suite")
+
+	var slashingGenesis slashingtypes.GenesisState
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(s.cfg.GenesisState[slashingtypes.ModuleName], &slashingGenesis))
+
+	slashingGenesis.Params.SignedBlocksWindow = 10
+	slashingGenesis.Params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(5, 1)
+	slashingGenesis.Params.DowntimeJailDuration = time.Hour
+
+	bz, err := s.cfg.Codec.MarshalJSON(&slashingGenesis)
+	s.Require().NoError(err)
+	s.cfg.GenesisState[slashingtypes.ModuleName] = bz
+
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+}
+
+func (s *E2ETestSuite) TearDownSuite() {
+	s.T().Log("tearing down e2e test suite")
+	s.network.Cleanup()
+}
+
+// TestFaults runs the faults in sequence, as each of them changes the validator
+// set of the network.
+func (s *E2ETestSuite) TestFaults() {
+	s.Run("partition without quorum halts the network", func() {
+		s.Require().NoError(s.network.AssertLiveness(3, time.Minute))
+		s.Require().NoError(s.network.Partition([]int{0, 1}, []int{2, 3}))
+		s.Require().NoError(s.network.AssertHalted(10 * time.Second))
+
+		s.network.HealPartition()
+		s.Require().NoError(s.network.AssertLiveness(2, time.Minute))
+	})
+
+	s.Run("delayed votes keep the network live", func() {
+		s.Require().NoError(s.network.SetVoteFault(1, network.VoteFault{Delay: 200 * time.Millisecond}))
+		s.Require().NoError(s.network.AssertLiveness(3, time.Minute))
+
+		s.network.ClearFaults()
+	})
+
+	s.Run("paused validator is jailed for downtime", func() {
+		val, err := s.network.QueryValidator(3)
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.network.PauseValidator(3))
+		s.Require().NoError(s.network.AssertLiveness(2, time.Minute))
+		s.Require().NoError(s.network.WaitForJailed(3, 2*time.Minute))
+		s.Require().NoError(s.network.WaitForSlashed(3, val.Tokens, time.Minute))
+
+		info, err := s.network.QuerySigningInfo(3)
+		s.Require().NoError(err)
+		s.Require().False(info.Tombstoned)
+
+		s.Require().NoError(s.network.ResumeValidator(3))
+	})
+
+	s.Run("double signing validator is tombstoned", func() {
+		val, err := s.network.QueryValidator(2)
+		s.Require().NoError(err)
+
+		height, err := s.network.LatestHeight()
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.network.DoubleSign(2, height-1))
+		s.Require().NoError(s.network.WaitForTombstoned(2, time.Minute))
+		s.Require().NoError(s.network.WaitForJailed(2, time.Minute))
+		s.Require().NoError(s.network.WaitForSlashed(2, val.Tokens, time.Minute))
+
+		// the remaining validators keep the network live
+		s.Require().NoError(s.network.AssertLiveness(2, time.Minute))
+	})
+}
@@ -0,0 +1,193 @@
+package circuit_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/simapp"
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+const circuitErr = "circuit breaker disables execution of this message: /cosmos.bank.v1beta1.MsgSend"
+
+var sendURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type fixture struct {
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContextLegacy(false, cmtproto.Header{
+		Height: app.LastBlockHeight() + 1,
+		Time:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+
+	return &fixture{app: app, ctx: ctx, addrs: addrs}
+}
+
+func (f *fixture) newMsgSend(from sdk.AccAddress) *banktypes.MsgSend {
+	return banktypes.NewMsgSend(from, f.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+}
+
+// disable disables MsgSend for all senders, or only for the given senders.
+func (f *fixture) disable(t *testing.T, senders ...sdk.AccAddress) {
+	t.Helper()
+	if len(senders) == 0 {
+		assert.NilError(t, f.app.CircuitBreakerKeeper.DisableList.Set(f.ctx, sendURL))
+		return
+	}
+
+	breaker := circuittypes.CircuitBreaker{MsgTypeUrl: sendURL}
+	for _, sender := range senders {
+		breaker.Senders = append(breaker.Senders, sender.String())
+	}
+	assert.NilError(t, f.app.CircuitBreakerKeeper.CircuitBreakers.Set(f.ctx, sendURL, breaker))
+}
+
+func (f *fixture) reset(t *testing.T) {
+	t.Helper()
+	assert.NilError(t, f.app.CircuitBreakerKeeper.DisableList.Remove(f.ctx, sendURL))
+	assert.NilError(t, f.app.CircuitBreakerKeeper.CircuitBreakers.Remove(f.ctx, sendURL))
+}
+
+func TestAuthzExecNestedMsg(t *testing.T) {
+	f := initFixture(t)
+	granter, grantee := f.addrs[0], f.addrs[1]
+
+	expiration := f.ctx.BlockTime().Add(time.Hour)
+	err := f.app.AuthzKeeper.SaveGrant(f.ctx, grantee, granter, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), nil), &expiration)
+	assert.NilError(t, err)
+
+	exec := func() error {
+		msg := authz.NewMsgExec(grantee, []sdk.Msg{f.newMsgSend(granter)})
+		_, err := f.app.MsgServiceRouter().Handler(&msg)(f.ctx, &msg)
+		return err
+	}
+
+	// the nested message is disabled for all senders
+	f.disable(t)
+	assert.ErrorContains(t, exec(), circuitErr)
+
+	// the nested message is disabled for the granter, which is its signer
+	f.reset(t)
+	f.disable(t, granter)
+	assert.ErrorContains(t, exec(), circuitErr)
+
+	// the grantee is not the signer of the nested message
+	f.reset(t)
+	f.disable(t, grantee)
+	assert.NilError(t, exec())
+
+	f.reset(t)
+	assert.NilError(t, exec())
+}
+
+func TestGroupProposalNestedMsg(t *testing.T) {
+	f := initFixture(t)
+	admin := f.addrs[0]
+
+	createMsg, err := group.NewMsgCreateGroupWithPolicy(
+		admin.String(),
+		[]group.MemberRequest{{Address: admin.String(), Weight: "1"}},
+		"", "", false,
+		group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+	)
+	assert.NilError(t, err)
+	createRes, err := f.app.GroupKeeper.CreateGroupWithPolicy(f.ctx, createMsg)
+	assert.NilError(t, err)
+
+	policyAddr, err := sdk.AccAddressFromBech32(createRes.GroupPolicyAddress)
+	assert.NilError(t, err)
+	assert.NilError(t, banktestutil.FundAccount(f.ctx, f.app.BankKeeper, policyAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+
+	// submitProposal submits a proposal which is voted and executed at once,
+	// and returns its executor result. The proposals executed successfully are
+	// pruned, so their executor result is not found.
+	submitProposal := func() (group.ProposalExecutorResult, error) {
+		msg, err := group.NewMsgSubmitProposal(policyAddr.String(), []string{admin.String()}, []sdk.Msg{f.newMsgSend(policyAddr)}, "", group.Exec_EXEC_TRY, "title", "summary")
+		assert.NilError(t, err)
+		res, err := f.app.GroupKeeper.SubmitProposal(f.ctx, msg)
+		assert.NilError(t, err)
+
+		proposal, err := f.app.GroupKeeper.Proposal(f.ctx, &group.QueryProposalRequest{ProposalId: res.ProposalId})
+		if err != nil {
+			return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, err
+		}
+		return proposal.Proposal.ExecutorResult, nil
+	}
+
+	f.disable(t)
+	result, err := submitProposal()
+	assert.NilError(t, err)
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, result)
+
+	// the nested message is disabled for the group policy, which is its signer
+	f.reset(t)
+	f.disable(t, policyAddr)
+	result, err = submitProposal()
+	assert.NilError(t, err)
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, result)
+
+	f.reset(t)
+	_, err = submitProposal()
+	assert.ErrorContains(t, err, "not found")
+
+	balance := f.app.BankKeeper.GetBalance(f.ctx, policyAddr, sdk.DefaultBondDenom)
+	assert.Assert(t, balance.Amount.Equal(math.NewInt(90)))
+}
+
+func TestGovProposalNestedMsg(t *testing.T) {
+	f := initFixture(t)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	assert.NilError(t, banktestutil.FundModuleAccount(f.ctx, f.app.BankKeeper, govtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+
+	delegations := f.app.StakingKeeper.GetAllDelegations(f.ctx)
+	assert.Assert(t, len(delegations) > 0)
+	voter := sdk.MustAccAddressFromBech32(delegations[0].DelegatorAddress)
+
+	// executeProposal executes a proposal passed by the validator, and returns
+	// its final status.
+	executeProposal := func() v1.ProposalStatus {
+		proposal, err := f.app.GovKeeper.SubmitProposal(f.ctx, []sdk.Msg{f.newMsgSend(govAddr)}, "", "title", "summary", f.addrs[0], false)
+		assert.NilError(t, err)
+		assert.NilError(t, f.app.GovKeeper.ActivateVotingPeriod(f.ctx, proposal))
+		assert.NilError(t, f.app.GovKeeper.AddVote(f.ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+		proposal, err = f.app.GovKeeper.Proposals.Get(f.ctx, proposal.Id)
+		assert.NilError(t, err)
+		endCtx := f.ctx.WithBlockTime(proposal.VotingEndTime.Add(time.Second))
+		assert.NilError(t, gov.EndBlocker(endCtx, f.app.GovKeeper))
+
+		proposal, err = f.app.GovKeeper.Proposals.Get(f.ctx, proposal.Id)
+		assert.NilError(t, err)
+		return proposal.Status
+	}
+
+	f.disable(t)
+	assert.Equal(t, v1.StatusFailed, executeProposal())
+
+	f.reset(t)
+	assert.Equal(t, v1.StatusPassed, executeProposal())
+
+	balance := f.app.BankKeeper.GetBalance(f.ctx, f.addrs[2], sdk.DefaultBondDenom)
+	assert.Assert(t, balance.Amount.Equal(sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction).Add(math.NewInt(10))))
+}
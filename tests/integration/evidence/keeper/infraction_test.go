@@ -286,6 +286,59 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
 }
 
+func TestHandleVoteExtensionEquivocation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1).WithChainID("test-chain")
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, privKey := valAddresses[0], ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(operatorAddr, privKey.PubKey(), power, true)
+
+	_, err := f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, f.slashingKeeper.AddPubkey(ctx, privKey.PubKey()))
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info))
+
+	// the validator signs conflicting vote extensions at height 1
+	signVoteExtension := func(extension []byte) []byte {
+		signBytes, err := evidencetypes.VoteExtensionSignBytes(ctx.ChainID(), 1, 0, extension)
+		assert.NilError(t, err)
+		sig, err := privKey.Sign(signBytes)
+		assert.NilError(t, err)
+		return sig
+	}
+	e := evidencetypes.NewVoteExtensionEquivocation(1, 0, consAddr,
+		[]byte("price:100"), signVoteExtension([]byte("price:100")),
+		[]byte("price:200"), signVoteExtension([]byte("price:200")),
+	)
+	msg, err := evidencetypes.NewMsgSubmitEvidence(sdk.AccAddress(valAddresses[1]), e)
+	assert.NilError(t, err)
+
+	oldTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	ctx = ctx.WithBlockHeight(2)
+	msgServer := keeper.NewMsgServerImpl(*f.evidenceKeeper)
+	_, err = msgServer.SubmitEvidence(ctx, msg)
+	assert.NilError(t, err)
+
+	// should be slashed, jailed and tombstoned
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, consAddr))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	// the evidence with swapped vote extensions is a duplicate
+	swapped := evidencetypes.NewVoteExtensionEquivocation(1, 0, consAddr, e.VoteExtensionB, e.SignatureB, e.VoteExtensionA, e.SignatureA)
+	msg, err = evidencetypes.NewMsgSubmitEvidence(sdk.AccAddress(valAddresses[1]), swapped)
+	assert.NilError(t, err)
+	_, err = msgServer.SubmitEvidence(ctx, msg)
+	assert.ErrorIs(t, err, evidencetypes.ErrEvidenceExists)
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
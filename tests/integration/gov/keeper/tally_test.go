@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/collections"
+
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -515,3 +518,205 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	assert.Assert(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyOptimistic(t *testing.T) {
+	testCases := []struct {
+		name              string
+		powers            []int64
+		rejectedThreshold string
+		noVoters          int
+		expPasses         bool
+	}{
+		{name: "no one votes", powers: []int64{5, 5, 5}, rejectedThreshold: "0.1", noVoters: 0, expPasses: true},
+		{name: "no votes reach threshold", powers: []int64{5, 5, 5}, rejectedThreshold: "0.1", noVoters: 1, expPasses: false},
+		{name: "no votes below threshold", powers: []int64{5, 5, 5}, rejectedThreshold: "0.5", noVoters: 1, expPasses: true},
+		{name: "no votes above threshold", powers: []int64{5, 5, 5}, rejectedThreshold: "0.5", noVoters: 2, expPasses: false},
+		{name: "no bonded tokens", powers: []int64{0, 0, 0}, rejectedThreshold: "0.1", noVoters: 0, expPasses: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := initFixture(t)
+
+			ctx := f.ctx
+
+			addrs, _ := createValidators(t, f, tc.powers)
+
+			params, err := f.govKeeper.Params.Get(ctx)
+			assert.NilError(t, err)
+			params.OptimisticAuthorizedAddresses = []string{addrs[0].String()}
+			params.OptimisticRejectedThreshold = tc.rejectedThreshold
+			assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+			tp := TestProposal
+			proposal, err := f.govKeeper.SubmitOptimisticProposal(ctx, tp, "", "test", "description", addrs[0])
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			f.govKeeper.SetProposal(ctx, proposal)
+
+			for i := 0; i < tc.noVoters; i++ {
+				assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[i], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			}
+
+			proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+			assert.NilError(t, err)
+			passes, burnDeposits, _, err := f.govKeeper.Tally(ctx, proposal)
+			assert.NilError(t, err)
+
+			assert.Equal(t, tc.expPasses, passes)
+			assert.Assert(t, burnDeposits == false)
+		})
+	}
+}
+
+func TestTallyBreakdown(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	addrs, valAddrs := createValidators(t, f, []int64{5, 6, 7})
+
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+
+	// addrs[3] overrides the vote of val1, addrs[4] inherits it
+	_, err := f.stakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+	val1, found = f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	_, err = f.stakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	f.stakingKeeper.EndBlocker(ctx)
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	votingEndTime := ctx.BlockTime()
+	proposal.VotingEndTime = &votingEndTime
+	f.govKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[3], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	_, tallyResults, err := f.govKeeper.TallyAndSetBreakdown(ctx, proposal)
+	assert.NilError(t, err)
+
+	tokens := func(power int64) string {
+		return f.stakingKeeper.TokensFromConsensusPower(ctx, power).String()
+	}
+	assert.Equal(t, tokens(41), tallyResults.YesCount)
+	assert.Equal(t, tokens(30), tallyResults.NoCount)
+
+	breakdown, err := f.govKeeper.TallyBreakdowns.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	assert.Equal(t, tokens(11), breakdown.DirectTally.YesCount)
+	assert.Equal(t, tokens(30), breakdown.DirectTally.NoCount)
+	assert.Equal(t, tokens(30), breakdown.InheritedTally.YesCount)
+	assert.Equal(t, "0", breakdown.InheritedTally.NoCount)
+	assert.Equal(t, uint64(1), breakdown.OverridingVoters)
+
+	// val3 did not vote, so it has no validator tally
+	_, err = f.govKeeper.ValidatorTallies.Get(ctx, collections.Join(proposalID, valAddrs[2]))
+	assert.ErrorIs(t, err, collections.ErrNotFound)
+
+	val1Tally, err := f.govKeeper.ValidatorTallies.Get(ctx, collections.Join(proposalID, valAddrs[0]))
+	assert.NilError(t, err)
+	assert.Equal(t, tokens(30), val1Tally.InheritedPower)
+	assert.Equal(t, tokens(30), val1Tally.OverriddenPower)
+
+	val2Tally, err := f.govKeeper.ValidatorTallies.Get(ctx, collections.Join(proposalID, valAddrs[1]))
+	assert.NilError(t, err)
+	assert.Equal(t, "0", val2Tally.InheritedPower)
+	assert.Equal(t, "0", val2Tally.OverriddenPower)
+
+	// a validator voting with its self-delegation does not override itself
+	val1VoterTally, err := f.govKeeper.VoterTallies.Get(ctx, collections.Join(proposalID, addrs[0]))
+	assert.NilError(t, err)
+	assert.Equal(t, tokens(5), val1VoterTally.Power)
+	assert.Assert(t, len(val1VoterTally.OverriddenValidators) == 0)
+
+	overridingVoterTally, err := f.govKeeper.VoterTallies.Get(ctx, collections.Join(proposalID, addrs[3]))
+	assert.NilError(t, err)
+	assert.Equal(t, tokens(30), overridingVoterTally.Power)
+	assert.DeepEqual(t, []string{valAddrs[0].String()}, overridingVoterTally.OverriddenValidators)
+
+	// the voter tallies are pruned once the retention period ended
+	params, err := f.govKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	pruningTime := votingEndTime.Add(*params.VoterTallyRetention)
+	has, err := f.govKeeper.VoterTalliesQueue.Has(ctx, collections.Join(pruningTime, proposalID))
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+
+	assert.NilError(t, f.govKeeper.PruneVoterTallies(ctx.WithBlockTime(pruningTime.Add(-time.Second))))
+	has, err = f.govKeeper.VoterTallies.Has(ctx, collections.Join(proposalID, addrs[3]))
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+
+	assert.NilError(t, f.govKeeper.PruneVoterTallies(ctx.WithBlockTime(pruningTime)))
+	has, err = f.govKeeper.VoterTallies.Has(ctx, collections.Join(proposalID, addrs[3]))
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+	has, err = f.govKeeper.VoterTalliesQueue.Has(ctx, collections.Join(pruningTime, proposalID))
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+
+	// the summary and the validator tallies are kept
+	_, err = f.govKeeper.TallyBreakdowns.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	_, err = f.govKeeper.ValidatorTallies.Get(ctx, collections.Join(proposalID, valAddrs[0]))
+	assert.NilError(t, err)
+}
+
+func TestTallyBreakdownWithoutVoterTallyRetention(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	params, err := f.govKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.VoterTallyRetention = nil
+	assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+	addrs, valAddrs := createValidators(t, f, []int64{5, 6, 7})
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	votingEndTime := ctx.BlockTime()
+	proposal.VotingEndTime = &votingEndTime
+	f.govKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	_, _, err = f.govKeeper.TallyAndSetBreakdown(ctx, proposal)
+	assert.NilError(t, err)
+
+	_, err = f.govKeeper.TallyBreakdowns.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	_, err = f.govKeeper.ValidatorTallies.Get(ctx, collections.Join(proposalID, valAddrs[0]))
+	assert.NilError(t, err)
+
+	// without a retention period no voter tallies are stored
+	_, err = f.govKeeper.VoterTallies.Get(ctx, collections.Join(proposalID, addrs[0]))
+	assert.ErrorIs(t, err, collections.ErrNotFound)
+}
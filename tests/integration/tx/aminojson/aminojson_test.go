@@ -344,7 +344,7 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		},
 		"slashing/params/empty_dec": {
 			gogo:   &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7},
-			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}},
+			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}, DowntimeHistoryDecay: &durationpb.Duration{}},
 		},
 		// This test cases demonstrates the expected contract and proper way to set a cosmos.Dec field represented
 		// as bytes in protobuf message, namely:
@@ -357,6 +357,7 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			pulsar: &slashingapi.Params{
 				DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7},
 				MinSignedPerWindow:   dec10bz,
+				DowntimeHistoryDecay: &durationpb.Duration{},
 			},
 		},
 		"staking/create_validator": {
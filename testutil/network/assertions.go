@@ -0,0 +1,154 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The assertions below observe the chain through the first validator, which
+// should not be paused nor isolated from the majority of the network.
+
+// AssertLiveness checks that the network commits the given number of blocks
+// within the timeout.
+func (n *Network) AssertLiveness(blocks int64, timeout time.Duration) error {
+	height, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	if _, err := n.WaitForHeightWithTimeout(height+blocks, timeout); err != nil {
+		return fmt.Errorf("network did not commit %d blocks after height %d: %w", blocks, height, err)
+	}
+
+	return nil
+}
+
+// AssertHalted checks that the network does not commit any block during the
+// given duration.
+func (n *Network) AssertHalted(d time.Duration) error {
+	height, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	time.Sleep(d)
+
+	latestHeight, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	if latestHeight != height {
+		return fmt.Errorf("network committed blocks %d to %d while expected to be halted", height+1, latestHeight)
+	}
+
+	return nil
+}
+
+// QueryValidator returns the staking validator of the validator at index i.
+func (n *Network) QueryValidator(i int) (stakingtypes.Validator, error) {
+	if err := n.checkValidatorIndex(i); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	queryClient := stakingtypes.NewQueryClient(n.Validators[0].ClientCtx)
+	res, err := queryClient.Validator(context.Background(), &stakingtypes.QueryValidatorRequest{
+		ValidatorAddr: n.Validators[i].ValAddress.String(),
+	})
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	return res.Validator, nil
+}
+
+// QuerySigningInfo returns the slashing signing info of the validator at index i.
+func (n *Network) QuerySigningInfo(i int) (slashingtypes.ValidatorSigningInfo, error) {
+	if err := n.checkValidatorIndex(i); err != nil {
+		return slashingtypes.ValidatorSigningInfo{}, err
+	}
+
+	queryClient := slashingtypes.NewQueryClient(n.Validators[0].ClientCtx)
+	res, err := queryClient.SigningInfo(context.Background(), &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdk.ConsAddress(n.Validators[i].PubKey.Address()).String(),
+	})
+	if err != nil {
+		return slashingtypes.ValidatorSigningInfo{}, err
+	}
+
+	return res.ValSigningInfo, nil
+}
+
+// WaitForJailed waits for the validator at index i to be jailed, returning an
+// error if it is not jailed within the timeout.
+func (n *Network) WaitForJailed(i int, timeout time.Duration) error {
+	return n.waitForValidator(i, timeout, "jailed", func(val stakingtypes.Validator) bool {
+		return val.IsJailed()
+	})
+}
+
+// WaitForSlashed waits for the tokens of the validator at index i to fall below
+// the given tokens, returning an error if it is not slashed within the timeout.
+func (n *Network) WaitForSlashed(i int, tokens sdkmath.Int, timeout time.Duration) error {
+	return n.waitForValidator(i, timeout, "slashed", func(val stakingtypes.Validator) bool {
+		return val.Tokens.LT(tokens)
+	})
+}
+
+// WaitForTombstoned waits for the validator at index i to be tombstoned, returning
+// an error if it is not tombstoned within the timeout.
+func (n *Network) WaitForTombstoned(i int, timeout time.Duration) error {
+	return n.waitFor(timeout, fmt.Sprintf("validator %d to be tombstoned", i), func() (bool, error) {
+		info, err := n.QuerySigningInfo(i)
+		if err != nil {
+			return false, err
+		}
+
+		return info.Tombstoned, nil
+	})
+}
+
+func (n *Network) waitForValidator(i int, timeout time.Duration, state string, cond func(stakingtypes.Validator) bool) error {
+	return n.waitFor(timeout, fmt.Sprintf("validator %d to be %s", i, state), func() (bool, error) {
+		val, err := n.QueryValidator(i)
+		if err != nil {
+			return false, err
+		}
+
+		return cond(val), nil
+	})
+}
+
+// waitFor checks the condition every second, until it holds or the timeout is
+// exceeded.
+func (n *Network) waitFor(timeout time.Duration, desc string, cond func() (bool, error)) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var lastErr error
+	for {
+		select {
+		case <-timer.C:
+			if lastErr != nil {
+				return fmt.Errorf("timeout exceeded waiting for %s: %w", desc, lastErr)
+			}
+			return fmt.Errorf("timeout exceeded waiting for %s", desc)
+		case <-ticker.C:
+			ok, err := cond()
+			if err == nil && ok {
+				return nil
+			}
+			lastErr = err
+		}
+	}
+}
@@ -19,6 +19,14 @@ exposed. Due to this exact same limitation, only a single test network can exist
 at a time. A caller must be certain it calls Cleanup after it no longer needs
 the network.
 
+Faults can be injected in a running network to test its liveness and the
+slashing of misbehaving validators: PauseValidator isolates a validator,
+Partition splits the validators into groups which cannot reach each other,
+SetVoteFault drops or delays the consensus votes of a validator and DoubleSign
+submits the evidence of a validator signing conflicting votes. The effects of
+the faults are checked with AssertLiveness, AssertHalted and the WaitForJailed,
+WaitForSlashed and WaitForTombstoned assertions.
+
 A typical testing flow might look like the following:
 
 	type IntegrationTestSuite struct {
@@ -0,0 +1,377 @@
+package network
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	mrand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	pvm "github.com/cometbft/cometbft/privval"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// VoteFault defines how the consensus votes signed by a validator are delivered
+// to the other validators of the network.
+type VoteFault struct {
+	// DropRate is the probability that a vote is dropped, between 0 and 1.
+	DropRate float64
+	// Delay is the delay before a vote which is not dropped is delivered.
+	Delay time.Duration
+}
+
+// faultInjector holds the faults injected in the network. The faults apply to
+// the messages received by the validators, as every validator wraps the
+// reactors of its CometBFT node with the faultyReactor.
+type faultInjector struct {
+	mtx sync.RWMutex
+
+	nodeIDs     []p2p.ID          // the node ID of each validator
+	switches    []*p2p.Switch     // the p2p switch of each validator
+	nodeIndexes map[p2p.ID]int    // the validator index by node ID
+	consIndexes map[string]int    // the validator index by consensus address
+	groups      []int             // the partition group of each validator, nil when the network is not partitioned
+	paused      map[int]bool      // the paused validators
+	voteFaults  map[int]VoteFault // the faults of the votes signed by each validator
+}
+
+func newFaultInjector(vals []*Validator) *faultInjector {
+	f := &faultInjector{
+		nodeIDs:     make([]p2p.ID, len(vals)),
+		switches:    make([]*p2p.Switch, len(vals)),
+		nodeIndexes: make(map[p2p.ID]int, len(vals)),
+		consIndexes: make(map[string]int, len(vals)),
+		paused:      make(map[int]bool),
+		voteFaults:  make(map[int]VoteFault),
+	}
+
+	for i, val := range vals {
+		f.nodeIDs[i] = p2p.ID(val.NodeID)
+		f.nodeIndexes[p2p.ID(val.NodeID)] = i
+		f.consIndexes[string(val.PubKey.Address())] = i
+	}
+
+	return f
+}
+
+// nodeOption returns the CometBFT node option wrapping the reactors of the node
+// of the validator at the index with the faultyReactor.
+func (f *faultInjector) nodeOption(index int) node.Option {
+	return func(n *node.Node) {
+		sw := n.Switch()
+		f.switches[index] = sw
+
+		reactors := make(map[string]p2p.Reactor, len(sw.Reactors()))
+		for name, reactor := range sw.Reactors() {
+			reactors[name] = reactor
+		}
+
+		for name, reactor := range reactors {
+			sw.RemoveReactor(name, reactor)
+			sw.AddReactor(name, &faultyReactor{Reactor: reactor, faults: f, index: index})
+		}
+	}
+}
+
+// isBlocked returns true if the messages sent by the validator at index from are
+// not delivered to the validator at index to.
+func (f *faultInjector) isBlocked(from, to int) bool {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+
+	return f.blocked(from, to)
+}
+
+// blocked is isBlocked without locking.
+func (f *faultInjector) blocked(from, to int) bool {
+	if f.paused[from] || f.paused[to] {
+		return true
+	}
+
+	return f.groups != nil && f.groups[from] != f.groups[to]
+}
+
+// update applies the change to the faults. The consensus reactors assume that
+// the messages they send are delivered, so the validators which are no longer
+// blocked from each other are disconnected: they reconnect with a fresh state
+// and resend the messages dropped by the fault.
+func (f *faultInjector) update(change func()) {
+	f.mtx.Lock()
+	var unblocked [][2]int
+	wasBlocked := make(map[[2]int]bool)
+	for i := range f.nodeIDs {
+		for j := i + 1; j < len(f.nodeIDs); j++ {
+			wasBlocked[[2]int{i, j}] = f.blocked(i, j)
+		}
+	}
+
+	change()
+
+	for pair, blocked := range wasBlocked {
+		if blocked && !f.blocked(pair[0], pair[1]) {
+			unblocked = append(unblocked, pair)
+		}
+	}
+	f.mtx.Unlock()
+
+	for _, pair := range unblocked {
+		sw := f.switches[pair[0]]
+		if sw == nil {
+			continue
+		}
+
+		for _, peer := range sw.Peers().List() {
+			if peer.ID() == f.nodeIDs[pair[1]] {
+				sw.StopPeerForError(peer, "network fault healed")
+			}
+		}
+	}
+}
+
+// voteFault returns the fault of the votes signed by the validator with the consensus address.
+func (f *faultInjector) voteFault(consAddr []byte) (VoteFault, bool) {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+
+	index, ok := f.consIndexes[string(consAddr)]
+	if !ok {
+		return VoteFault{}, false
+	}
+
+	fault, ok := f.voteFaults[index]
+	return fault, ok
+}
+
+// faultyReactor wraps a reactor of a CometBFT node to drop or delay the messages
+// it receives, according to the faults injected in the network.
+type faultyReactor struct {
+	p2p.Reactor
+
+	faults *faultInjector
+	index  int // the index of the validator running the reactor
+}
+
+// Receive implements p2p.Reactor.
+func (r *faultyReactor) Receive(e p2p.Envelope) {
+	if from, ok := r.faults.nodeIndexes[e.Src.ID()]; ok && r.faults.isBlocked(from, r.index) {
+		return
+	}
+
+	if vote, ok := e.Message.(*cmtcons.Vote); ok && e.ChannelID == consensus.VoteChannel && vote.Vote != nil {
+		if fault, ok := r.faults.voteFault(vote.Vote.ValidatorAddress); ok {
+			if mrand.Float64() < fault.DropRate { //nolint:gosec // randomness of the faults does not need to be secure
+				return
+			}
+
+			if fault.Delay > 0 {
+				time.AfterFunc(fault.Delay, func() { r.Reactor.Receive(e) })
+				return
+			}
+		}
+	}
+
+	r.Reactor.Receive(e)
+}
+
+// SwitchToConsensus forwards the switch from block sync to the consensus reactor,
+// which is looked up by name by the block sync reactor.
+func (r *faultyReactor) SwitchToConsensus(state sm.State, skipWAL bool) {
+	if conR, ok := r.Reactor.(interface {
+		SwitchToConsensus(state sm.State, skipWAL bool)
+	}); ok {
+		conR.SwitchToConsensus(state, skipWAL)
+	}
+}
+
+// PauseValidator disconnects the validator at index i from the rest of the network:
+// the messages it sends and receives are dropped until ResumeValidator is called.
+// The validator keeps running, but no longer takes part in the consensus of the
+// network.
+func (n *Network) PauseValidator(i int) error {
+	if err := n.checkValidatorIndex(i); err != nil {
+		return err
+	}
+
+	n.faults.update(func() {
+		n.faults.paused[i] = true
+	})
+
+	return nil
+}
+
+// ResumeValidator reconnects the validator at index i paused by PauseValidator.
+func (n *Network) ResumeValidator(i int) error {
+	if err := n.checkValidatorIndex(i); err != nil {
+		return err
+	}
+
+	n.faults.update(func() {
+		delete(n.faults.paused, i)
+	})
+
+	return nil
+}
+
+// Partition splits the network into groups of validators, given by their indexes:
+// the messages sent between validators of different groups are dropped until
+// HealPartition is called. The validators not listed in any group form another
+// group.
+func (n *Network) Partition(groups ...[]int) error {
+	partition := make([]int, len(n.Validators))
+	for i := range partition {
+		partition[i] = -1
+	}
+
+	for g, group := range groups {
+		for _, i := range group {
+			if err := n.checkValidatorIndex(i); err != nil {
+				return err
+			}
+			if partition[i] != -1 {
+				return fmt.Errorf("validator %d is in several groups", i)
+			}
+			partition[i] = g
+		}
+	}
+
+	n.faults.update(func() {
+		n.faults.groups = partition
+	})
+
+	return nil
+}
+
+// HealPartition reconnects the groups of validators split by Partition.
+func (n *Network) HealPartition() {
+	n.faults.update(func() {
+		n.faults.groups = nil
+	})
+}
+
+// SetVoteFault sets the fault of the consensus votes signed by the validator at
+// index i, which are dropped or delayed before being delivered to the other
+// validators. As over a lossy link, the dropped votes are not resent. A zero
+// VoteFault delivers the votes normally.
+func (n *Network) SetVoteFault(i int, fault VoteFault) error {
+	if err := n.checkValidatorIndex(i); err != nil {
+		return err
+	}
+	if fault.DropRate < 0 || fault.DropRate > 1 {
+		return fmt.Errorf("vote drop rate must be between 0 and 1, got %v", fault.DropRate)
+	}
+	if fault.Delay < 0 {
+		return fmt.Errorf("vote delay must not be negative, got %s", fault.Delay)
+	}
+
+	n.faults.mtx.Lock()
+	defer n.faults.mtx.Unlock()
+
+	if fault == (VoteFault{}) {
+		delete(n.faults.voteFaults, i)
+	} else {
+		n.faults.voteFaults[i] = fault
+	}
+
+	return nil
+}
+
+// ClearFaults removes all the faults injected in the network.
+func (n *Network) ClearFaults() {
+	n.faults.update(func() {
+		n.faults.groups = nil
+		n.faults.paused = make(map[int]bool)
+		n.faults.voteFaults = make(map[int]VoteFault)
+	})
+}
+
+// DoubleSign makes the validator at index i sign two conflicting prevotes at the
+// committed height, and broadcasts the evidence of the duplicate vote. Once the
+// evidence is committed, the application handles it as a misbehavior of the
+// validator, i.e. x/evidence jails, slashes and tombstones the validator.
+func (n *Network) DoubleSign(i int, height int64) error {
+	if err := n.checkValidatorIndex(i); err != nil {
+		return err
+	}
+
+	val := n.Validators[i]
+	client := n.Validators[0].RPCClient
+	if client == nil {
+		return errors.New("the first validator has no RPC client")
+	}
+
+	ctx := context.Background()
+	block, err := client.Block(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	page, perPage := 1, len(n.Validators)
+	res, err := client.Validators(ctx, &height, &page, &perPage)
+	if err != nil {
+		return err
+	}
+	valSet, err := cmttypes.ValidatorSetFromExistingValidators(res.Validators)
+	if err != nil {
+		return err
+	}
+
+	cmtCfg := val.Ctx.Config
+	pv := pvm.LoadFilePV(cmtCfg.PrivValidatorKeyFile(), cmtCfg.PrivValidatorStateFile())
+	valIndex, _ := valSet.GetByAddress(pv.Key.Address)
+	if valIndex == -1 {
+		return fmt.Errorf("validator %d is not in the validator set at height %d", i, height)
+	}
+
+	votes := make([]*cmttypes.Vote, 2)
+	for j := range votes {
+		blockHash := make([]byte, tmhash.Size)
+		if _, err := rand.Read(blockHash); err != nil {
+			return err
+		}
+
+		vote := &cmttypes.Vote{
+			Type:   cmtproto.PrevoteType,
+			Height: height,
+			Round:  0,
+			BlockID: cmttypes.BlockID{
+				Hash:          blockHash,
+				PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(blockHash)},
+			},
+			Timestamp:        block.Block.Time,
+			ValidatorAddress: pv.Key.Address,
+			ValidatorIndex:   valIndex,
+		}
+
+		// sign with the key, as the private validator refuses to double sign
+		vote.Signature, err = pv.Key.PrivKey.Sign(cmttypes.VoteSignBytes(n.Config.ChainID, vote.ToProto()))
+		if err != nil {
+			return err
+		}
+		votes[j] = vote
+	}
+
+	evidence, err := cmttypes.NewDuplicateVoteEvidence(votes[0], votes[1], block.Block.Time, valSet)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.BroadcastEvidence(ctx, evidence)
+	return err
+}
+
+func (n *Network) checkValidatorIndex(i int) error {
+	if i < 0 || i >= len(n.Validators) {
+		return fmt.Errorf("invalid validator index %d, the network has %d validators", i, len(n.Validators))
+	}
+
+	return nil
+}
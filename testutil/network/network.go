@@ -252,6 +252,8 @@ type (
 		Validators []*Validator
 
 		Config Config
+
+		faults *faultInjector
 	}
 
 	// Validator defines an in-process CometBFT validator node. Through this object,
@@ -608,9 +610,11 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		return nil, err
 	}
 
+	network.faults = newFaultInjector(network.Validators)
+
 	l.Log("starting test network...")
 	for idx, v := range network.Validators {
-		err := startInProcess(cfg, v)
+		err := startInProcess(cfg, v, network.faults.nodeOption(idx))
 		if err != nil {
 			return nil, err
 		}
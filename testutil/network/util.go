@@ -28,7 +28,7 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-func startInProcess(cfg Config, val *Validator) error {
+func startInProcess(cfg Config, val *Validator, nodeOpts ...node.Option) error {
 	logger := val.Ctx.Logger
 	cmtCfg := val.Ctx.Config
 	cmtCfg.Instrumentation.Prometheus = false
@@ -52,6 +52,10 @@ func startInProcess(cfg Config, val *Validator) error {
 		return appGenesis.ToGenesisDoc()
 	}
 
+	if val.AppConfig.Events.Enable && (val.APIAddress != "" || val.AppConfig.GRPC.Enable) {
+		app.RegisterEventsService(val.ClientCtx, *val.AppConfig)
+	}
+
 	cmtApp := server.NewCometABCIWrapper(app)
 	tmNode, err := node.NewNode( //resleak:notresource
 		cmtCfg,
@@ -62,6 +66,7 @@ func startInProcess(cfg Config, val *Validator) error {
 		cmtcfg.DefaultDBProvider,
 		node.DefaultMetricsProvider(cmtCfg.Instrumentation),
 		servercmtlog.CometLoggerWrapper{Logger: logger.With("module", val.Moniker)},
+		nodeOpts...,
 	)
 	if err != nil {
 		return err
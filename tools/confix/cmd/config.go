@@ -7,6 +7,12 @@ import (
 // ConfigComamnd contains all the confix commands
 // These command can be used to interactively update an application config value.
 func ConfigCommand() *cobra.Command {
+	return ConfigCommandWithAppConfig(nil)
+}
+
+// ConfigCommandWithAppConfig contains all the confix commands, app.toml being
+// validated against the given application config struct (see ValidateCommand).
+func ConfigCommandWithAppConfig(customAppConfig interface{}) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
 		Short: "Utilities for managing application configuration",
@@ -18,6 +24,7 @@ func ConfigCommand() *cobra.Command {
 		GetCommand(),
 		SetCommand(),
 		HomeCommand(),
+		ValidateCommand(customAppConfig),
 	)
 
 	return cmd
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+
+	"cosmossdk.io/tools/confix"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+var FlagFix bool
+
+// ValidateCommand returns a CLI command to validate a config file against the
+// config struct of the application. customAppConfig is the config struct of the
+// application, as returned by its initAppConfig function. If nil, app.toml is
+// validated against the server config.
+func ValidateCommand(customAppConfig interface{}) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [config]",
+		Short: "Validate an application config file against the application config",
+		Long: `Validate an application config file against the application config, reporting unknown keys, type mismatches and deprecated keys.
+The [config] argument must be the path of the file when using the tool standalone, otherwise it must be the name of the config file without the .toml extension.
+With --fix, the values which can be converted to the expected type are rewritten.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename := args[0]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			if clientCtx.HomeDir != "" {
+				filename = fmt.Sprintf("%s/config/%s.toml", clientCtx.HomeDir, filename)
+			}
+
+			schema, err := confix.SchemaForFile(filename, customAppConfig)
+			if err != nil {
+				return err
+			}
+
+			doc, err := confix.LoadConfig(filename)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			if FlagFix {
+				outputPath := filename
+				if FlagStdOut {
+					outputPath = ""
+				}
+
+				ctx := cmd.Context()
+				if FlagVerbose {
+					ctx = confix.WithLogWriter(ctx, cmd.ErrOrStderr())
+				}
+
+				plan := confix.FixPlan(doc, schema)
+				if err := confix.Upgrade(ctx, plan, filename, outputPath, FlagSkipValidate); err != nil {
+					return fmt.Errorf("failed to fix config: %w", err)
+				}
+
+				// report the issues left after the fix
+				if err := plan.Apply(cmd.Context(), doc); err != nil {
+					return fmt.Errorf("failed to fix config: %w", err)
+				}
+			}
+
+			issues := confix.Validate(doc, schema)
+			if len(issues) == 0 {
+				if FlagStdOut {
+					return nil
+				}
+
+				return clientCtx.PrintString("The config is valid.\n")
+			}
+
+			// the issues are not a usage error
+			cmd.SilenceUsage = true
+			confix.PrintIssues(cmd.ErrOrStderr(), issues)
+			return fmt.Errorf("%d issue(s) found in %s", len(issues), filename)
+		},
+	}
+
+	cmd.Flags().BoolVar(&FlagFix, "fix", false, "rewrite the values which can be converted to the expected type")
+	cmd.Flags().BoolVar(&FlagStdOut, "stdout", false, "with --fix, print the fixed config to stdout")
+	cmd.Flags().BoolVar(&FlagVerbose, "verbose", false, "with --fix, log changes to stderr")
+	cmd.Flags().BoolVar(&FlagSkipValidate, "skip-validate", false, "with --fix, skip the validation of the fixed config (allows to fix unknown configurations)")
+
+	return cmd
+}
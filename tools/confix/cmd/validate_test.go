@@ -0,0 +1,50 @@
+package cmd_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/tools/confix/cmd"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"gotest.tools/v3/assert"
+)
+
+func TestValidateCmd(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t)
+	defer cleanup()
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{"client"})
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(out.String()), "The config is valid.")
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{"unexisting"})
+	assert.ErrorContains(t, err, "unknown config")
+
+	// clientCtx does not create app.toml, so this should fail
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{"app"})
+	assert.ErrorContains(t, err, "no such file or directory")
+
+	appConfig := fmt.Sprintf("%s/config/app.toml", clientCtx.HomeDir)
+	err = os.WriteFile(appConfig, []byte(`
+minimum-gas-prices = "0stake"
+halt-height = "10"
+unknown = true
+`), 0o600)
+	assert.NilError(t, err)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{"app"})
+	assert.Error(t, err, fmt.Sprintf("2 issue(s) found in %s", appConfig))
+	assert.Assert(t, strings.Contains(out.String(), `type mismatch: halt-height: expected uint, got string "10" (fixable)`))
+	assert.Assert(t, strings.Contains(out.String(), "unknown key: unknown"))
+
+	// only the type mismatch is fixed
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(nil), []string{"app", "--fix", "--skip-validate"})
+	assert.Error(t, err, fmt.Sprintf("1 issue(s) found in %s", appConfig))
+	assert.Assert(t, strings.HasPrefix(out.String(), "unknown key: unknown\n"))
+
+	bz, err := os.ReadFile(appConfig)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(bz), "halt-height = 10\n"))
+}
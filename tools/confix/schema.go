@@ -0,0 +1,268 @@
+package confix
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/creachadair/tomledit"
+	"github.com/creachadair/tomledit/parser"
+	"golang.org/x/exp/maps"
+
+	clientcfg "github.com/cosmos/cosmos-sdk/client/config"
+	srvcfg "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// Kind is the kind of a configuration value.
+type Kind string
+
+const (
+	KindString   Kind = "string"
+	KindBool     Kind = "bool"
+	KindInt      Kind = "int"
+	KindUint     Kind = "uint"
+	KindFloat    Kind = "float"
+	KindDuration Kind = "duration"
+	KindArray    Kind = "array"
+	KindTable    Kind = "table"
+	// KindAny is the kind of the values which type is not checked, such as maps.
+	// The keys nested under a value of this kind are not checked either.
+	KindAny Kind = "any"
+)
+
+// Field describes a configuration value.
+type Field struct {
+	Kind Kind
+	// Elem describes the elements of an array.
+	Elem *Field
+	// Deprecated is the reason why the key is deprecated, if it is.
+	Deprecated string
+}
+
+// Schema describes the keys of a configuration file and the types of their values.
+type Schema struct {
+	fields map[string]Field
+}
+
+// NewSchema derives the schema of a configuration from its struct, following
+// the mapstructure tags used to decode the configuration.
+func NewSchema(cfg interface{}) (Schema, error) {
+	t := reflect.TypeOf(cfg)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return Schema{}, fmt.Errorf("config must be a struct, got %T", cfg)
+	}
+
+	s := Schema{fields: map[string]Field{}}
+	s.addStruct("", t)
+
+	return s, nil
+}
+
+// AppSchema returns the schema of app.toml. customAppConfig is the config
+// struct of the app, it defaults to the server config if nil. The keys of the
+// previous versions of the server config, which are not in the config struct,
+// are deprecated.
+func AppSchema(customAppConfig interface{}) (Schema, error) {
+	if customAppConfig == nil {
+		customAppConfig = srvcfg.DefaultConfig()
+	}
+
+	s, err := NewSchema(customAppConfig)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	if err := s.deprecateRemovedKeys(); err != nil {
+		return Schema{}, err
+	}
+
+	return s, nil
+}
+
+// ClientSchema returns the schema of client.toml.
+func ClientSchema() (Schema, error) {
+	return NewSchema(clientcfg.ClientConfig{})
+}
+
+// SchemaForFile returns the schema of the configuration file, based on its name.
+func SchemaForFile(fileName string, customAppConfig interface{}) (Schema, error) {
+	switch {
+	case strings.HasSuffix(fileName, AppConfig):
+		return AppSchema(customAppConfig)
+	case strings.HasSuffix(fileName, ClientConfig):
+		return ClientSchema()
+	case strings.HasSuffix(fileName, CMTConfig):
+		return Schema{}, fmt.Errorf("cometbft config is not supported")
+	default:
+		return Schema{}, fmt.Errorf("unknown config: %s", fileName)
+	}
+}
+
+// Field returns the field of the key, with sections separated by dots.
+func (s Schema) Field(key string) (Field, bool) {
+	f, ok := s.fields[key]
+	return f, ok
+}
+
+// Keys returns the sorted keys of the schema.
+func (s Schema) Keys() []string {
+	keys := maps.Keys(s.fields)
+	sort.Strings(keys)
+	return keys
+}
+
+// Deprecate marks the key as deprecated for the given reason. The key does not
+// need to be part of the config struct.
+func (s Schema) Deprecate(key, reason string) {
+	f, ok := s.fields[key]
+	if !ok {
+		f = Field{Kind: KindAny}
+	}
+
+	f.Deprecated = reason
+	s.fields[key] = f
+}
+
+// anyParent reports whether a parent of the key is of KindAny.
+func (s Schema) anyParent(key string) bool {
+	for i := strings.LastIndex(key, "."); i > 0; i = strings.LastIndex(key, ".") {
+		key = key[:i]
+		if f, ok := s.fields[key]; ok && f.Kind == KindAny {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s Schema) addStruct(prefix string, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name, squash := mapstructureName(sf)
+		if name == "-" {
+			continue
+		}
+
+		ft := sf.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		// embedded structs are squashed by mapstructure when tagged so
+		if squash && ft.Kind() == reflect.Struct {
+			s.addStruct(prefix, ft)
+			continue
+		}
+
+		key := prefix + name
+		f := fieldOf(ft)
+		s.fields[key] = f
+		if f.Kind == KindTable {
+			s.addStruct(key+".", ft)
+		}
+	}
+}
+
+// mapstructureName returns the name under which mapstructure decodes the
+// struct field, and whether the field is squashed.
+func mapstructureName(sf reflect.StructField) (string, bool) {
+	tag := sf.Tag.Get("mapstructure")
+	name, opts, _ := strings.Cut(tag, ",")
+	squash := false
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "squash" {
+			squash = true
+		}
+	}
+
+	if name == "" {
+		// the fields of untagged embedded structs are promoted, as rendered by
+		// the app.toml template
+		if sf.Anonymous {
+			return "", true
+		}
+
+		// mapstructure matches untagged fields case insensitively
+		name = strings.ToLower(sf.Name)
+	}
+
+	return name, squash
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func fieldOf(t reflect.Type) Field {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == durationType {
+		return Field{Kind: KindDuration}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Field{Kind: KindString}
+	case reflect.Bool:
+		return Field{Kind: KindBool}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Field{Kind: KindInt}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Field{Kind: KindUint}
+	case reflect.Float32, reflect.Float64:
+		return Field{Kind: KindFloat}
+	case reflect.Slice, reflect.Array:
+		elem := fieldOf(t.Elem())
+		return Field{Kind: KindArray, Elem: &elem}
+	case reflect.Struct:
+		return Field{Kind: KindTable}
+	default:
+		return Field{Kind: KindAny}
+	}
+}
+
+// deprecateRemovedKeys deprecates the keys of the app.toml of the previous
+// versions which are not in the schema, nor in the app.toml of the latest version.
+func (s Schema) deprecateRemovedKeys() error {
+	versions := maps.Keys(Migrations)
+	sort.Strings(versions)
+	latest := versions[len(versions)-1]
+
+	lastSeen := map[string]string{}
+	for _, version := range versions {
+		doc, err := LoadLocalConfig(version)
+		if err != nil {
+			return fmt.Errorf("failed to load %s config: %w", version, err)
+		}
+
+		doc.Scan(func(key parser.Key, _ *tomledit.Entry) bool {
+			lastSeen[key.String()] = version
+			return true
+		})
+	}
+
+	removed := map[string]string{}
+	for key, version := range lastSeen {
+		if _, ok := s.fields[key]; ok || version == latest || s.anyParent(key) {
+			continue
+		}
+
+		removed[key] = version
+	}
+
+	for key, version := range removed {
+		s.Deprecate(key, fmt.Sprintf("removed after %s", version))
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package confix
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/creachadair/tomledit"
+	"github.com/creachadair/tomledit/parser"
+	"github.com/creachadair/tomledit/scanner"
+	"github.com/creachadair/tomledit/transform"
+)
+
+// IssueType is the type of a configuration issue.
+type IssueType string
+
+const (
+	IssueUnknownKey    IssueType = "unknown key"
+	IssueTypeMismatch  IssueType = "type mismatch"
+	IssueDeprecatedKey IssueType = "deprecated key"
+)
+
+// Issue is an issue found by the validation of a configuration file.
+type Issue struct {
+	Type    IssueType
+	Key     string
+	Message string
+	// Fixable reports whether the issue can be fixed by the plan of FixPlan.
+	Fixable bool
+}
+
+func (i Issue) String() string {
+	s := fmt.Sprintf("%s: %s", i.Type, i.Key)
+	if i.Message != "" {
+		s += ": " + i.Message
+	}
+	if i.Fixable {
+		s += " (fixable)"
+	}
+
+	return s
+}
+
+// Validate checks the keys and the types of the values of the TOML document
+// against the schema. It reports unknown keys, values which don't have the
+// type of the schema and deprecated keys.
+func Validate(doc *tomledit.Document, schema Schema) []Issue {
+	var issues []Issue
+	doc.Scan(func(key parser.Key, entry *tomledit.Entry) bool {
+		name := key.String()
+		field, ok := schema.Field(name)
+		switch {
+		case !ok && schema.anyParent(name):
+			return true
+		case !ok:
+			issues = append(issues, Issue{Type: IssueUnknownKey, Key: name})
+			return true
+		case field.Deprecated != "":
+			issues = append(issues, Issue{Type: IssueDeprecatedKey, Key: name, Message: field.Deprecated})
+			return true
+		}
+
+		if entry.IsSection() {
+			if field.Kind != KindTable && field.Kind != KindAny {
+				issues = append(issues, Issue{Type: IssueTypeMismatch, Key: name, Message: fmt.Sprintf("expected %s, got table", field.Kind)})
+			}
+			return true
+		}
+
+		if _, fixable, err := checkValue(field, entry.KeyValue.Value); err != nil {
+			issues = append(issues, Issue{Type: IssueTypeMismatch, Key: name, Message: err.Error(), Fixable: fixable})
+		}
+
+		return true
+	})
+
+	return issues
+}
+
+// FixPlan returns a transformation plan rewriting the values of the document
+// which don't have the type of the schema, when they can be converted to it,
+// e.g. the "true" string of a bool value is rewritten to true.
+func FixPlan(from *tomledit.Document, schema Schema) transform.Plan {
+	plan := transform.Plan{}
+	from.Scan(func(key parser.Key, entry *tomledit.Entry) bool {
+		if entry.IsSection() {
+			return true
+		}
+
+		field, ok := schema.Field(key.String())
+		if !ok || field.Deprecated != "" {
+			return true
+		}
+
+		fixed, fixable, err := checkValue(field, entry.KeyValue.Value)
+		if err == nil || !fixable {
+			return true
+		}
+
+		key = append(parser.Key{}, key...)
+		plan = append(plan, transform.Step{
+			Desc: fmt.Sprintf("fix %s: %s -> %s", key, entry.KeyValue.Value, fixed),
+			T: transform.Func(func(_ context.Context, doc *tomledit.Document) error {
+				results := doc.Find(key...)
+				if len(results) != 1 || results[0].IsSection() {
+					return fmt.Errorf("key %q not found", key)
+				}
+
+				fixed.Trailer = results[0].KeyValue.Value.Trailer
+				results[0].KeyValue.Value = fixed
+				return nil
+			}),
+		})
+
+		return true
+	})
+
+	return plan
+}
+
+// PrintIssues prints one line per issue.
+func PrintIssues(w io.Writer, issues []Issue) {
+	for _, issue := range issues {
+		fmt.Fprintln(w, issue.String())
+	}
+}
+
+// checkValue checks that the value has the type of the field. If it doesn't,
+// it returns whether the value can be converted to this type, and if so the
+// converted value.
+func checkValue(field Field, value parser.Value) (parser.Value, bool, error) {
+	if field.Kind == KindAny {
+		return value, false, nil
+	}
+
+	if field.Kind == KindArray {
+		return checkArray(field, value)
+	}
+
+	// the keys of an inline table are checked one by one
+	if _, ok := value.X.(parser.Inline); ok && field.Kind == KindTable {
+		return value, false, nil
+	}
+
+	tok, ok := value.X.(parser.Token)
+	if !ok {
+		return value, false, fmt.Errorf("expected %s, got %s", field.Kind, describe(value))
+	}
+
+	text := tok.String()
+	str, isString := unquote(tok)
+	switch field.Kind {
+	case KindString:
+		if isString {
+			return value, false, nil
+		}
+
+		return mustFix(strconv.Quote(text), field, value)
+
+	case KindBool:
+		if tok.Type == scanner.Word && (text == "true" || text == "false") {
+			return value, false, nil
+		}
+
+		if b, err := strconv.ParseBool(str); isString && err == nil {
+			return mustFix(strconv.FormatBool(b), field, value)
+		}
+
+	case KindInt, KindUint:
+		if tok.Type == scanner.Integer {
+			if field.Kind == KindUint && strings.HasPrefix(text, "-") {
+				return value, false, fmt.Errorf("expected %s, got negative integer %s", field.Kind, text)
+			}
+
+			return value, false, nil
+		}
+
+		if isString {
+			text = strings.TrimSpace(str)
+		}
+
+		if i, ok := parseIntegral(text); (isString || tok.Type == scanner.Float) && ok && (field.Kind == KindInt || i >= 0) {
+			return mustFix(strconv.FormatInt(i, 10), field, value)
+		}
+
+	case KindFloat:
+		if tok.Type == scanner.Float || tok.Type == scanner.Integer {
+			return value, false, nil
+		}
+
+		if f, err := strconv.ParseFloat(strings.TrimSpace(str), 64); isString && err == nil {
+			return mustFix(strconv.FormatFloat(f, 'g', -1, 64), field, value)
+		}
+
+	case KindDuration:
+		// durations are decoded from strings, or from integers as nanoseconds
+		if tok.Type == scanner.Integer {
+			return value, false, nil
+		}
+
+		if _, err := time.ParseDuration(str); isString && err == nil {
+			return value, false, nil
+		}
+
+		if isString {
+			return value, false, fmt.Errorf("expected %s, got invalid duration %s", field.Kind, text)
+		}
+
+	}
+
+	return value, false, fmt.Errorf("expected %s, got %s", field.Kind, describe(value))
+}
+
+// checkArray checks that the value is an array which elements have the type of
+// the elements of the field. A string is converted to an array by splitting it
+// on commas, as done when decoding the configuration.
+func checkArray(field Field, value parser.Value) (parser.Value, bool, error) {
+	arr, ok := value.X.(parser.Array)
+	if !ok {
+		tok, isToken := value.X.(parser.Token)
+		str, isString := unquote(tok)
+		if !isToken || !isString || field.Elem == nil || field.Elem.Kind != KindString {
+			return value, false, fmt.Errorf("expected %s, got %s", field.Kind, describe(value))
+		}
+
+		elems := []string{}
+		for _, elem := range strings.Split(str, ",") {
+			if elem = strings.TrimSpace(elem); elem != "" {
+				elems = append(elems, strconv.Quote(elem))
+			}
+		}
+
+		return mustFix("["+strings.Join(elems, ", ")+"]", field, value)
+	}
+
+	var (
+		fixedElems []string
+		fixable    = true
+		mismatch   error
+	)
+	for i, item := range arr {
+		elem, ok := item.(parser.Value)
+		if !ok {
+			continue // comments
+		}
+
+		fixed, elemFixable, err := checkValue(*field.Elem, elem)
+		if err != nil {
+			if mismatch == nil {
+				mismatch = fmt.Errorf("element %d: %w", i, err)
+			}
+			fixable = fixable && elemFixable
+		}
+
+		fixedElems = append(fixedElems, fixed.String())
+	}
+
+	if mismatch == nil {
+		return value, false, nil
+	}
+
+	if !fixable {
+		return value, false, mismatch
+	}
+
+	fixed, err := parser.ParseValue("[" + strings.Join(fixedElems, ", ") + "]")
+	if err != nil {
+		return value, false, mismatch
+	}
+
+	return fixed, true, mismatch
+}
+
+// mustFix returns the fixed value of a value which doesn't have the type of the field.
+func mustFix(fixed string, field Field, value parser.Value) (parser.Value, bool, error) {
+	v, err := parser.ParseValue(fixed)
+	if err != nil {
+		return value, false, fmt.Errorf("expected %s, got %s", field.Kind, describe(value))
+	}
+
+	return v, true, fmt.Errorf("expected %s, got %s", field.Kind, describe(value))
+}
+
+// parseIntegral parses an integer, or a float without fractional part.
+func parseIntegral(s string) (int64, bool) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, true
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) || math.Abs(f) >= 1<<53 {
+		return 0, false
+	}
+
+	return int64(f), true
+}
+
+// unquote returns the content of a string token, and whether the token is a string.
+func unquote(tok parser.Token) (string, bool) {
+	text := tok.String()
+	switch tok.Type {
+	case scanner.String:
+		s, err := strconv.Unquote(text)
+		if err != nil {
+			return strings.Trim(text, `"`), true
+		}
+		return s, true
+	case scanner.MString:
+		return strings.TrimPrefix(strings.TrimSuffix(text, `"""`), `"""`), true
+	case scanner.LString:
+		return strings.Trim(text, `'`), true
+	case scanner.MLString:
+		return strings.TrimPrefix(strings.TrimSuffix(text, `'''`), `'''`), true
+	default:
+		return "", false
+	}
+}
+
+func describe(value parser.Value) string {
+	switch x := value.X.(type) {
+	case parser.Array:
+		return "array " + x.String()
+	case parser.Inline:
+		return "table " + x.String()
+	case parser.Token:
+		switch x.Type {
+		case scanner.String, scanner.MString, scanner.LString, scanner.MLString:
+			return "string " + x.String()
+		case scanner.Integer:
+			return "integer " + x.String()
+		case scanner.Float:
+			return "float " + x.String()
+		case scanner.Word:
+			if s := x.String(); s == "true" || s == "false" {
+				return "bool " + s
+			}
+		}
+	}
+
+	return value.String()
+}
@@ -0,0 +1,180 @@
+package confix_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/creachadair/tomledit"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/tools/confix"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+type wasmConfig struct {
+	QueryGasLimit uint64 `mapstructure:"query_gas_limit"`
+	LruSize       uint64 `mapstructure:"lru_size"`
+}
+
+type customAppConfig struct {
+	serverconfig.Config
+
+	WASM wasmConfig `mapstructure:"wasm"`
+}
+
+func mustParseConfig(t *testing.T, data string) *tomledit.Document {
+	doc, err := tomledit.Parse(strings.NewReader(data))
+	assert.NilError(t, err)
+	return doc
+}
+
+func TestNewSchema(t *testing.T) {
+	_, err := confix.NewSchema("foo")
+	assert.ErrorContains(t, err, "config must be a struct")
+
+	schema, err := confix.NewSchema(customAppConfig{})
+	assert.NilError(t, err)
+
+	tests := []struct {
+		key  string
+		kind confix.Kind
+	}{
+		{"minimum-gas-prices", confix.KindString},
+		{"halt-height", confix.KindUint},
+		{"index-events", confix.KindArray},
+		{"telemetry", confix.KindTable},
+		{"telemetry.global-labels", confix.KindArray},
+		{"grpc.max-recv-msg-size", confix.KindInt},
+		{"mempool.max-txs", confix.KindInt},
+		{"wasm", confix.KindTable},
+		{"wasm.lru_size", confix.KindUint},
+	}
+	for _, tc := range tests {
+		field, ok := schema.Field(tc.key)
+		assert.Assert(t, ok, tc.key)
+		assert.Equal(t, field.Kind, tc.kind, tc.key)
+	}
+
+	_, ok := schema.Field("Config")
+	assert.Assert(t, !ok)
+}
+
+func TestValidateTemplates(t *testing.T) {
+	schema, err := confix.AppSchema(nil)
+	assert.NilError(t, err)
+
+	// the app.toml written by the server has the types of the server config
+	path := filepath.Join(t.TempDir(), "app.toml")
+	serverconfig.WriteConfigFile(path, serverconfig.DefaultConfig())
+	doc, err := confix.LoadConfig(path)
+	assert.NilError(t, err)
+	assert.Equal(t, len(confix.Validate(doc, schema)), 0)
+
+	// the v0.50 template quotes some integers, and has custom sections which
+	// are unknown to the server config
+	doc, err = confix.LoadLocalConfig("v0.50")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, confix.Validate(doc, schema), []confix.Issue{
+		{Type: confix.IssueTypeMismatch, Key: "grpc.max-recv-msg-size", Message: `expected int, got string "10485760"`, Fixable: true},
+		{Type: confix.IssueTypeMismatch, Key: "grpc.max-send-msg-size", Message: `expected int, got string "2147483647"`, Fixable: true},
+		{Type: confix.IssueTypeMismatch, Key: "mempool.max-txs", Message: `expected int, got string "5000"`, Fixable: true},
+		{Type: confix.IssueUnknownKey, Key: "wasm"},
+		{Type: confix.IssueUnknownKey, Key: "wasm.query_gas_limit"},
+		{Type: confix.IssueUnknownKey, Key: "wasm.lru_size"},
+	})
+
+	schema, err = confix.AppSchema(customAppConfig{})
+	assert.NilError(t, err)
+
+	// the keys of the previous versions are deprecated
+	doc, err = confix.LoadLocalConfig("v0.45")
+	assert.NilError(t, err)
+	issues := confix.Validate(doc, schema)
+	assert.Assert(t, len(issues) > 0)
+	for _, issue := range issues {
+		assert.Equal(t, issue.Type, confix.IssueDeprecatedKey, issue.String())
+	}
+	assert.DeepEqual(t, issues[0], confix.Issue{Type: confix.IssueDeprecatedKey, Key: "pruning-keep-every", Message: "removed after v0.45"})
+}
+
+func TestValidateAndFix(t *testing.T) {
+	schema, err := confix.AppSchema(customAppConfig{})
+	assert.NilError(t, err)
+
+	doc := mustParseConfig(t, `
+minimum-gas-prices = 0
+halt-height = "10"
+inter-block-cache = "true"
+index-events = "a, b"
+foo = "bar"
+
+[api]
+enable = 1
+max-open-connections = -1
+
+[telemetry]
+global-labels = [["chain_id", "test"]]
+
+[grpc]
+max-recv-msg-size = "1.5"
+
+[wasm]
+lru_size = 1.0
+`)
+
+	issues := confix.Validate(doc, schema)
+	assert.DeepEqual(t, issues, []confix.Issue{
+		{Type: confix.IssueTypeMismatch, Key: "minimum-gas-prices", Message: "expected string, got integer 0", Fixable: true},
+		{Type: confix.IssueTypeMismatch, Key: "halt-height", Message: `expected uint, got string "10"`, Fixable: true},
+		{Type: confix.IssueTypeMismatch, Key: "inter-block-cache", Message: `expected bool, got string "true"`, Fixable: true},
+		{Type: confix.IssueTypeMismatch, Key: "index-events", Message: `expected array, got string "a, b"`, Fixable: true},
+		{Type: confix.IssueUnknownKey, Key: "foo"},
+		{Type: confix.IssueTypeMismatch, Key: "api.enable", Message: "expected bool, got integer 1"},
+		{Type: confix.IssueTypeMismatch, Key: "api.max-open-connections", Message: "expected uint, got negative integer -1"},
+		{Type: confix.IssueTypeMismatch, Key: "grpc.max-recv-msg-size", Message: `expected int, got string "1.5"`},
+		{Type: confix.IssueTypeMismatch, Key: "wasm.lru_size", Message: "expected uint, got float 1.0", Fixable: true},
+	})
+
+	assert.NilError(t, confix.FixPlan(doc, schema).Apply(context.Background(), doc))
+
+	var buf bytes.Buffer
+	assert.NilError(t, tomledit.Format(&buf, doc))
+	fixed := buf.String()
+	for _, line := range []string{
+		`minimum-gas-prices = "0"`,
+		`halt-height = 10`,
+		`inter-block-cache = true`,
+		`index-events = ["a", "b"]`,
+		`lru_size = 1`,
+	} {
+		assert.Assert(t, strings.Contains(fixed, line), line)
+	}
+
+	issues = confix.Validate(doc, schema)
+	for _, issue := range issues {
+		assert.Assert(t, !issue.Fixable, issue.String())
+	}
+	assert.Equal(t, len(issues), 4)
+}
+
+func TestValidateClientConfig(t *testing.T) {
+	schema, err := confix.SchemaForFile("client.toml", nil)
+	assert.NilError(t, err)
+
+	doc := mustParseConfig(t, `
+chain-id = "test-chain"
+keyring-backend = "os"
+output = "text"
+node = "tcp://localhost:26657"
+broadcast-mode = "sync"
+gas = "auto"
+`)
+	assert.DeepEqual(t, confix.Validate(doc, schema), []confix.Issue{{Type: confix.IssueUnknownKey, Key: "gas"}})
+
+	_, err = confix.SchemaForFile("config.toml", nil)
+	assert.ErrorContains(t, err, "not supported")
+}
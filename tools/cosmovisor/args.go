@@ -28,6 +28,9 @@ const (
 	EnvInterval             = "DAEMON_POLL_INTERVAL"
 	EnvPreupgradeMaxRetries = "DAEMON_PREUPGRADE_MAX_RETRIES"
 	EnvDisableLogs          = "COSMOVISOR_DISABLE_LOGS"
+	EnvRollbackOnFailure    = "DAEMON_ROLLBACK_ON_FAILURE"
+	EnvRollbackMaxFailures  = "DAEMON_ROLLBACK_MAX_FAILURES"
+	EnvBinaryPublicKey      = "DAEMON_BINARY_PUBLIC_KEY"
 )
 
 const (
@@ -35,6 +38,9 @@ const (
 	genesisDir  = "genesis"
 	upgradesDir = "upgrades"
 	currentLink = "current"
+
+	healthCheckFilename  = "upgrade-health-check.json"
+	batchUpgradeFilename = "batch-upgrade.json"
 )
 
 // must be the same as x/upgrade/types.UpgradeInfoFilename
@@ -52,6 +58,9 @@ type Config struct {
 	DataBackupPath        string
 	PreupgradeMaxRetries  int
 	DisableLogs           bool
+	RollbackOnFailure     bool
+	RollbackMaxFailures   int
+	BinaryPublicKey       string
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -88,6 +97,18 @@ func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", defaultFilename)
 }
 
+// UpgradeHealthCheckFilePath is the file tracking the health of the binary of
+// the last upgrade, when DAEMON_ROLLBACK_ON_FAILURE is enabled.
+func (cfg *Config) UpgradeHealthCheckFilePath() string {
+	return filepath.Join(cfg.Root(), healthCheckFilename)
+}
+
+// BatchUpgradeFilePath is the file listing the upgrades added with the
+// add-batch-upgrade command.
+func (cfg *Config) BatchUpgradeFilePath() string {
+	return filepath.Join(cfg.Root(), batchUpgradeFilename)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -140,9 +161,10 @@ func (cfg *Config) CurrentBin() (string, error) {
 func GetConfigFromEnv() (*Config, error) {
 	var errs []error
 	cfg := &Config{
-		Home:           os.Getenv(EnvHome),
-		Name:           os.Getenv(EnvName),
-		DataBackupPath: os.Getenv(EnvDataBackupPath),
+		Home:            os.Getenv(EnvHome),
+		Name:            os.Getenv(EnvName),
+		DataBackupPath:  os.Getenv(EnvDataBackupPath),
+		BinaryPublicKey: os.Getenv(EnvBinaryPublicKey),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -162,6 +184,9 @@ func GetConfigFromEnv() (*Config, error) {
 	if cfg.DisableLogs, err = booleanOption(EnvDisableLogs, false); err != nil {
 		errs = append(errs, err)
 	}
+	if cfg.RollbackOnFailure, err = booleanOption(EnvRollbackOnFailure, false); err != nil {
+		errs = append(errs, err)
+	}
 
 	interval := os.Getenv(EnvInterval)
 	if interval != "" {
@@ -191,6 +216,14 @@ func GetConfigFromEnv() (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	cfg.RollbackMaxFailures = 3
+	envRollbackMaxFailuresVal := os.Getenv(EnvRollbackMaxFailures)
+	if envRollbackMaxFailuresVal != "" {
+		if cfg.RollbackMaxFailures, err = strconv.Atoi(envRollbackMaxFailuresVal); err != nil {
+			errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvRollbackMaxFailures, err))
+		}
+	}
+
 	errs = append(errs, cfg.validate()...)
 
 	if len(errs) > 0 {
@@ -252,6 +285,21 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	if cfg.RollbackOnFailure {
+		if cfg.UnsafeSkipBackup {
+			errs = append(errs, fmt.Errorf("%s requires a data backup, %s must not be set", EnvRollbackOnFailure, EnvSkipBackup))
+		}
+		if cfg.RollbackMaxFailures <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be greater than 0", EnvRollbackMaxFailures))
+		}
+	}
+
+	if cfg.BinaryPublicKey != "" {
+		if _, err := cfg.binaryPublicKey(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -374,6 +422,9 @@ func (cfg Config) DetailString() string {
 		{EnvDataBackupPath, cfg.DataBackupPath},
 		{EnvPreupgradeMaxRetries, fmt.Sprintf("%d", cfg.PreupgradeMaxRetries)},
 		{EnvDisableLogs, fmt.Sprintf("%t", cfg.DisableLogs)},
+		{EnvRollbackOnFailure, fmt.Sprintf("%t", cfg.RollbackOnFailure)},
+		{EnvRollbackMaxFailures, fmt.Sprintf("%d", cfg.RollbackMaxFailures)},
+		{EnvBinaryPublicKey, cfg.BinaryPublicKey},
 	}
 
 	derivedEntries := []struct{ name, value string }{
@@ -382,6 +433,8 @@ func (cfg Config) DetailString() string {
 		{"Genesis Bin", cfg.GenesisBin()},
 		{"Monitored File", cfg.UpgradeInfoFilePath()},
 		{"Data Backup Dir", cfg.DataBackupPath},
+		{"Upgrade Health Check File", cfg.UpgradeHealthCheckFilePath()},
+		{"Batch Upgrade File", cfg.BatchUpgradeFilePath()},
 	}
 
 	var sb strings.Builder
@@ -39,6 +39,9 @@ type cosmovisorEnv struct {
 	Interval             string
 	PreupgradeMaxRetries string
 	DisableLogs          string
+	RollbackOnFailure    string
+	RollbackMaxFailures  string
+	BinaryPublicKey      string
 }
 
 // ToMap creates a map of the cosmovisorEnv where the keys are the env var names.
@@ -54,6 +57,9 @@ func (c cosmovisorEnv) ToMap() map[string]string {
 		EnvInterval:             c.Interval,
 		EnvPreupgradeMaxRetries: c.PreupgradeMaxRetries,
 		EnvDisableLogs:          c.DisableLogs,
+		EnvRollbackOnFailure:    c.RollbackOnFailure,
+		EnvRollbackMaxFailures:  c.RollbackMaxFailures,
+		EnvBinaryPublicKey:      c.BinaryPublicKey,
 	}
 }
 
@@ -80,6 +86,12 @@ func (c *cosmovisorEnv) Set(envVar, envVal string) {
 		c.PreupgradeMaxRetries = envVal
 	case EnvDisableLogs:
 		c.DisableLogs = envVal
+	case EnvRollbackOnFailure:
+		c.RollbackOnFailure = envVal
+	case EnvRollbackMaxFailures:
+		c.RollbackMaxFailures = envVal
+	case EnvBinaryPublicKey:
+		c.BinaryPublicKey = envVal
 	default:
 		panic(fmt.Errorf("Unknown environment variable [%s]. Ccannot set field to [%s]. ", envVar, envVal))
 	}
@@ -383,9 +395,23 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 			DataBackupPath:        dataBackupPath,
 			PreupgradeMaxRetries:  preupgradeMaxRetries,
 			DisableLogs:           disableLogs,
+			RollbackMaxFailures:   3,
 		}
 	}
 
+	newRollbackConfig := func(rollbackMaxFailures int) *Config {
+		cfg := newConfig(absPath, "testname", false, false, 600, false, absPath, 406, 0, false)
+		cfg.RollbackOnFailure = true
+		cfg.RollbackMaxFailures = rollbackMaxFailures
+		return cfg
+	}
+
+	newPublicKeyConfig := func(binaryPublicKey string) *Config {
+		cfg := newConfig(absPath, "testname", false, false, 600, false, absPath, 406, 0, false)
+		cfg.BinaryPublicKey = binaryPublicKey
+		return cfg
+	}
+
 	tests := []struct {
 		name             string
 		envVals          cosmovisorEnv
@@ -410,197 +436,251 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 		},
 		{
 			name:             "all good",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, false, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "nothing set",
-			envVals:          cosmovisorEnv{"", "", "", "", "", "", "", "", "", "false"},
+			envVals:          cosmovisorEnv{"", "", "", "", "", "", "", "", "", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 3,
 		},
 		// Note: Home and Name tests are done in TestValidate
 		{
 			name:             "download bin bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "bad", "false", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "bad", "false", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "download bin not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "", "false", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "", "false", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "download bin true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, false, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "download bin false",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart upgrade bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "bad", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "bad", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart upgrade not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, true, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart upgrade true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "true", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, true, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart upgrade true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, false, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "skip unsafe backups bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "bad", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "bad", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "skip unsafe backups not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, false, 600, false, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "skip unsafe backups true",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "true", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, false, 600, true, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "skip unsafe backups false",
-			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "false", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "true", "false", "600ms", "false", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", true, false, 600, false, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "poll interval bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "bad", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "bad", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "poll interval 0",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "0", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "0", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "poll interval not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, false, absPath, 300, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "poll interval 600",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "600", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "600", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "poll interval 1s",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "1s", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "1s", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, false, absPath, 1000, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "poll interval -3m",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "-3m", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "-3m", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "bad", "false", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "bad", "false", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay 0",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "0", "false", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "0", "false", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "", "false", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "", "false", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 0, false, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart delay 600",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600", "false", "", "300ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600", "false", "", "300ms", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "restart delay 1s",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "1s", "false", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "1s", "false", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 1000, false, absPath, 303, 1, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "restart delay -3m",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "-3m", "false", "", "303ms", "1", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "-3m", "false", "", "303ms", "1", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "prepupgrade max retries bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "bad", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "bad", "false", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "prepupgrade max retries 0",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "0", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "0", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, false, absPath, 406, 0, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "prepupgrade max retries not set",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, false, absPath, 406, 0, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "prepupgrade max retries 5",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "5", "false"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "5", "false", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, false, absPath, 406, 5, false),
 			expectedErrCount: 0,
 		},
 		{
 			name:             "disable logs bad",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "5", "bad"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "5", "bad", "", "", ""},
 			expectedCfg:      nil,
 			expectedErrCount: 1,
 		},
 		{
 			name:             "disable logs good",
-			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "true"},
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "true", "", "", ""},
 			expectedCfg:      newConfig(absPath, "testname", false, false, 600, false, absPath, 406, 0, true),
 			expectedErrCount: 0,
 		},
+		{
+			name:             "rollback on failure bad",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "bad", "", ""},
+			expectedCfg:      nil,
+			expectedErrCount: 1,
+		},
+		{
+			name:             "rollback on failure good",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "true", "", ""},
+			expectedCfg:      newRollbackConfig(3),
+			expectedErrCount: 0,
+		},
+		{
+			name:             "rollback max failures 5",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "true", "5", ""},
+			expectedCfg:      newRollbackConfig(5),
+			expectedErrCount: 0,
+		},
+		{
+			name:             "rollback max failures bad",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "true", "bad", ""},
+			expectedCfg:      nil,
+			expectedErrCount: 2,
+		},
+		{
+			name:             "rollback max failures 0",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "true", "0", ""},
+			expectedCfg:      nil,
+			expectedErrCount: 1,
+		},
+		{
+			name:             "binary public key good",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "", "", "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="},
+			expectedCfg:      newPublicKeyConfig("11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			expectedErrCount: 0,
+		},
+		{
+			name:             "binary public key not base64",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "", "", "not-base64!"},
+			expectedCfg:      nil,
+			expectedErrCount: 1,
+		},
+		{
+			name:             "binary public key bad length",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "false", "", "406ms", "", "false", "", "", "AQID"},
+			expectedCfg:      nil,
+			expectedErrCount: 1,
+		},
+		{
+			name:             "rollback on failure without backup",
+			envVals:          cosmovisorEnv{absPath, "testname", "false", "false", "600ms", "true", "", "406ms", "", "false", "true", "", ""},
+			expectedCfg:      nil,
+			expectedErrCount: 1,
+		},
 	}
 
 	for _, tc := range tests {
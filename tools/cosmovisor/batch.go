@@ -0,0 +1,377 @@
+package cosmovisor
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/upgrade/plan"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// BatchUpgradeManifest is a list of future upgrades, which binaries are
+// downloaded and verified ahead of the upgrades.
+type BatchUpgradeManifest struct {
+	Upgrades []BatchUpgrade `json:"upgrades"`
+}
+
+// BatchUpgrade is an upgrade of a BatchUpgradeManifest.
+type BatchUpgrade struct {
+	// Name is the name of the upgrade plan.
+	Name string `json:"name"`
+	// Height is the height of the upgrade plan.
+	Height int64 `json:"height"`
+	// Binaries are the URLs of the upgrade binary by os/arch. They must contain a checksum
+	// query parameter, as in the upgrade plan info.
+	Binaries plan.BinaryDownloadURLMap `json:"binaries"`
+	// Signatures are the base64 encoded ed25519 signatures of the upgrade binary by os/arch,
+	// made with the key configured by DAEMON_BINARY_PUBLIC_KEY.
+	Signatures map[string]string `json:"signatures,omitempty"`
+	// Checksum is the sha256 checksum of the upgrade binary, recorded when the upgrade
+	// is added. It is set by cosmovisor, and ignored in manifests.
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// ParseBatchUpgradeManifest reads and validates the batch upgrade manifest file.
+func ParseBatchUpgradeManifest(filename string) (BatchUpgradeManifest, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return BatchUpgradeManifest{}, fmt.Errorf("failed to read batch upgrade manifest: %w", err)
+	}
+
+	var m BatchUpgradeManifest
+	if err := json.Unmarshal(bz, &m); err != nil {
+		return BatchUpgradeManifest{}, fmt.Errorf("failed to parse batch upgrade manifest: %w", err)
+	}
+
+	if err := m.ValidateBasic(); err != nil {
+		return BatchUpgradeManifest{}, fmt.Errorf("invalid batch upgrade manifest: %w", err)
+	}
+
+	return m, nil
+}
+
+// ValidateBasic does stateless validation of the manifest.
+func (m BatchUpgradeManifest) ValidateBasic() error {
+	if len(m.Upgrades) == 0 {
+		return errors.New("no upgrades found")
+	}
+
+	names := make(map[string]bool, len(m.Upgrades))
+	for _, u := range m.Upgrades {
+		if err := u.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if names[u.Name] {
+			return fmt.Errorf("duplicate upgrade %q", u.Name)
+		}
+		names[u.Name] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic does stateless validation of the upgrade.
+func (u BatchUpgrade) ValidateBasic() error {
+	if u.Name == "" {
+		return errors.New("upgrade name cannot be empty")
+	}
+
+	if u.Height <= 0 {
+		return fmt.Errorf("upgrade %q: height must be greater than 0", u.Name)
+	}
+
+	if err := u.Binaries.ValidateBasic(); err != nil {
+		return fmt.Errorf("upgrade %q: %w", u.Name, err)
+	}
+
+	for osArch, sig := range u.Signatures {
+		if _, ok := u.Binaries[osArch]; !ok {
+			return fmt.Errorf("upgrade %q: no binary for signature of %s", u.Name, osArch)
+		}
+
+		if _, err := base64.StdEncoding.DecodeString(sig); err != nil {
+			return fmt.Errorf("upgrade %q: invalid signature of %s: %w", u.Name, osArch, err)
+		}
+	}
+
+	return nil
+}
+
+// binaryOSArch returns the os/arch key of the binary of the upgrade to use on this host.
+func (u BatchUpgrade) binaryOSArch() (string, error) {
+	if _, ok := u.Binaries[OSArch()]; ok {
+		return OSArch(), nil
+	}
+
+	if _, ok := u.Binaries["any"]; ok {
+		return "any", nil
+	}
+
+	return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
+}
+
+// PrepareBatchUpgrade downloads the binary of the upgrade, unless it is already
+// present, verifies it and records its checksum in the upgrade. A binary which
+// fails the verification is removed.
+// If force is set, the binary is downloaded again even if already present.
+func PrepareBatchUpgrade(logger log.Logger, cfg *Config, u *BatchUpgrade, force bool) error {
+	osArch, err := u.binaryOSArch()
+	if err != nil {
+		return fmt.Errorf("upgrade %q: %w", u.Name, err)
+	}
+
+	upgradeDir := cfg.UpgradeDir(u.Name)
+	if err := plan.EnsureBinary(cfg.UpgradeBin(u.Name)); err == nil && !force {
+		logger.Info("upgrade binary already present", "upgrade", u.Name)
+	} else {
+		if err := os.RemoveAll(upgradeDir); err != nil {
+			return fmt.Errorf("upgrade %q: failed to remove upgrade directory: %w", u.Name, err)
+		}
+
+		// the checksum of the binary is verified by the download
+		logger.Info("downloading upgrade binary", "upgrade", u.Name, "url", u.Binaries[osArch])
+		if err := plan.DownloadUpgrade(upgradeDir, u.Binaries[osArch], cfg.Name); err != nil {
+			_ = os.RemoveAll(upgradeDir)
+			return fmt.Errorf("upgrade %q: cannot download binary: %w", u.Name, err)
+		}
+	}
+
+	if err := verifyBatchUpgradeSignature(cfg, *u); err != nil {
+		_ = os.RemoveAll(upgradeDir)
+		return fmt.Errorf("upgrade %q: %w", u.Name, err)
+	}
+
+	// the checksum is the one of the binary, which differs from the one of the
+	// downloaded file if the binary is unpacked from an archive
+	checksum, err := binaryChecksum(cfg.UpgradeBin(u.Name))
+	if err != nil {
+		return fmt.Errorf("upgrade %q: %w", u.Name, err)
+	}
+	u.Checksum = checksum
+
+	logger.Info("upgrade binary ready", "upgrade", u.Name, "path", cfg.UpgradeBin(u.Name))
+	return nil
+}
+
+// verifyBatchUpgradeBinary verifies the upgrade binary against the checksum
+// recorded when the upgrade was added, and its signature.
+func verifyBatchUpgradeBinary(cfg *Config, u BatchUpgrade) error {
+	if u.Checksum == "" {
+		return errors.New("no binary checksum recorded, the upgrade must be added again")
+	}
+
+	checksum, err := binaryChecksum(cfg.UpgradeBin(u.Name))
+	if err != nil {
+		return err
+	}
+
+	if checksum != u.Checksum {
+		return fmt.Errorf("checksum mismatch of binary %s: expected %s, got %s", cfg.UpgradeBin(u.Name), u.Checksum, checksum)
+	}
+
+	return verifyBatchUpgradeSignature(cfg, u)
+}
+
+// verifyBatchUpgradeSignature verifies the signature of the upgrade binary. The
+// signature is required if DAEMON_BINARY_PUBLIC_KEY is set.
+func verifyBatchUpgradeSignature(cfg *Config, u BatchUpgrade) error {
+	osArch, err := u.binaryOSArch()
+	if err != nil {
+		return err
+	}
+
+	sig, ok := u.Signatures[osArch]
+	if cfg.BinaryPublicKey == "" {
+		if ok {
+			return fmt.Errorf("cannot verify the binary signature, %s is not set", EnvBinaryPublicKey)
+		}
+
+		return nil
+	}
+
+	if !ok {
+		return fmt.Errorf("missing binary signature for %s, required when %s is set", osArch, EnvBinaryPublicKey)
+	}
+
+	return VerifyBinarySignature(cfg, cfg.UpgradeBin(u.Name), sig)
+}
+
+// binaryChecksum returns the sha256 checksum of the binary, in the format of the
+// checksum query parameter of binary URLs.
+func binaryChecksum(bin string) (string, error) {
+	f, err := os.Open(bin)
+	if err != nil {
+		return "", fmt.Errorf("failed to read binary: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to read binary: %w", err)
+	}
+
+	return fmt.Sprintf("sha256:%x", h.Sum(nil)), nil
+}
+
+// VerifyBinarySignature verifies the base64 encoded ed25519 signature of the
+// binary against the key configured by DAEMON_BINARY_PUBLIC_KEY.
+func VerifyBinarySignature(cfg *Config, bin, signature string) error {
+	pubKey, err := cfg.binaryPublicKey()
+	if err != nil {
+		return err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid binary signature: %w", err)
+	}
+
+	bz, err := os.ReadFile(bin)
+	if err != nil {
+		return fmt.Errorf("failed to read binary: %w", err)
+	}
+
+	if !ed25519.Verify(pubKey, bz, sig) {
+		return fmt.Errorf("invalid signature of binary %s", bin)
+	}
+
+	return nil
+}
+
+// binaryPublicKey decodes the key configured by DAEMON_BINARY_PUBLIC_KEY.
+func (cfg *Config) binaryPublicKey() (ed25519.PublicKey, error) {
+	bz, err := base64.StdEncoding.DecodeString(cfg.BinaryPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a base64 encoded ed25519 public key: %w", EnvBinaryPublicKey, err)
+	}
+
+	if len(bz) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%s must be a base64 encoded ed25519 public key, got %d bytes", EnvBinaryPublicKey, len(bz))
+	}
+
+	return ed25519.PublicKey(bz), nil
+}
+
+// LoadBatchUpgrades returns the upgrades added with the add-batch-upgrade
+// command, ordered by height.
+func LoadBatchUpgrades(cfg *Config) ([]BatchUpgrade, error) {
+	bz, err := os.ReadFile(cfg.BatchUpgradeFilePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read %s: %w", batchUpgradeFilename, err)
+	}
+
+	var m BatchUpgradeManifest
+	if err := json.Unmarshal(bz, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", batchUpgradeFilename, err)
+	}
+
+	return m.Upgrades, nil
+}
+
+// SaveBatchUpgrades adds the upgrades to the ones added with the
+// add-batch-upgrade command. An upgrade replaces the one of the same name.
+func SaveBatchUpgrades(cfg *Config, upgrades []BatchUpgrade) error {
+	existing, err := LoadBatchUpgrades(cfg)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]BatchUpgrade, len(existing)+len(upgrades))
+	for _, u := range append(existing, upgrades...) {
+		byName[u.Name] = u
+	}
+
+	m := BatchUpgradeManifest{Upgrades: make([]BatchUpgrade, 0, len(byName))}
+	for _, u := range byName {
+		m.Upgrades = append(m.Upgrades, u)
+	}
+	sort.Slice(m.Upgrades, func(i, j int) bool {
+		if m.Upgrades[i].Height != m.Upgrades[j].Height {
+			return m.Upgrades[i].Height < m.Upgrades[j].Height
+		}
+		return m.Upgrades[i].Name < m.Upgrades[j].Name
+	})
+
+	bz, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cfg.BatchUpgradeFilePath(), bz, 0o600)
+}
+
+// verifyPlanBinary verifies the binary of the upgrade plan against its recorded
+// checksum and signature if it was added with the add-batch-upgrade command, so
+// that a binary altered after it was added is not run.
+func verifyPlanBinary(cfg *Config, p upgradetypes.Plan) error {
+	upgrades, err := LoadBatchUpgrades(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range upgrades {
+		if strings.EqualFold(u.Name, p.Name) {
+			return verifyBatchUpgradeBinary(cfg, u)
+		}
+	}
+
+	return nil
+}
+
+// UpgradeStatus is the status of an upgrade added with the add-batch-upgrade command.
+type UpgradeStatus struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	// Applied is set once cosmovisor switched to the upgrade binary.
+	Applied bool `json:"applied"`
+	// Ready is set if the upgrade binary is present and verified.
+	Ready bool `json:"ready"`
+	// Error is the reason why the upgrade binary is not ready.
+	Error string `json:"error,omitempty"`
+}
+
+// GetUpgradeStatuses returns the status of the upgrades added with the
+// add-batch-upgrade command, ordered by height.
+func GetUpgradeStatuses(cfg *Config) ([]UpgradeStatus, error) {
+	upgrades, err := LoadBatchUpgrades(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]UpgradeStatus, 0, len(upgrades))
+	for _, u := range upgrades {
+		status := UpgradeStatus{Name: u.Name, Height: u.Height}
+
+		// the upgrade info is written in the upgrade directory when switching to the upgrade binary
+		if _, err := os.Stat(filepath.Join(cfg.UpgradeDir(u.Name), upgradetypes.UpgradeInfoFilename)); err == nil {
+			status.Applied = true
+		}
+
+		if err := plan.EnsureBinary(cfg.UpgradeBin(u.Name)); err != nil {
+			status.Error = err.Error()
+		} else if err := verifyBatchUpgradeBinary(cfg, u); err != nil {
+			status.Error = err.Error()
+		} else {
+			status.Ready = true
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
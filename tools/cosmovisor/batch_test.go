@@ -0,0 +1,214 @@
+package cosmovisor_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+	"cosmossdk.io/x/upgrade/plan"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// newBatchBinary writes an upgrade binary and returns its URL with checksum and its contents.
+func newBatchBinary(t *testing.T, name string) (string, []byte) {
+	t.Helper()
+
+	bz := []byte(fmt.Sprintf("#!/bin/sh\necho %s\n", name))
+	path := filepath.Join(t.TempDir(), "dummyd")
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	return fmt.Sprintf("file://%s?checksum=sha256:%x", path, sha256.Sum256(bz)), bz
+}
+
+// tamperBinary replaces the binary, the file downloaded from a file URL being a link to the source file.
+func tamperBinary(t *testing.T, bin string) {
+	t.Helper()
+
+	require.NoError(t, os.Remove(bin))
+	require.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\necho tampered\n"), 0o700))
+}
+
+func newBatchConfig(t *testing.T) *cosmovisor.Config {
+	t.Helper()
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "cosmovisor", "upgrades"), 0o750))
+
+	return &cosmovisor.Config{Home: home, Name: "dummyd"}
+}
+
+func TestParseBatchUpgradeManifest(t *testing.T) {
+	url, _ := newBatchBinary(t, "v2")
+
+	cases := []struct {
+		name     string
+		manifest string
+		expErr   string
+	}{
+		{"valid", fmt.Sprintf(`{"upgrades":[{"name":"v2","height":100,"binaries":{"any":%q},"signatures":{"any":"AQID"}}]}`, url), ""},
+		{"no upgrades", `{"upgrades":[]}`, "no upgrades found"},
+		{"empty name", fmt.Sprintf(`{"upgrades":[{"height":100,"binaries":{"any":%q}}]}`, url), "upgrade name cannot be empty"},
+		{"no height", fmt.Sprintf(`{"upgrades":[{"name":"v2","binaries":{"any":%q}}]}`, url), "height must be greater than 0"},
+		{"no binaries", `{"upgrades":[{"name":"v2","height":100}]}`, "no \"binaries\" entries found"},
+		{"no checksum", `{"upgrades":[{"name":"v2","height":100,"binaries":{"any":"file:///tmp/dummyd"}}]}`, "missing checksum query parameter"},
+		{"signature without binary", fmt.Sprintf(`{"upgrades":[{"name":"v2","height":100,"binaries":{"any":%q},"signatures":{"linux/arm64":"AQID"}}]}`, url), "no binary for signature of linux/arm64"},
+		{"invalid signature", fmt.Sprintf(`{"upgrades":[{"name":"v2","height":100,"binaries":{"any":%q},"signatures":{"any":"not base64!"}}]}`, url), "invalid signature of any"},
+		{"duplicate", fmt.Sprintf(`{"upgrades":[{"name":"v2","height":100,"binaries":{"any":%q}},{"name":"v2","height":200,"binaries":{"any":%q}}]}`, url, url), `duplicate upgrade "v2"`},
+		{"invalid json", `{"upgrades":`, "failed to parse batch upgrade manifest"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "manifest.json")
+			require.NoError(t, os.WriteFile(path, []byte(tc.manifest), 0o600))
+
+			m, err := cosmovisor.ParseBatchUpgradeManifest(path)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, m.Upgrades, 1)
+		})
+	}
+}
+
+func TestPrepareBatchUpgrade(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	url, bz := newBatchBinary(t, "v2")
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, bz))
+
+	// without public key, the checksum only is verified
+	cfg := newBatchConfig(t)
+	u := cosmovisor.BatchUpgrade{Name: "v2", Height: 100, Binaries: plan.BinaryDownloadURLMap{"any": url}}
+	require.NoError(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, false))
+	require.NoError(t, plan.EnsureBinary(cfg.UpgradeBin("v2")))
+	require.Equal(t, fmt.Sprintf("sha256:%x", sha256.Sum256(bz)), u.Checksum)
+
+	// a signature can't be verified without public key
+	u.Signatures = map[string]string{"any": signature}
+	require.ErrorContains(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, true), "DAEMON_BINARY_PUBLIC_KEY is not set")
+	require.NoDirExists(t, cfg.UpgradeDir("v2"))
+
+	// with public key, the signature is verified
+	cfg.BinaryPublicKey = base64.StdEncoding.EncodeToString(pubKey)
+	require.NoError(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, false))
+	require.NoError(t, plan.EnsureBinary(cfg.UpgradeBin("v2")))
+
+	// a present binary is verified again
+	tamperBinary(t, cfg.UpgradeBin("v2"))
+	require.ErrorContains(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, false), "invalid signature of binary")
+	require.NoDirExists(t, cfg.UpgradeDir("v2"))
+
+	// the signature is required with public key
+	u.Signatures = nil
+	require.ErrorContains(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, false), "missing binary signature for any")
+
+	// a signature of another binary is rejected
+	otherURL, _ := newBatchBinary(t, "v3")
+	u = cosmovisor.BatchUpgrade{Name: "v3", Height: 200, Binaries: plan.BinaryDownloadURLMap{"any": otherURL}, Signatures: map[string]string{"any": signature}}
+	require.ErrorContains(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, false), "invalid signature of binary")
+	require.NoDirExists(t, cfg.UpgradeDir("v3"))
+
+	// the checksum is verified
+	u = cosmovisor.BatchUpgrade{Name: "v3", Height: 200, Binaries: plan.BinaryDownloadURLMap{"any": url[:len(url)-1] + "0"}}
+	cfg.BinaryPublicKey = ""
+	require.ErrorContains(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, false), "cannot download binary")
+	require.NoDirExists(t, cfg.UpgradeDir("v3"))
+
+	// no binary for the os/arch
+	u = cosmovisor.BatchUpgrade{Name: "v3", Height: 200, Binaries: plan.BinaryDownloadURLMap{"plan9/mips": otherURL}}
+	require.ErrorContains(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &u, false), "cannot find binary for os/arch")
+}
+
+func TestBatchUpgradeStatuses(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	cfg := newBatchConfig(t)
+	cfg.BinaryPublicKey = base64.StdEncoding.EncodeToString(pubKey)
+
+	v2URL, v2Bz := newBatchBinary(t, "v2")
+	v3URL, v3Bz := newBatchBinary(t, "v3")
+	upgrades := []cosmovisor.BatchUpgrade{
+		{Name: "v3", Height: 200, Binaries: plan.BinaryDownloadURLMap{"any": v3URL}, Signatures: map[string]string{"any": base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, v3Bz))}},
+		{Name: "v2", Height: 100, Binaries: plan.BinaryDownloadURLMap{"any": v2URL}, Signatures: map[string]string{"any": base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, v2Bz))}},
+	}
+	for i := range upgrades {
+		require.NoError(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &upgrades[i], false))
+	}
+	require.NoError(t, cosmovisor.SaveBatchUpgrades(cfg, upgrades))
+
+	// the upgrades are ordered by height, and replaced by name
+	v4URL, _ := newBatchBinary(t, "v4")
+	require.NoError(t, cosmovisor.SaveBatchUpgrades(cfg, []cosmovisor.BatchUpgrade{
+		{Name: "v4", Height: 300, Binaries: plan.BinaryDownloadURLMap{"any": v4URL}},
+		{Name: "v3", Height: 250, Binaries: upgrades[0].Binaries, Signatures: upgrades[0].Signatures, Checksum: upgrades[0].Checksum},
+	}))
+
+	saved, err := cosmovisor.LoadBatchUpgrades(cfg)
+	require.NoError(t, err)
+	require.Len(t, saved, 3)
+	require.Equal(t, []string{"v2", "v3", "v4"}, []string{saved[0].Name, saved[1].Name, saved[2].Name})
+	require.Equal(t, int64(250), saved[1].Height)
+
+	// the upgrade binary of v2 is verified again when upgrading
+	require.NoError(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v2", Height: 100}))
+	tamperBinary(t, cfg.UpgradeBin("v3"))
+	require.ErrorContains(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v3", Height: 250}), "upgrade binary verification failed")
+
+	statuses, err := cosmovisor.GetUpgradeStatuses(cfg)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+
+	require.Equal(t, cosmovisor.UpgradeStatus{Name: "v2", Height: 100, Applied: true, Ready: true}, statuses[0])
+
+	require.Equal(t, "v3", statuses[1].Name)
+	require.False(t, statuses[1].Applied)
+	require.False(t, statuses[1].Ready)
+	require.Contains(t, statuses[1].Error, "checksum mismatch of binary")
+
+	require.Equal(t, "v4", statuses[2].Name)
+	require.False(t, statuses[2].Ready)
+	require.NotEmpty(t, statuses[2].Error)
+}
+
+func TestVerifyPlanBinaryChecksum(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	cfg := newBatchConfig(t)
+
+	// without public key, the binary is verified against its recorded checksum
+	v2URL, _ := newBatchBinary(t, "v2")
+	v3URL, _ := newBatchBinary(t, "v3")
+	upgrades := []cosmovisor.BatchUpgrade{
+		{Name: "v2", Height: 100, Binaries: plan.BinaryDownloadURLMap{"any": v2URL}},
+		{Name: "v3", Height: 200, Binaries: plan.BinaryDownloadURLMap{"any": v3URL}},
+	}
+	for i := range upgrades {
+		require.NoError(t, cosmovisor.PrepareBatchUpgrade(logger, cfg, &upgrades[i], false))
+	}
+	require.NoError(t, cosmovisor.SaveBatchUpgrades(cfg, upgrades))
+
+	tamperBinary(t, cfg.UpgradeBin("v3"))
+	require.ErrorContains(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v3", Height: 200}), "checksum mismatch of binary")
+	require.NoError(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v2", Height: 100}))
+
+	// an upgrade without recorded checksum is rejected
+	upgrades[0].Checksum = ""
+	require.NoError(t, cosmovisor.SaveBatchUpgrades(cfg, upgrades[:1]))
+	require.ErrorContains(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "v2", Height: 100}), "no binary checksum recorded")
+}
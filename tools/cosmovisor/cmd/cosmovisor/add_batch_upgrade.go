@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func NewAddBatchUpgradeCmd() *cobra.Command {
+	addBatchUpgrade := &cobra.Command{
+		Use:   "add-batch-upgrade [path to manifest]",
+		Short: "Add multiple upgrades to Cosmovisor from a manifest, downloading and verifying their binaries",
+		Long: `Add multiple upgrades to Cosmovisor from a JSON manifest of the form:
+
+{"upgrades": [{"name": "v2", "height": 1000, "binaries": {"linux/amd64": "https://...?checksum=sha256:..."}, "signatures": {"linux/amd64": "<base64 ed25519 signature>"}}]}
+
+The binary of each upgrade for the current os/arch is downloaded and its checksum verified.
+The checksum of the binary is recorded, and verified again when the upgrade is applied.
+If ` + cosmovisor.EnvBinaryPublicKey + ` is set, the signature of each binary is required and verified against it.`,
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE:         AddBatchUpgrade,
+	}
+
+	addBatchUpgrade.Flags().Bool(cosmovisor.FlagForce, false, "download again upgrade binaries already present")
+
+	return addBatchUpgrade
+}
+
+// AddBatchUpgrade adds the upgrades of a manifest
+func AddBatchUpgrade(cmd *cobra.Command, args []string) error {
+	cfg, err := cosmovisor.GetConfigFromEnv()
+	if err != nil {
+		return err
+	}
+
+	logger := cmd.Context().Value(log.ContextKey).(log.Logger)
+	if cfg.DisableLogs {
+		logger = log.NewCustomLogger(zerolog.Nop())
+	}
+
+	manifest, err := cosmovisor.ParseBatchUpgradeManifest(args[0])
+	if err != nil {
+		return err
+	}
+
+	force, _ := cmd.Flags().GetBool(cosmovisor.FlagForce)
+
+	// all the binaries are prepared before any upgrade is added
+	for i := range manifest.Upgrades {
+		if err := cosmovisor.PrepareBatchUpgrade(logger, cfg, &manifest.Upgrades[i], force); err != nil {
+			return err
+		}
+	}
+
+	if err := cosmovisor.SaveBatchUpgrades(cfg, manifest.Upgrades); err != nil {
+		return fmt.Errorf("failed to save batch upgrades: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("Added %d upgrade(s) from %s", len(manifest.Upgrades), args[0]))
+
+	return nil
+}
@@ -17,6 +17,8 @@ func NewRootCmd() *cobra.Command {
 		configCmd,
 		NewVersionCmd(),
 		NewAddUpgradeCmd(),
+		NewAddBatchUpgradeCmd(),
+		NewStatusCmd(),
 	)
 
 	return rootCmd
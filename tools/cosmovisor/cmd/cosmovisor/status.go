@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+// statusOutput is the output of the status command.
+type statusOutput struct {
+	CurrentUpgrade string                     `json:"current_upgrade"`
+	CurrentBin     string                     `json:"current_bin"`
+	AppVersion     string                     `json:"app_version,omitempty"`
+	Upgrades       []cosmovisor.UpgradeStatus `json:"upgrades"`
+}
+
+func NewStatusCmd() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:          "status",
+		Short:        "Display the current APP version and the upgrades added with add-batch-upgrade.",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cosmovisor.GetConfigFromEnv()
+			if err != nil {
+				return err
+			}
+
+			noAppVersion, _ := cmd.Flags().GetBool(cosmovisor.FlagNoAppVersion)
+			status, err := getStatus(cfg, noAppVersion)
+			if err != nil {
+				return err
+			}
+
+			if val, err := cmd.Flags().GetString(cosmovisor.FlagOutput); val == "json" && err == nil {
+				out, err := json.Marshal(status)
+				if err != nil {
+					return err
+				}
+
+				cmd.Println(string(out))
+				return nil
+			}
+
+			printStatus(cmd, status)
+			return nil
+		},
+	}
+
+	statusCmd.Flags().StringP(cosmovisor.FlagOutput, "o", "text", "Output format (text|json)")
+	statusCmd.Flags().Bool(cosmovisor.FlagNoAppVersion, false, "Don't print APP version")
+
+	return statusCmd
+}
+
+func getStatus(cfg *cosmovisor.Config, noAppVersion bool) (statusOutput, error) {
+	bin, err := cfg.CurrentBin()
+	if err != nil {
+		return statusOutput{}, fmt.Errorf("failed to get current binary: %w", err)
+	}
+
+	// the current binary is either <root>/genesis/bin/<name> or <root>/upgrades/<upgrade>/bin/<name>
+	status := statusOutput{CurrentUpgrade: filepath.Base(filepath.Dir(filepath.Dir(bin))), CurrentBin: bin}
+
+	if !noAppVersion {
+		out, err := exec.Command(bin, "version").CombinedOutput()
+		if err != nil {
+			return statusOutput{}, fmt.Errorf("failed to run version command: %w", err)
+		}
+
+		status.AppVersion = strings.TrimSpace(string(out))
+	}
+
+	if status.Upgrades, err = cosmovisor.GetUpgradeStatuses(cfg); err != nil {
+		return statusOutput{}, err
+	}
+
+	return status, nil
+}
+
+func printStatus(cmd *cobra.Command, status statusOutput) {
+	cmd.Printf("current upgrade: %s\n", status.CurrentUpgrade)
+	cmd.Printf("current binary: %s\n", status.CurrentBin)
+	if status.AppVersion != "" {
+		cmd.Printf("app version: %s\n", status.AppVersion)
+	}
+
+	var pending, applied []cosmovisor.UpgradeStatus
+	for _, u := range status.Upgrades {
+		if u.Applied {
+			applied = append(applied, u)
+		} else {
+			pending = append(pending, u)
+		}
+	}
+
+	cmd.Println("pending upgrades:")
+	if len(pending) == 0 {
+		cmd.Println("  none")
+	}
+	for _, u := range pending {
+		if u.Ready {
+			cmd.Printf("  %s at height %d: binary ready\n", u.Name, u.Height)
+		} else {
+			cmd.Printf("  %s at height %d: binary not ready: %s\n", u.Name, u.Height, u.Error)
+		}
+	}
+
+	if len(applied) > 0 {
+		cmd.Println("applied upgrades:")
+		for _, u := range applied {
+			cmd.Printf("  %s at height %d\n", u.Name, u.Height)
+		}
+	}
+}
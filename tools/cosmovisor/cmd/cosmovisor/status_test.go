@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+	"cosmossdk.io/x/upgrade/plan"
+)
+
+func TestStatusCommand(t *testing.T) {
+	logger := log.NewTestLogger(t).With(log.ModuleKey, "cosmovisor")
+
+	home := t.TempDir()
+	t.Setenv(cosmovisor.EnvHome, home)
+	t.Setenv(cosmovisor.EnvName, "dummyd")
+	t.Setenv(cosmovisor.EnvBinaryPublicKey, "")
+
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+	require.NoError(t, os.MkdirAll(filepath.Dir(cfg.GenesisBin()), 0o750))
+	require.NoError(t, os.WriteFile(cfg.GenesisBin(), []byte("#!/bin/sh\necho v1.0.0\n"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Dir(cfg.UpgradeBin("v2")), 0o750))
+	v2Bin := []byte("#!/bin/sh\necho v2.0.0\n")
+	require.NoError(t, os.WriteFile(cfg.UpgradeBin("v2"), v2Bin, 0o700))
+	require.NoError(t, cosmovisor.SaveBatchUpgrades(cfg, []cosmovisor.BatchUpgrade{
+		{Name: "v3", Height: 200, Binaries: plan.BinaryDownloadURLMap{"any": "https://example.com/dummyd?checksum=sha256:0000"}},
+		{Name: "v2", Height: 100, Binaries: plan.BinaryDownloadURLMap{"any": "https://example.com/dummyd?checksum=sha256:0000"}, Checksum: fmt.Sprintf("sha256:%x", sha256.Sum256(v2Bin))},
+	}))
+
+	ctx := context.WithValue(context.Background(), log.ContextKey, logger)
+
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"status"})
+	out := bytes.NewBufferString("")
+	rootCmd.SetOut(out)
+	require.NoError(t, rootCmd.ExecuteContext(ctx))
+	require.Contains(t, out.String(), "current upgrade: genesis\n")
+	require.Contains(t, out.String(), "app version: v1.0.0\n")
+	require.Contains(t, out.String(), "  v2 at height 100: binary ready\n")
+	require.Contains(t, out.String(), "  v3 at height 200: binary not ready")
+
+	rootCmd = NewRootCmd()
+	rootCmd.SetArgs([]string{"status", "--output", "json", "--no-app-version"})
+	out.Reset()
+	rootCmd.SetOut(out)
+	require.NoError(t, rootCmd.ExecuteContext(ctx))
+
+	var status statusOutput
+	require.NoError(t, json.Unmarshal(out.Bytes(), &status))
+	require.Equal(t, "genesis", status.CurrentUpgrade)
+	require.Equal(t, cfg.GenesisBin(), status.CurrentBin)
+	require.Empty(t, status.AppVersion)
+	require.Len(t, status.Upgrades, 2)
+	require.Equal(t, cosmovisor.UpgradeStatus{Name: "v2", Height: 100, Ready: true}, status.Upgrades[0])
+	require.False(t, status.Upgrades[1].Ready)
+}
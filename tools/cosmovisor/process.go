@@ -2,6 +2,7 @@ package cosmovisor
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -50,6 +51,27 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		return false, fmt.Errorf("current binary is invalid: %w", err)
 	}
 
+	// check the health of the binary of the last upgrade until it commits a block past the upgrade height
+	var (
+		healthCheck *upgradeHealthCheck
+		watcher     *commitWatcher
+	)
+	if l.cfg.RollbackOnFailure {
+		if healthCheck, err = loadUpgradeHealthCheck(l.cfg); err != nil {
+			return false, err
+		}
+
+		if healthCheck != nil && !healthCheck.RolledBack {
+			watcher = newCommitWatcher(healthCheck.Height, func() {
+				l.logger.Info().Str("upgrade", healthCheck.Upgrade).Msg("upgrade binary committed a block past the upgrade height, upgrade is healthy")
+				if err := os.Remove(l.cfg.UpgradeHealthCheckFilePath()); err != nil {
+					l.logger.Error().Err(err).Msg("failed to remove upgrade health check file")
+				}
+			})
+			stdout, stderr = watcher.Wrap(stdout), watcher.Wrap(stderr)
+		}
+	}
+
 	l.logger.Info().Str("path", bin).Strs("args", args).Msg("running app")
 	cmd := exec.Command(bin, args...)
 	cmd.Stdout = stdout
@@ -67,14 +89,25 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	if err != nil && watcher != nil && !watcher.Committed() {
+		return false, l.handleUpgradeFailure(healthCheck, err)
+	}
+
+	if err != nil || !needsUpdate {
 		return false, err
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
+		// a rolled back upgrade is not applied again until the operator removes the health check file
+		if healthCheck != nil && healthCheck.RolledBack && healthCheck.Upgrade == l.fw.currentInfo.Name {
+			return false, fmt.Errorf("upgrade %q was rolled back, remove %s to retry it", healthCheck.Upgrade, l.cfg.UpgradeHealthCheckFilePath())
+		}
+
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		backupDir, err := l.doBackup()
+		if err != nil {
 			return false, err
 		}
 
@@ -86,6 +119,18 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 			return false, err
 		}
 
+		if l.cfg.RollbackOnFailure {
+			healthCheck = &upgradeHealthCheck{
+				Upgrade:     l.fw.currentInfo.Name,
+				Height:      l.fw.currentInfo.Height,
+				PreviousDir: filepath.Dir(filepath.Dir(bin)),
+				BackupDir:   backupDir,
+			}
+			if err := healthCheck.save(l.cfg); err != nil {
+				return false, fmt.Errorf("error while saving upgrade health check: %w", err)
+			}
+		}
+
 		return true, nil
 	}
 
@@ -130,22 +175,47 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// handleUpgradeFailure records a failure of the binary of the last upgrade. Once
+// it failed DAEMON_ROLLBACK_MAX_FAILURES times, the upgrade is rolled back and
+// the returned error reports it.
+func (l Launcher) handleUpgradeFailure(hc *upgradeHealthCheck, runErr error) error {
+	hc.Failures++
+	hc.LastError = runErr.Error()
+	l.logger.Error().Err(runErr).Str("upgrade", hc.Upgrade).Int("failures", hc.Failures).Int("max failures", l.cfg.RollbackMaxFailures).Msg("upgrade binary failed before committing a block past the upgrade height")
+
+	if hc.Failures < l.cfg.RollbackMaxFailures {
+		if err := hc.save(l.cfg); err != nil {
+			return fmt.Errorf("error while saving upgrade health check: %w", err)
+		}
+
+		return runErr
+	}
+
+	if err := l.rollback(hc); err != nil {
+		return fmt.Errorf("rollback of upgrade %q failed: %w", hc.Upgrade, err)
+	}
+
+	return errors.New(hc.report(l.cfg))
+}
+
+// doBackup takes a backup of the data directory and returns its path, unless
+// UNSAFE_SKIP_BACKUP is set.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(filepath.Join(l.cfg.Home, "data", "upgrade-info.json"))
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return fmt.Errorf("upgrade-info.json is empty")
+			return "", fmt.Errorf("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -157,15 +227,17 @@ func (l Launcher) doBackup() error {
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
 		et := time.Now()
 		l.logger.Info().Str("backup saved at", dst).Time("backup completion time", et).TimeDiff("time taken to complete backup", et, st).Msg("backup completed")
+
+		return dst, nil
 	}
 
-	return nil
+	return "", nil
 }
 
 // doPreUpgrade runs the pre-upgrade command defined by the application and handles respective error codes.
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -169,6 +170,97 @@ func (s *processTestSuite) TestLaunchProcessWithDownloads() {
 }
 
 // TestSkipUpgrade tests heights that are identified to be skipped and return if upgrade height matches the skip heights
+// TestLaunchProcessWithRollback will upgrade to a binary failing before committing a block past the
+// upgrade height, and ensure the upgrade is rolled back once it failed too many times
+func (s *processTestSuite) TestLaunchProcessWithRollback() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: s.T().TempDir(), RollbackOnFailure: true, RollbackMaxFailures: 2}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+	dataDir := filepath.Join(home, "data")
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	stdout, stderr := newBuffer(), newBuffer()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+	require.FileExists(cfg.UpgradeHealthCheckFilePath())
+
+	// the first failure is reported as is
+	doUpgrade, err = launcher.Run([]string{"fail", dataDir}, stdout, stderr)
+	require.Error(err)
+	require.NotContains(err.Error(), "rolled back")
+	require.False(doUpgrade)
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+
+	// the upgrade is rolled back once the max failures is reached
+	_, err = launcher.Run([]string{"fail", dataDir}, stdout, stderr)
+	require.ErrorContains(err, `upgrade "chain2" at height 49 rolled back: the upgrade binary failed 2 time(s)`)
+	require.Contains(stderr.String(), "panic: corrupted state")
+
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.GenesisBin(), currentBin)
+	require.NoFileExists(filepath.Join(dataDir, "corrupted.db"))
+	require.FileExists(cfg.UpgradeInfoFilePath())
+
+	// the priv validator state is not restored, to prevent double signing
+	pvs, err := os.ReadFile(filepath.Join(dataDir, "priv_validator_state.json"))
+	require.NoError(err)
+	require.Contains(string(pvs), `"height":"50"`)
+
+	// the rolled back upgrade is not applied again once cosmovisor is restarted
+	cfg = &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: cfg.DataBackupPath, RollbackOnFailure: true, RollbackMaxFailures: 2}
+	launcher, err = cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	doUpgrade, err = launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+	require.ErrorContains(err, `upgrade "chain2" was rolled back`)
+	require.False(doUpgrade)
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.GenesisBin(), currentBin)
+}
+
+// TestLaunchProcessWithHealthyUpgrade ensures failures are not counted once the upgrade binary
+// committed a block past the upgrade height
+func (s *processTestSuite) TestLaunchProcessWithHealthyUpgrade() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: s.T().TempDir(), RollbackOnFailure: true, RollbackMaxFailures: 1}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+	dataDir := filepath.Join(home, "data")
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	stdout, stderr := newBuffer(), newBuffer()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+	require.FileExists(cfg.UpgradeHealthCheckFilePath())
+
+	stdout.Reset()
+	_, err = launcher.Run([]string{"healthy"}, stdout, stderr)
+	require.Error(err)
+	require.NotContains(err.Error(), "rolled back")
+	require.Equal("Chain 2 is live!\nINF committed state app_hash=8B3F height=50 module=state\n", stdout.String())
+	require.NoFileExists(cfg.UpgradeHealthCheckFilePath())
+
+	_, err = launcher.Run([]string{"fail", dataDir}, stdout, stderr)
+	require.Error(err)
+	require.NotContains(err.Error(), "rolled back")
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+}
+
 func TestSkipUpgrade(t *testing.T) {
 	cases := []struct {
 		args        []string
@@ -0,0 +1,202 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/otiai10/copy"
+)
+
+// privValidatorStateFilename is the file of the data directory in which the
+// consensus engine records the last height signed by the validator. It is kept
+// when the data directory is restored from a backup, so that the validator does
+// not sign the same heights twice.
+const privValidatorStateFilename = "priv_validator_state.json"
+
+var (
+	ansiEscapeRegexp     = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	committedStateRegexp = regexp.MustCompile(`height"?\s*[=:]\s*"?(\d+)`)
+)
+
+// upgradeHealthCheck tracks the health of the binary of the last upgrade until
+// it commits a block past the upgrade height. It is persisted to
+// Config.UpgradeHealthCheckFilePath so that failures are counted across the
+// restarts of cosmovisor.
+type upgradeHealthCheck struct {
+	// Upgrade is the name of the upgrade.
+	Upgrade string `json:"upgrade"`
+	// Height is the height of the upgrade.
+	Height int64 `json:"height"`
+	// PreviousDir is the directory the current link pointed to before the upgrade.
+	PreviousDir string `json:"previous_dir"`
+	// BackupDir is the data backup taken before the upgrade.
+	BackupDir string `json:"backup_dir"`
+	// Failures is the number of times the upgrade binary exited with an error.
+	Failures int `json:"failures"`
+	// LastError is the error of the last failure of the upgrade binary.
+	LastError string `json:"last_error,omitempty"`
+	// RolledBack is set once the upgrade has been rolled back.
+	RolledBack bool `json:"rolled_back"`
+}
+
+// loadUpgradeHealthCheck reads the upgrade health check of the last upgrade.
+// It returns nil if no upgrade is being checked.
+func loadUpgradeHealthCheck(cfg *Config) (*upgradeHealthCheck, error) {
+	bz, err := os.ReadFile(cfg.UpgradeHealthCheckFilePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error while reading %s: %w", healthCheckFilename, err)
+	}
+
+	var hc upgradeHealthCheck
+	if err := json.Unmarshal(bz, &hc); err != nil {
+		return nil, fmt.Errorf("error while parsing %s: %w", healthCheckFilename, err)
+	}
+
+	return &hc, nil
+}
+
+func (hc *upgradeHealthCheck) save(cfg *Config) error {
+	bz, err := json.MarshalIndent(hc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cfg.UpgradeHealthCheckFilePath(), bz, 0o600)
+}
+
+// report describes the rolled back upgrade to the operator.
+func (hc *upgradeHealthCheck) report(cfg *Config) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("upgrade %q at height %d rolled back: the upgrade binary failed %d time(s) before committing a block past the upgrade height\n", hc.Upgrade, hc.Height, hc.Failures))
+	sb.WriteString(fmt.Sprintf("  last error: %s\n", hc.LastError))
+	sb.WriteString(fmt.Sprintf("  data restored from: %s\n", hc.BackupDir))
+	sb.WriteString(fmt.Sprintf("  current binary restored to: %s\n", hc.PreviousDir))
+	sb.WriteString(fmt.Sprintf("fix the upgrade binary, then remove %s to retry the upgrade", cfg.UpgradeHealthCheckFilePath()))
+
+	return sb.String()
+}
+
+// rollback restores the data directory from the backup taken before the
+// upgrade, except the priv validator state, and points the current link back to
+// the binary run before the upgrade.
+func (l Launcher) rollback(hc *upgradeHealthCheck) error {
+	dataDir := filepath.Join(l.cfg.Home, "data")
+
+	// keep the last signed height, the restored data must not make the validator double sign
+	pvs, err := os.ReadFile(filepath.Join(dataDir, privValidatorStateFilename))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error while reading %s: %w", privValidatorStateFilename, err)
+	}
+
+	l.logger.Info().Str("backup", hc.BackupDir).Msg("restoring data directory")
+
+	if err := os.RemoveAll(dataDir); err != nil {
+		return fmt.Errorf("error while removing data directory: %w", err)
+	}
+
+	if err := copy.Copy(hc.BackupDir, dataDir); err != nil {
+		return fmt.Errorf("error while restoring data backup: %w", err)
+	}
+
+	if pvs != nil {
+		if err := os.WriteFile(filepath.Join(dataDir, privValidatorStateFilename), pvs, 0o600); err != nil {
+			return fmt.Errorf("error while restoring %s: %w", privValidatorStateFilename, err)
+		}
+	}
+
+	l.logger.Info().Str("path", hc.PreviousDir).Msg("restoring current binary")
+
+	link := filepath.Join(l.cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove existing link: %w", err)
+	}
+
+	if err := os.Symlink(hc.PreviousDir, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+
+	hc.RolledBack = true
+	return hc.save(l.cfg)
+}
+
+// commitWatcher scans the lines of the output of the app and calls onCommit
+// once, when the app commits a block past the given height.
+type commitWatcher struct {
+	height   int64
+	onCommit func()
+
+	once      sync.Once
+	committed atomic.Bool
+}
+
+func newCommitWatcher(height int64, onCommit func()) *commitWatcher {
+	return &commitWatcher{height: height, onCommit: onCommit}
+}
+
+// Committed returns true once the app committed a block past the watched height.
+func (w *commitWatcher) Committed() bool {
+	return w.committed.Load()
+}
+
+// Wrap returns a writer scanning the lines written to out.
+func (w *commitWatcher) Wrap(out io.Writer) io.Writer {
+	return &commitWatcherWriter{watcher: w, out: out}
+}
+
+func (w *commitWatcher) scan(line []byte) {
+	if w.Committed() || !bytes.Contains(line, []byte("committed state")) {
+		return
+	}
+
+	matches := committedStateRegexp.FindSubmatch(ansiEscapeRegexp.ReplaceAll(line, nil))
+	if matches == nil {
+		return
+	}
+
+	height, err := strconv.ParseInt(string(matches[1]), 10, 64)
+	if err != nil || height <= w.height {
+		return
+	}
+
+	w.once.Do(func() {
+		w.committed.Store(true)
+		w.onCommit()
+	})
+}
+
+type commitWatcherWriter struct {
+	watcher *commitWatcher
+	out     io.Writer
+	buf     []byte
+}
+
+// Write implements io.Writer. The output is written as is, the complete lines
+// are scanned.
+func (w *commitWatcherWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.watcher.scan(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+
+	return w.out.Write(p)
+}
@@ -0,0 +1,47 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitWatcher(t *testing.T) {
+	cases := []struct {
+		name      string
+		output    []string
+		committed bool
+	}{
+		{"no commit", []string{"INF starting node\n", "INF executed block height=51\n"}, false},
+		{"commit at upgrade height", []string{"INF committed state app_hash=AB height=49 module=state\n"}, false},
+		{"text commit", []string{"INF committed state app_hash=AB height=50 module=state\n"}, true},
+		{"colored commit", []string{"\x1b[32mINF\x1b[0m committed state \x1b[36mheight=\x1b[0m50\n"}, true},
+		{"json commit", []string{`{"level":"info","module":"state","height":50,"message":"committed state"}` + "\n"}, true},
+		{"split commit", []string{"INF committed st", "ate height=5", "0\n"}, true},
+		{"incomplete line", []string{"INF committed state height=50"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			w := newCommitWatcher(49, func() { calls++ })
+
+			var out bytes.Buffer
+			wr := w.Wrap(&out)
+			for _, o := range tc.output {
+				_, err := wr.Write([]byte(o))
+				require.NoError(t, err)
+			}
+			// the watcher only reports the first commit
+			_, err := wr.Write([]byte("INF committed state height=51\n"))
+			require.NoError(t, err)
+
+			require.True(t, w.Committed())
+			if tc.committed {
+				require.Equal(t, 1, calls)
+			}
+			require.Contains(t, out.String(), tc.output[0])
+		})
+	}
+}
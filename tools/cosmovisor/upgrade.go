@@ -18,7 +18,11 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 	// simplest case is to switch the link
 	err := plan.EnsureBinary(cfg.UpgradeBin(p.Name))
 	if err == nil {
-		// we have the binary - do it
+		// we have the binary - verify it if it was added with a batch and do it
+		if err := verifyPlanBinary(cfg, p); err != nil {
+			return fmt.Errorf("upgrade binary verification failed: %w", err)
+		}
+
 		return cfg.SetCurrentUpgrade(p)
 	}
 
@@ -14,6 +14,11 @@ type PrepareCheckStater func(ctx Context)
 // Precommiter runs code during commit immediately before the `deliverState` is written to the `rootMultiStore`.
 type Precommiter func(ctx Context)
 
+// MsgEventHandler handles the events emitted by a message, after the message
+// is executed and with its context. It returns the events emitted while
+// handling them, which are added to the events of the message.
+type MsgEventHandler func(ctx Context, events []abci.Event) ([]abci.Event, error)
+
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) *abci.ResponseQuery
 
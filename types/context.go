@@ -64,6 +64,15 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.BlockInfo
 	headerInfo           header.Info
+	storeGasTracker      StoreGasTracker
+}
+
+// StoreGasTracker tracks the gas consumed by the accesses to the stores of a
+// Context, by store.
+type StoreGasTracker interface {
+	// TrackStoreGas is called with the name of the store key whenever gas is
+	// consumed by an access to the store.
+	TrackStoreGas(storeName string, gas storetypes.Gas)
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -91,6 +100,7 @@ func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.trans
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 func (c Context) CometInfo() comet.BlockInfo                    { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) StoreGasTracker() StoreGasTracker              { return c.storeGasTracker }
 
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
@@ -310,6 +320,12 @@ func (c Context) WithHeaderInfo(headerInfo header.Info) Context {
 	return c
 }
 
+// WithStoreGasTracker returns a Context with an updated store gas tracker
+func (c Context) WithStoreGasTracker(tracker StoreGasTracker) Context {
+	c.storeGasTracker = tracker
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -334,12 +350,37 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.transientKVGasConfig)
+}
+
+// storeGasMeter returns the gas meter of the accesses to the store of the
+// given key, reporting the gas consumed to the store gas tracker if any.
+func (c Context) storeGasMeter(key storetypes.StoreKey) storetypes.GasMeter {
+	if c.storeGasTracker == nil {
+		return c.gasMeter
+	}
+
+	return trackedGasMeter{GasMeter: c.gasMeter, tracker: c.storeGasTracker, storeName: key.Name()}
+}
+
+// trackedGasMeter is a GasMeter reporting the gas consumed by the accesses to
+// a store to a StoreGasTracker.
+type trackedGasMeter struct {
+	storetypes.GasMeter
+	tracker   StoreGasTracker
+	storeName string
+}
+
+// ConsumeGas implements the GasMeter interface. The gas is reported to the
+// tracker before being consumed, as consuming it panics if it exceeds the limit.
+func (m trackedGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.tracker.TrackStoreGas(m.storeName, amount)
+	m.GasMeter.ConsumeGas(amount, descriptor)
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -228,6 +228,38 @@ func (s *contextTestSuite) TestContextHeaderClone() {
 	}
 }
 
+type storeGasTracker map[string]storetypes.Gas
+
+func (t storeGasTracker) TrackStoreGas(storeName string, gas storetypes.Gas) {
+	t[storeName] += gas
+}
+
+func (s *contextTestSuite) TestStoreGasTracker() {
+	key := storetypes.NewKVStoreKey(s.T().Name() + "_key")
+	tkey := storetypes.NewTransientStoreKey(s.T().Name() + "_tkey")
+	ctx := testutil.DefaultContext(key, tkey).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	s.Require().Nil(ctx.StoreGasTracker())
+
+	tracker := storeGasTracker{}
+	ctx = ctx.WithStoreGasTracker(tracker)
+	s.Require().Equal(tracker, ctx.StoreGasTracker())
+
+	ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+	kvGas := ctx.GasMeter().GasConsumed()
+	ctx.TransientStore(tkey).Get([]byte("key"))
+	ctx.GasMeter().ConsumeGas(10, "not a store access")
+
+	s.Require().Equal(storeGasTracker{
+		key.Name():  kvGas,
+		tkey.Name(): ctx.GasMeter().GasConsumed() - kvGas - 10,
+	}, tracker)
+
+	// the tracker is kept when the gas meter is replaced
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(key).Get([]byte("key"))
+	s.Require().Equal(kvGas+ctx.GasMeter().GasConsumed(), tracker[key.Name()])
+}
+
 func (s *contextTestSuite) TestUnwrapSDKContext() {
 	sdkCtx := types.NewContext(nil, cmtproto.Header{}, false, nil)
 	ctx := types.WrapSDKContext(sdkCtx)
@@ -3,4 +3,11 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// GRPCQueryGasLimitHeader is the gRPC header a client may set to request a
+	// gas limit for its query lower than the one configured on the node.
+	GRPCQueryGasLimitHeader = "x-cosmos-query-gas-limit"
+
+	// GRPCQueryGasUsedHeader is the gRPC header for the gas consumed by a query.
+	GRPCQueryGasUsedHeader = "x-cosmos-query-gas-used"
 )
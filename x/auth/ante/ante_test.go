@@ -15,15 +15,20 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -1196,16 +1201,15 @@ func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []cryptoty
 	pubkeys = make([]cryptotypes.PubKey, n)
 	signatures = make([][]byte, n)
 	for i := 0; i < n; i++ {
-		var privkey cryptotypes.PrivKey = secp256k1.GenPrivKey()
-
-		// TODO: also generate ed25519 keys as below when ed25519 keys are
-		//  actually supported, https://github.com/cosmos/cosmos-sdk/issues/4789
-		// for now this fails:
-		// if rand.Int63()%2 == 0 {
-		//	privkey = ed25519.GenPrivKey()
-		// } else {
-		//	privkey = secp256k1.GenPrivKey()
-		//}
+		var privkey cryptotypes.PrivKey
+		switch i % 3 {
+		case 0:
+			privkey = secp256k1.GenPrivKey()
+		case 1:
+			privkey = ed25519.GenPrivKey()
+		default:
+			privkey, _ = secp256r1.GenPrivKey()
+		}
 
 		pubkeys[i] = privkey.PubKey()
 		signatures[i], _ = privkey.Sign(msg)
@@ -1222,6 +1226,8 @@ func expectedGasCostByKeys(pubkeys []cryptotypes.PubKey) uint64 {
 			cost += authtypes.DefaultParams().SigVerifyCostED25519
 		case strings.Contains(pubkeyType, "secp256k1"):
 			cost += authtypes.DefaultParams().SigVerifyCostSecp256k1
+		case strings.Contains(pubkeyType, "secp256r1"):
+			cost += authtypes.DefaultParams().SigVerifyCostSecp256r1()
 		default:
 			panic("unexpected key type")
 		}
@@ -1229,6 +1235,39 @@ func expectedGasCostByKeys(pubkeys []cryptotypes.PubKey) uint64 {
 	return cost
 }
 
+func TestAnteHandlerAccountKeyTypes(t *testing.T) {
+	skR1, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	skBls, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+
+	for _, priv := range []cryptotypes.PrivKey{secp256k1.GenPrivKey(), skR1, ed25519.GenPrivKey(), skBls} {
+		t.Run(priv.Type(), func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			addr := sdk.AccAddress(priv.PubKey().Address())
+			acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+			acc.SetAccountNumber(1000)
+			suite.accountKeeper.SetAccount(suite.ctx, acc)
+			suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			args := TestCaseArgs{
+				msgs: []sdk.Msg{testdata.NewTestMsg(addr)},
+			}.WithAccountsInfo([]TestAccount{{acc, priv}})
+			args.chainID = suite.ctx.ChainID()
+			args.feeAmount = testdata.NewTestFeeAmount()
+			args.gasLimit = testdata.NewTestGasLimit()
+
+			suite.RunTestCase(t, TestCase{desc: priv.Type(), expPass: true}, args)
+
+			// the public key is set by the first transaction
+			acc = suite.accountKeeper.GetAccount(suite.ctx, addr)
+			require.True(t, priv.PubKey().Equals(acc.GetPubKey()))
+		})
+	}
+}
+
 func TestCountSubkeys(t *testing.T) {
 	genPubKeys := func(n int) []cryptotypes.PubKey {
 		var ret []cryptotypes.PubKey
@@ -1244,6 +1283,14 @@ func TestCountSubkeys(t *testing.T) {
 	multiLevelMultiKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
 		multiLevelSubKey1, multiLevelSubKey2, secp256k1.GenPrivKey().PubKey(),
 	})
+	blsPubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range blsPubKeys {
+		blsPrivKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		blsPubKeys[i] = blsPrivKey.PubKey()
+	}
+	aggregatedKey, err := bls12_381.NewAggregatedPubKey(2, blsPubKeys)
+	require.NoError(t, err)
 	type args struct {
 		pub cryptotypes.PubKey
 	}
@@ -1255,6 +1302,7 @@ func TestCountSubkeys(t *testing.T) {
 		{"single key", args{singleKey}, 1},
 		{"single level multikey", args{singleLevelMultiKey}, 5},
 		{"multi level multikey", args{multiLevelMultiKey}, 11},
+		{"aggregated key", args{aggregatedKey}, 1},
 		{"nil key", args{nil}, 0},
 	}
 	for _, tc := range testCases {
@@ -1365,6 +1413,78 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	}
 }
 
+func TestAnteHandlerAggregatedPubKeySigLimit(t *testing.T) {
+	testCases := []struct {
+		name    string
+		numKeys int
+		expErr  error
+	}{
+		// an aggregated key is verified with a single signature, it counts as one signature
+		{"keys within the signature limit", int(authtypes.DefaultTxSigLimit), nil},
+		// but its keys are bounded by the signature limit
+		{"keys above the signature limit", int(authtypes.DefaultTxSigLimit) + 1, sdkerrors.ErrTooManySignatures},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			privs := make([]*bls12_381.PrivKey, tc.numKeys)
+			pubKeys := make([]cryptotypes.PubKey, tc.numKeys)
+			for i := range privs {
+				priv, err := bls12_381.GenPrivKey()
+				require.NoError(t, err)
+				privs[i], pubKeys[i] = priv, priv.PubKey()
+			}
+			aggregatedKey, err := bls12_381.NewAggregatedPubKey(tc.numKeys, pubKeys)
+			require.NoError(t, err)
+
+			addr := sdk.AccAddress(aggregatedKey.Address())
+			acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+			acc.SetAccountNumber(1000)
+			suite.accountKeeper.SetAccount(suite.ctx, acc)
+			suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			signerData := xauthsigning.SignerData{
+				Address:       addr.String(),
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+				PubKey:        aggregatedKey,
+			}
+			multisignature := multisig.NewMultisig(tc.numKeys)
+			for _, priv := range privs {
+				sigV2, err := tx.SignWithPrivKey(
+					suite.ctx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData,
+					suite.txBuilder, priv, suite.clientCtx.TxConfig, acc.GetSequence())
+				require.NoError(t, err)
+				require.NoError(t, multisig.AddSignatureV2(multisignature, sigV2, pubKeys))
+			}
+			aggregatedSignature, err := aggregatedKey.AggregateSignatures(multisignature)
+			require.NoError(t, err)
+			require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   aggregatedKey,
+				Data:     aggregatedSignature,
+				Sequence: acc.GetSequence(),
+			}))
+
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(suite.txBuilder.GetTx())
+			require.NoError(t, err)
+			_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), suite.txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAnteHandlerReCheck(t *testing.T) {
 	suite := SetupTestSuite(t, false)
 	// Set recheck=true
@@ -15,8 +15,8 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -412,6 +412,10 @@ func (vscd ValidateSigCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 
 	sigCount := 0
 	for _, pk := range pubKeys {
+		if err := validateAggregatedPubKeys(pk, params.TxSigLimit); err != nil {
+			return ctx, err
+		}
+
 		sigCount += CountSubKeys(pk)
 		if uint64(sigCount) > params.TxSigLimit {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrTooManySignatures,
@@ -432,7 +436,7 @@ func DefaultSigVerificationGasConsumer(
 	switch pubkey := pubkey.(type) {
 	case *ed25519.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+		return nil
 
 	case *secp256k1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
@@ -442,6 +446,20 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12_381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBls12381(), "ante verify: bls12_381")
+		return nil
+
+	case *bls12_381.AggregatedPubKey:
+		if _, ok := sig.Data.(*signing.MultiSignatureData); !ok {
+			return fmt.Errorf("expected %T, got, %T", &signing.MultiSignatureData{}, sig.Data)
+		}
+		meter.ConsumeGas(params.SigVerifyCostBls12381(), "ante verify: bls12_381 aggregated")
+		for range pubkey.PubKeys {
+			meter.ConsumeGas(params.SigVerifyCostBls12381Aggregation(), "ante verify: bls12_381 aggregation")
+		}
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -495,14 +513,43 @@ func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (sdk.A
 	return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
 }
 
+// validateAggregatedPubKeys returns an error if an aggregated bls12_381 public key,
+// or an aggregated sub key of a multisig public key, has more keys than the limit.
+// An aggregated public key counts as a single signature, so its keys are bounded
+// separately.
+func validateAggregatedPubKeys(pub cryptotypes.PubKey, limit uint64) error {
+	switch pub := pub.(type) {
+	case *bls12_381.AggregatedPubKey:
+		if uint64(len(pub.PubKeys)) > limit {
+			return errorsmod.Wrapf(sdkerrors.ErrTooManySignatures,
+				"aggregated public key keys: %d, limit: %d", len(pub.PubKeys), limit)
+		}
+
+	case multisig.PubKey:
+		for _, subkey := range pub.GetPubKeys() {
+			if err := validateAggregatedPubKeys(subkey, limit); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 // A non-multisig, i.e. a regular signature, it naturally a count of 1. If it is a multisig,
 // then it recursively calls it on its pubkeys.
+// An aggregated bls12_381 multisig is verified with a single signature, whose cost
+// already accounts for its keys, so it counts as 1. Its keys are bounded by
+// ValidateSigCountDecorator.
 func CountSubKeys(pub cryptotypes.PubKey) int {
 	if pub == nil {
 		return 0
 	}
-	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
+	if _, ok := pub.(*bls12_381.AggregatedPubKey); ok {
+		return 1
+	}
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
@@ -12,6 +12,7 @@ import (
 	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -91,6 +92,37 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	skBls, _ := bls12_381.GenPrivKey()
+	aggregatedPrivKeys := make([]*bls12_381.PrivKey, 3)
+	aggregatedPubKeys := make([]cryptotypes.PubKey, 3)
+	for i := range aggregatedPrivKeys {
+		aggregatedPrivKeys[i], _ = bls12_381.GenPrivKey()
+		aggregatedPubKeys[i] = aggregatedPrivKeys[i].PubKey()
+	}
+	aggregatedKey, err := bls12_381.NewAggregatedPubKey(2, aggregatedPubKeys)
+	require.NoError(t, err)
+	aggregatedSignature := multisig.NewMultisig(len(aggregatedPubKeys))
+	for _, i := range []int{0, 2} {
+		sig, err := aggregatedPrivKeys[i].Sign(msg)
+		require.NoError(t, err)
+		aggregatedSignature.BitArray.SetIndex(i, true)
+		aggregatedSignature.Signatures = append(aggregatedSignature.Signatures, &signing.SingleSignatureData{Signature: sig})
+	}
+	aggregatedSignature, err = aggregatedKey.AggregateSignatures(aggregatedSignature)
+	require.NoError(t, err)
+
+	// every key of an aggregated key costs at least a secp256k1 verification, signer or not
+	largePubKeys := make([]cryptotypes.PubKey, 100)
+	for i := range largePubKeys {
+		sk, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		largePubKeys[i] = sk.PubKey()
+	}
+	largeAggregatedKey, err := bls12_381.NewAggregatedPubKey(1, largePubKeys)
+	require.NoError(t, err)
+	largeAggregatedSignature := multisig.NewMultisig(len(largePubKeys))
+	largeAggregatedSignature.BitArray.SetIndex(0, true)
+
 	type args struct {
 		meter  storetypes.GasMeter
 		sig    signing.SignatureData
@@ -103,10 +135,14 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		gasConsumed uint64
 		shouldErr   bool
 	}{
-		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
+		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyBls12_381", args{storetypes.NewInfiniteGasMeter(), nil, skBls.PubKey(), params}, p.SigVerifyCostBls12381(), false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"AggregatedPubKeyBls12_381", args{storetypes.NewInfiniteGasMeter(), aggregatedSignature, aggregatedKey, params}, p.SigVerifyCostBls12381() + 3*p.SigVerifyCostBls12381Aggregation(), false},
+		{"AggregatedPubKeyBls12_381 with many keys", args{storetypes.NewInfiniteGasMeter(), largeAggregatedSignature, largeAggregatedKey, params}, p.SigVerifyCostBls12381() + 100*p.SigVerifyCostSecp256k1, false},
+		{"AggregatedPubKeyBls12_381 without multisignature", args{storetypes.NewInfiniteGasMeter(), nil, aggregatedKey, params}, 0, true},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
@@ -17,7 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -94,7 +94,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 		if err != nil {
 			return err
 		}
-		pubKey, err := k.GetPubKey()
+		multisigPub, err := getMultisigPubKey(k)
 		if err != nil {
 			return err
 		}
@@ -104,8 +104,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			return err
 		}
 
-		multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 		if !clientCtx.Offline {
 			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
 			if err != nil {
@@ -161,6 +160,11 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			}
 		}
 
+		multisigSig, err = aggregateMultisig(multisigPub, multisigSig)
+		if err != nil {
+			return err
+		}
+
 		sigV2 := signingtypes.SignatureV2{
 			PubKey:   multisigPub,
 			Data:     multisigSig,
@@ -306,12 +310,11 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				return err
 			}
-			pubKey, err := k.GetPubKey()
+			multisigPub, err := getMultisigPubKey(k)
 			if err != nil {
 				return err
 			}
-			multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-			multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+			multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 
 			anyPk, err := codectypes.NewAnyWithValue(multisigPub)
 			if err != nil {
@@ -321,7 +324,7 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 				ChainID:       txFactory.ChainID(),
 				AccountNumber: txFactory.AccountNumber(),
 				Sequence:      txFactory.Sequence(),
-				Address:       sdk.AccAddress(pubKey.Address()).String(),
+				Address:       sdk.AccAddress(multisigPub.Address()).String(),
 				PubKey: &anypb.Any{
 					TypeUrl: anyPk.TypeUrl,
 					Value:   anyPk.Value,
@@ -347,6 +350,11 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 				}
 			}
 
+			multisigSig, err = aggregateMultisig(multisigPub, multisigSig)
+			if err != nil {
+				return err
+			}
+
 			sigV2 := signingtypes.SignatureV2{
 				PubKey:   multisigPub,
 				Data:     multisigSig,
@@ -418,3 +426,29 @@ func getMultisigRecord(clientCtx client.Context, name string) (*keyring.Record,
 
 	return multisigRecord, nil
 }
+
+// getMultisigPubKey returns the multisig public key of the record.
+func getMultisigPubKey(record *keyring.Record) (multisig.PubKey, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	multisigPub, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a multisig key", record.Name)
+	}
+
+	return multisigPub, nil
+}
+
+// aggregateMultisig returns the multisignature to set in the transaction: the
+// signatures are aggregated into one when the multisig public key verifies an
+// aggregated signature.
+func aggregateMultisig(pubKey multisig.PubKey, sig *signingtypes.MultiSignatureData) (*signingtypes.MultiSignatureData, error) {
+	if aggregated, ok := pubKey.(*bls12_381.AggregatedPubKey); ok {
+		return aggregated.AggregateSignatures(sig)
+	}
+
+	return sig, nil
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
@@ -360,11 +359,10 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx
 		if err != nil {
 			return err
 		}
-		multisigPubKey, err := multisigkey.GetPubKey()
+		multisigPub, err := getMultisigPubKey(multisigkey)
 		if err != nil {
 			return err
 		}
-		multisigLegacyPub := multisigPubKey.(*kmultisig.LegacyAminoPubKey)
 
 		fromRecord, err := clientCtx.Keyring.Key(fromName)
 		if err != nil {
@@ -376,7 +374,7 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx
 		}
 
 		var found bool
-		for _, pubkey := range multisigLegacyPub.GetPubKeys() {
+		for _, pubkey := range multisigPub.GetPubKeys() {
 			if pubkey.Equals(fromPubKey) {
 				found = true
 			}
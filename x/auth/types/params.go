@@ -47,6 +47,23 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBls12381 returns gas fee of bls12_381 signature verification.
+// The verification computes two pairings, it is about 3x slower than the
+// verification of a secp256k1 signature.
+func (p Params) SigVerifyCostBls12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 3
+}
+
+// SigVerifyCostBls12381Aggregation returns gas fee of the aggregation of each
+// public key of a bls12_381 aggregated public key, which is charged on top of
+// the verification of the aggregated signature. Each key is hashed into its
+// coefficient, decompressed, checked to be in the subgroup and multiplied by
+// its coefficient, which costs as much as the verification of a secp256k1
+// signature.
+func (p Params) SigVerifyCostBls12381Aggregation() uint64 {
+	return p.SigVerifyCostSecp256k1
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -10,7 +10,9 @@ import (
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
-	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+	// IsMsgAllowed returns false if the message is disabled, either for all
+	// senders or because it is in the scope of a tripped circuit breaker.
+	IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error)
 }
 
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
@@ -25,9 +27,14 @@ func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
 }
 
 func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the messages are checked again by the msg service router when executed,
+	// so the state changes of the check (e.g. the usage of a rate limit) are
+	// discarded here to not count the messages twice
+	cacheCtx, _ := ctx.CacheContext()
+
 	// loop through all the messages and check if the message type is allowed
 	for _, msg := range tx.GetMsgs() {
-		isAllowed, err := cbd.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+		isAllowed, err := cbd.circuitKeeper.IsMsgAllowed(cacheCtx, msg)
 		if err != nil {
 			return ctx, err
 		}
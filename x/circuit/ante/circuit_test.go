@@ -35,8 +35,8 @@ type MockCircuitBreaker struct {
 	isAllowed bool
 }
 
-func (m MockCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
-	return typeURL == "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker", nil
+func (m MockCircuitBreaker) IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	return sdk.MsgTypeURL(msg) == "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker", nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -93,3 +93,32 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+type countingCircuitBreaker struct {
+	storeKey storetypes.StoreKey
+}
+
+func (m countingCircuitBreaker) IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(m.storeKey).Set([]byte("count"), []byte{1})
+	return true, nil
+}
+
+func TestCircuitBreakerDecoratorDiscardsState(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	decorator := ante.NewCircuitBreakerDecorator(countingCircuitBreaker{storeKey: f.mockStoreKey})
+
+	require.NoError(t, f.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	_, err := decorator.AnteHandle(sdkCtx, f.txBuilder.GetTx(), false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+
+	// the messages are checked again when executed, so the state changes of
+	// the check are not written
+	require.False(t, sdkCtx.KVStore(f.mockStoreKey).Has([]byte("count")))
+}
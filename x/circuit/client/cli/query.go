@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetDisabeListCmd(),
 		GetAccountCmd(),
 		GetAccountsCmd(),
+		GetCircuitBreakersCmd(),
+		GetCircuitBreakerCmd(),
 	)
 
 	return cmd
@@ -117,3 +119,63 @@ func GetAccountsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetCircuitBreakersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breakers",
+		Short: "Query for all scoped circuit breakers",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CircuitBreakers(cmd.Context(), &types.QueryCircuitBreakersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "circuit-breakers")
+
+	return cmd
+}
+
+func GetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker [type_url]",
+		Short: "Query for the scoped circuit breaker of a message type, and the usage of its rate limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CircuitBreaker(cmd.Context(), &types.QueryCircuitBreakerRequest{MsgTypeUrl: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
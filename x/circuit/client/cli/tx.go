@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -15,6 +16,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// Trip circuit breaker flags
+const (
+	FlagSenders         = "senders"
+	FlagMinAmount       = "min-amount"
+	FlagExpiryHeight    = "expiry-height"
+	FlagExpiryTime      = "expiry-time"
+	FlagRateLimitMsgs   = "rate-limit-msgs"
+	FlagRateLimitBlocks = "rate-limit-blocks"
+)
+
 // NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -28,6 +39,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		AuthorizeCircuitBreakerCmd(),
 		TripCircuitBreakerCmd(),
+		ResetCircuitBreakerCmd(),
 	)
 
 	return txCmd
@@ -81,10 +93,16 @@ func AuthorizeCircuitBreakerCmd() *cobra.Command {
 // TripCircuitBreakerCmd returns a CLI command handler for creating a MsgTripCircuitBreaker transaction.
 func TripCircuitBreakerCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "disable [type_url]",
-		Short:   "disable a message from being executed",
-		Example: fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
-		Args:    cobra.ExactArgs(1),
+		Use:   "disable [type_url]",
+		Short: "disable a message from being executed",
+		Long: `Disable a message from being executed. The scope of the circuit breaker can be
+restricted to the messages of some senders, or to the messages transferring at least
+an amount. The circuit breaker can expire at a height or a time, and it can rate-limit
+the messages in its scope instead of disabling them.`,
+		Example: fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"
+%s circuit disable "cosmos.bank.v1beta1.MsgSend" --min-amount 1000000stake --expiry-height 1000
+%s circuit disable "cosmos.bank.v1beta1.MsgSend" --rate-limit-msgs 100 --rate-limit-blocks 10`, version.AppName, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -93,10 +111,60 @@ func TripCircuitBreakerCmd() *cobra.Command {
 
 			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","))
 
+			senders, err := cmd.Flags().GetStringSlice(FlagSenders)
+			if err != nil {
+				return err
+			}
+			msg.Senders = senders
+
+			minAmount, err := cmd.Flags().GetString(FlagMinAmount)
+			if err != nil {
+				return err
+			}
+			if minAmount != "" {
+				if msg.MinAmount, err = sdk.ParseCoinsNormalized(minAmount); err != nil {
+					return err
+				}
+			}
+
+			if msg.ExpiryHeight, err = cmd.Flags().GetInt64(FlagExpiryHeight); err != nil {
+				return err
+			}
+
+			expiryTime, err := cmd.Flags().GetString(FlagExpiryTime)
+			if err != nil {
+				return err
+			}
+			if expiryTime != "" {
+				t, err := time.Parse(time.RFC3339, expiryTime)
+				if err != nil {
+					return err
+				}
+				msg.ExpiryTime = &t
+			}
+
+			maxMsgs, err := cmd.Flags().GetUint64(FlagRateLimitMsgs)
+			if err != nil {
+				return err
+			}
+			windowBlocks, err := cmd.Flags().GetUint64(FlagRateLimitBlocks)
+			if err != nil {
+				return err
+			}
+			if maxMsgs != 0 || windowBlocks != 0 {
+				msg.RateLimit = &types.RateLimit{MaxMsgs: maxMsgs, WindowBlocks: windowBlocks}
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagSenders, nil, "Restrict the circuit breaker to the messages of these senders")
+	cmd.Flags().String(FlagMinAmount, "", "Restrict the circuit breaker to the messages transferring at least this amount of one of its denoms")
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Height at which the circuit breaker is reset")
+	cmd.Flags().String(FlagExpiryTime, "", "Time at which the circuit breaker is reset, in RFC3339 format")
+	cmd.Flags().Uint64(FlagRateLimitMsgs, 0, "Maximum number of messages executed in a window, instead of disabling them")
+	cmd.Flags().Uint64(FlagRateLimitBlocks, 0, "Number of blocks of a rate limit window")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	context "context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsMsgAllowed returns false if the message is disabled, either because its
+// URL is disabled for all senders, or because it is in the scope of a circuit
+// breaker. If the circuit breaker has a rate limit, the message is allowed
+// until the limit is reached, and counts towards it outside of CheckTx.
+func (k *Keeper) IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	msgURL := sdk.MsgTypeURL(msg)
+	disabled, err := k.DisableList.Has(ctx, msgURL)
+	if err != nil || disabled {
+		return !disabled, err
+	}
+
+	breaker, found, err := k.getCircuitBreaker(ctx, msgURL)
+	if err != nil || !found {
+		return true, err
+	}
+
+	if breaker.IsScoped() {
+		applies, err := k.appliesTo(breaker, msg)
+		if err != nil || !applies {
+			return true, err
+		}
+	}
+
+	if breaker.RateLimit == nil {
+		return false, nil
+	}
+
+	return k.consumeRateLimit(ctx, breaker)
+}
+
+// getCircuitBreaker returns the circuit breaker of the message URL, ignoring
+// the expired circuit breakers which are not reset yet.
+func (k *Keeper) getCircuitBreaker(ctx context.Context, msgURL string) (types.CircuitBreaker, bool, error) {
+	breaker, err := k.CircuitBreakers.Get(ctx, msgURL)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CircuitBreaker{}, false, nil
+	}
+	if err != nil {
+		return types.CircuitBreaker{}, false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if breaker.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return types.CircuitBreaker{}, false, nil
+	}
+
+	return breaker, true, nil
+}
+
+// appliesTo returns true if the message is in the scope of the circuit
+// breaker.
+func (k *Keeper) appliesTo(breaker types.CircuitBreaker, msg sdk.Msg) (bool, error) {
+	var signers []string
+	if len(breaker.Senders) > 0 {
+		signerBzs, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return false, err
+		}
+
+		for _, bz := range signerBzs {
+			signer, err := k.addressCodec.BytesToString(bz)
+			if err != nil {
+				return false, err
+			}
+			signers = append(signers, signer)
+		}
+	}
+
+	amount, _ := types.MsgAmount(msg)
+
+	return breaker.AppliesTo(signers, amount), nil
+}
+
+// consumeRateLimit returns true if the rate limit of the circuit breaker is
+// not reached in the current window, and counts the message towards it.
+func (k *Keeper) consumeRateLimit(ctx context.Context, breaker types.CircuitBreaker) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	usage, err := k.RateLimitUsage.Get(ctx, breaker.MsgTypeUrl)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+
+	if errors.Is(err, collections.ErrNotFound) || height >= usage.WindowStartHeight+int64(breaker.RateLimit.WindowBlocks) {
+		usage = types.RateLimitUsage{WindowStartHeight: height}
+	}
+
+	if usage.Count >= breaker.RateLimit.MaxMsgs {
+		return false, nil
+	}
+
+	// the check state is discarded at commit, so that only the executed
+	// messages count towards the rate limit
+	if sdkCtx.IsCheckTx() || sdkCtx.IsReCheckTx() {
+		return true, nil
+	}
+
+	usage.Count++
+
+	return true, k.RateLimitUsage.Set(ctx, breaker.MsgTypeUrl, usage)
+}
+
+// ResetExpiredCircuitBreakers resets the circuit breakers which expired at
+// the current block.
+func (k *Keeper) ResetExpiredCircuitBreakers(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var expired []string
+	err := k.CircuitBreakers.Walk(ctx, nil, func(msgURL string, breaker types.CircuitBreaker) (bool, error) {
+		if breaker.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			expired = append(expired, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	for _, msgURL := range expired {
+		if err := k.removeCircuitBreaker(ctx, msgURL); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"expire_circuit_breaker",
+				sdk.NewAttribute("msg_url", msgURL),
+			),
+		)
+	}
+
+	return nil
+}
+
+// removeCircuitBreaker removes the circuit breaker of the message URL, and the
+// usage of its rate limit.
+func (k *Keeper) removeCircuitBreaker(ctx context.Context, msgURL string) error {
+	if err := k.CircuitBreakers.Remove(ctx, msgURL); err != nil {
+		return err
+	}
+
+	return k.RateLimitUsage.Remove(ctx, msgURL)
+}
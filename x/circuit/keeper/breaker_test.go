@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func newMsgSend(from string, amount int64) *banktypes.MsgSend {
+	return &banktypes.MsgSend{
+		FromAddress: from,
+		ToAddress:   addresses[4],
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+	}
+}
+
+func TestIsMsgAllowedScopes(t *testing.T) {
+	ft := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockHeight(10)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	// the messages of some senders above an amount are disabled
+	trip := types.NewMsgTripCircuitBreaker(authority, []string{sendURL})
+	trip.Senders = []string{addresses[1], addresses[2]}
+	trip.MinAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.NoError(t, err)
+
+	// the msg type url is not disabled for all senders
+	allowed, err := ft.keeper.IsAllowed(ctx, sendURL)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		allowed bool
+	}{
+		{"sender above the amount", newMsgSend(addresses[1], 100), false},
+		{"other sender above the amount", newMsgSend(addresses[2], 1000), false},
+		{"sender below the amount", newMsgSend(addresses[1], 99), true},
+		{"sender with another denom", &banktypes.MsgSend{FromAddress: addresses[1], ToAddress: addresses[4], Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))}, true},
+		{"other sender", newMsgSend(addresses[3], 1000), true},
+		{"other message", &stakingtypes.MsgDelegate{DelegatorAddress: addresses[1], Amount: sdk.NewInt64Coin("stake", 1000)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, err := ft.keeper.IsMsgAllowed(ctx, tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, allowed)
+		})
+	}
+
+	// the circuit breaker is reset with the msg type url
+	_, err = srv.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(authority, []string{sendURL}))
+	require.NoError(t, err)
+
+	allowed, err = ft.keeper.IsMsgAllowed(ctx, newMsgSend(addresses[1], 1000))
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestIsMsgAllowedExpiry(t *testing.T) {
+	ft := initFixture(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockHeight(10).WithBlockTime(now)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	delegateURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	// the expiry must be in the future
+	trip := types.NewMsgTripCircuitBreaker(authority, []string{sendURL})
+	trip.ExpiryHeight = 10
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.ErrorContains(t, err, "must be after the current height")
+
+	trip.ExpiryHeight = 0
+	trip.ExpiryTime = &now
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.ErrorContains(t, err, "must be after the current block time")
+
+	// the messages are disabled for everyone until the expiry
+	trip.ExpiryHeight = 20
+	trip.ExpiryTime = nil
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.NoError(t, err)
+
+	expiryTime := now.Add(time.Hour)
+	trip = types.NewMsgTripCircuitBreaker(authority, []string{delegateURL})
+	trip.ExpiryTime = &expiryTime
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.NoError(t, err)
+
+	for _, url := range []string{sendURL, delegateURL} {
+		allowed, err := ft.keeper.IsAllowed(ctx, url)
+		require.NoError(t, err)
+		require.False(t, allowed)
+	}
+
+	allowed, err := ft.keeper.IsMsgAllowed(ctx, newMsgSend(addresses[1], 1))
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// the circuit breaker is ignored once expired, and reset at the next block
+	ctx = ctx.WithBlockHeight(20)
+	allowed, err = ft.keeper.IsAllowed(ctx, sendURL)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, ft.keeper.ResetExpiredCircuitBreakers(ctx))
+	has, err := ft.keeper.CircuitBreakers.Has(ctx, sendURL)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = ft.keeper.CircuitBreakers.Has(ctx, delegateURL)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	ctx = ctx.WithBlockTime(expiryTime)
+	require.NoError(t, ft.keeper.ResetExpiredCircuitBreakers(ctx))
+	has, err = ft.keeper.CircuitBreakers.Has(ctx, delegateURL)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestIsMsgAllowedRateLimit(t *testing.T) {
+	ft := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockHeight(10)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	// the rate limit must be valid
+	trip := types.NewMsgTripCircuitBreaker(authority, []string{sendURL})
+	trip.RateLimit = &types.RateLimit{MaxMsgs: 2}
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.ErrorContains(t, err, "window blocks must be positive")
+
+	// two messages are executed every five blocks
+	trip.RateLimit.WindowBlocks = 5
+	_, err = srv.TripCircuitBreaker(ctx, trip)
+	require.NoError(t, err)
+
+	allowed, err := ft.keeper.IsAllowed(ctx, sendURL)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// the messages of CheckTx do not count towards the rate limit
+	checkCtx := ctx.WithIsCheckTx(true)
+	for i := 0; i < 3; i++ {
+		allowed, err = ft.keeper.IsMsgAllowed(checkCtx, newMsgSend(addresses[1], 1))
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	for i, expAllowed := range []bool{true, true, false} {
+		allowed, err = ft.keeper.IsMsgAllowed(ctx, newMsgSend(addresses[1], 1))
+		require.NoError(t, err)
+		require.Equal(t, expAllowed, allowed, "message %d", i)
+	}
+
+	// the rate limit is reached for CheckTx as well
+	allowed, err = ft.keeper.IsMsgAllowed(checkCtx, newMsgSend(addresses[1], 1))
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	qs := keeper.NewQueryServer(ft.keeper)
+	res, err := qs.CircuitBreaker(ctx, &types.QueryCircuitBreakerRequest{MsgTypeUrl: sendURL})
+	require.NoError(t, err)
+	require.Equal(t, &types.RateLimitUsage{WindowStartHeight: 10, Count: 2}, res.RateLimitUsage)
+
+	// a new window starts
+	ctx = ctx.WithBlockHeight(15)
+	allowed, err = ft.keeper.IsMsgAllowed(ctx, newMsgSend(addresses[1], 1))
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	res, err = qs.CircuitBreaker(ctx, &types.QueryCircuitBreakerRequest{MsgTypeUrl: sendURL})
+	require.NoError(t, err)
+	require.Equal(t, &types.RateLimitUsage{WindowStartHeight: 15, Count: 1}, res.RateLimitUsage)
+}
+
+func TestResetExpiredCircuitBreakersEmpty(t *testing.T) {
+	ft := initFixture(t)
+	require.NoError(t, ft.keeper.ResetExpiredCircuitBreakers(ft.ctx))
+}
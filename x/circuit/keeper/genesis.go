@@ -39,9 +39,19 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
+	var breakers []types.CircuitBreaker
+	err = k.CircuitBreakers.Walk(ctx, nil, func(_ string, breaker types.CircuitBreaker) (stop bool, err error) {
+		breakers = append(breakers, breaker)
+		return false, nil
+	})
+	if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		CircuitBreakers:    breakers,
 	}
 }
 
@@ -64,4 +74,10 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, breaker := range genState.CircuitBreakers {
+		// Set the scoped circuit breakers
+		if err := k.CircuitBreakers.Set(ctx, breaker.MsgTypeUrl, breaker); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -13,7 +13,7 @@ import (
 
 // Keeper defines the circuit module's keeper.
 type Keeper struct {
-	cdc          codec.BinaryCodec
+	cdc          codec.Codec
 	storeService store.KVStoreService
 
 	authority []byte
@@ -25,10 +25,16 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// CircuitBreakers contains the scoped or expiring circuit breakers, by
+	// message URL
+	CircuitBreakers collections.Map[string, types.CircuitBreaker]
+	// RateLimitUsage contains the usage of the rate limits of the circuit
+	// breakers in their current window, by message URL
+	RateLimitUsage collections.Map[string, types.RateLimitUsage]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authority string, addressCodec address.Codec) Keeper {
+func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, authority string, addressCodec address.Codec) Keeper {
 	auth, err := addressCodec.StringToBytes(authority)
 	if err != nil {
 		panic(err)
@@ -54,6 +60,20 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authori
 			"disable_list",
 			collections.StringKey,
 		),
+		CircuitBreakers: collections.NewMap(
+			sb,
+			types.CircuitBreakerPrefix,
+			"circuit_breakers",
+			collections.StringKey,
+			codec.CollValue[types.CircuitBreaker](cdc),
+		),
+		RateLimitUsage: collections.NewMap(
+			sb,
+			types.RateLimitUsagePrefix,
+			"rate_limit_usage",
+			collections.StringKey,
+			codec.CollValue[types.RateLimitUsage](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -69,7 +89,18 @@ func (k *Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
+// IsAllowed returns false if the messages of the given URL are disabled for
+// all senders, either indefinitely or until the circuit breaker expires.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
-	return !has, err
+	if err != nil || has {
+		return !has, err
+	}
+
+	breaker, found, err := k.getCircuitBreaker(ctx, msgURL)
+	if err != nil || !found {
+		return true, err
+	}
+
+	return breaker.IsScoped(), nil
 }
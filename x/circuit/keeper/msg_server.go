@@ -6,6 +6,8 @@ import (
 	fmt "fmt"
 	"strings"
 
+	"golang.org/x/exp/slices"
+
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
@@ -87,43 +89,46 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	if err := srv.validateScope(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	switch {
 	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
 		for _, msgTypeURL := range msg.MsgTypeUrls {
-			// check if the message is in the list of allowed messages
-			isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+			// check if the message is already disabled
+			isTripped, err := srv.isTripped(ctx, msgTypeURL)
 			if err != nil {
 				return nil, err
 			}
 
-			if !isAllowed {
+			if isTripped {
 				return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
 			}
 
-			if err = srv.DisableList.Set(ctx, msgTypeURL); err != nil {
+			if err = srv.trip(ctx, msg, msgTypeURL); err != nil {
 				return nil, err
 			}
 
 		}
 	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
 		for _, msgTypeURL := range msg.MsgTypeUrls {
-			// check if the message is in the list of allowed messages
-			isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+			// check if the message is already disabled
+			isTripped, err := srv.isTripped(ctx, msgTypeURL)
 			if err != nil {
 				return nil, err
 			}
 
-			if !isAllowed {
+			if isTripped {
 				return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
 			}
-			for _, msgurl := range perms.LimitTypeUrls {
-				if msgTypeURL == msgurl {
-					if err = srv.DisableList.Set(ctx, msgTypeURL); err != nil {
-						return nil, err
-					}
-				} else {
-					return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", msgTypeURL)
-				}
+
+			if !slices.Contains(perms.LimitTypeUrls, msgTypeURL) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", msgTypeURL)
+			}
+
+			if err = srv.trip(ctx, msg, msgTypeURL); err != nil {
+				return nil, err
 			}
 		}
 	default:
@@ -167,18 +172,22 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	if perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || perms.Level == types.Permissions_LEVEL_SOME_MSGS || bytes.Equal(address, srv.GetAuthority()) {
 		// add all msg type urls to the disable list
 		for _, msgTypeURL := range msg.MsgTypeUrls {
-			isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+			isTripped, err := srv.isTripped(ctx, msgTypeURL)
 			if err != nil {
 				return nil, err
 			}
 
-			if isAllowed {
+			if !isTripped {
 				return nil, fmt.Errorf("message %s is not disabled", msgTypeURL)
 			}
 
 			if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 				return nil, err
 			}
+
+			if err = srv.removeCircuitBreaker(ctx, msgTypeURL); err != nil {
+				return nil, err
+			}
 		}
 	} else {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
@@ -200,3 +209,58 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
+
+// validateScope validates the scope and the expiry of the circuit breakers
+// tripped by the message.
+func (srv msgServer) validateScope(ctx context.Context, msg *types.MsgTripCircuitBreaker) error {
+	for _, sender := range msg.Senders {
+		if _, err := srv.addressCodec.StringToBytes(sender); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+		}
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		breaker, _ := msg.CircuitBreakerFor(msgTypeURL)
+		if err := breaker.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= sdkCtx.BlockHeight() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height %d must be after the current height %d", msg.ExpiryHeight, sdkCtx.BlockHeight())
+	}
+	if msg.ExpiryTime != nil && !msg.ExpiryTime.After(sdkCtx.BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry time %s must be after the current block time %s", msg.ExpiryTime, sdkCtx.BlockTime())
+	}
+
+	return nil
+}
+
+// isTripped returns true if a circuit breaker is tripped for the message URL,
+// whatever its scope.
+func (srv msgServer) isTripped(ctx context.Context, msgTypeURL string) (bool, error) {
+	disabled, err := srv.DisableList.Has(ctx, msgTypeURL)
+	if err != nil || disabled {
+		return disabled, err
+	}
+
+	_, found, err := srv.getCircuitBreaker(ctx, msgTypeURL)
+	return found, err
+}
+
+// trip disables the message URL, or trips a circuit breaker for it if the
+// message restricts its scope or sets an expiry.
+func (srv msgServer) trip(ctx context.Context, msg *types.MsgTripCircuitBreaker, msgTypeURL string) error {
+	breaker, ok := msg.CircuitBreakerFor(msgTypeURL)
+	if !ok {
+		return srv.DisableList.Set(ctx, msgTypeURL)
+	}
+
+	// an expired circuit breaker may not be reset yet
+	if err := srv.removeCircuitBreaker(ctx, msgTypeURL); err != nil {
+		return err
+	}
+
+	return srv.CircuitBreakers.Set(ctx, msgTypeURL, breaker)
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/circuit/types"
@@ -78,3 +81,51 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// CircuitBreakers returns the scoped circuit breakers.
+func (qs QueryServer) CircuitBreakers(ctx context.Context, req *types.QueryCircuitBreakersRequest) (*types.QueryCircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[string, types.CircuitBreaker](ctx, qs.keeper.CircuitBreakers, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	breakers := make([]types.CircuitBreaker, 0, len(results))
+	for _, result := range results {
+		breakers = append(breakers, result.Value)
+	}
+
+	return &types.QueryCircuitBreakersResponse{CircuitBreakers: breakers, Pagination: pageRes}, nil
+}
+
+// CircuitBreaker returns the scoped circuit breaker of a message URL, and the
+// usage of its rate limit.
+func (qs QueryServer) CircuitBreaker(ctx context.Context, req *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	if req == nil || req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type url")
+	}
+
+	breaker, err := qs.keeper.CircuitBreakers.Get(ctx, req.MsgTypeUrl)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no circuit breaker for %s", req.MsgTypeUrl)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryCircuitBreakerResponse{CircuitBreaker: breaker}
+	if breaker.RateLimit != nil {
+		usage, err := qs.keeper.RateLimitUsage.Get(ctx, req.MsgTypeUrl)
+		switch {
+		case err == nil:
+			res.RateLimitUsage = &usage
+		case !errorsmod.IsOf(err, collections.ErrNotFound):
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
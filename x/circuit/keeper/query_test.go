@@ -73,3 +73,30 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryCircuitBreakers(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	breakers := []types.CircuitBreaker{
+		{MsgTypeUrl: "url1", ExpiryHeight: 100},
+		{MsgTypeUrl: "url2", Senders: []string{addresses[0]}, RateLimit: &types.RateLimit{MaxMsgs: 1, WindowBlocks: 1}},
+	}
+	for _, breaker := range breakers {
+		require.NoError(t, f.keeper.CircuitBreakers.Set(f.ctx, breaker.MsgTypeUrl, breaker))
+	}
+
+	qs := keeper.NewQueryServer(f.keeper)
+
+	res, err := qs.CircuitBreakers(f.ctx, &types.QueryCircuitBreakersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, breakers, res.CircuitBreakers)
+
+	breaker, err := qs.CircuitBreaker(f.ctx, &types.QueryCircuitBreakerRequest{MsgTypeUrl: "url2"})
+	require.NoError(t, err)
+	require.Equal(t, breakers[1], breaker.CircuitBreaker)
+	require.Nil(t, breaker.RateLimitUsage)
+
+	_, err = qs.CircuitBreaker(f.ctx, &types.QueryCircuitBreakerRequest{MsgTypeUrl: "url3"})
+	require.ErrorContains(t, err, "no circuit breaker for url3")
+}
@@ -34,8 +34,11 @@ import (
 const ConsensusVersion = 1
 
 var (
-	_ module.AppModuleGenesis = AppModule{}
-	_ module.AppModuleBasic   = AppModuleBasic{}
+	_ module.AppModuleGenesis   = AppModule{}
+	_ module.AppModuleBasic     = AppModuleBasic{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+
+	_ baseapp.MsgCircuitBreaker = &keeper.Keeper{}
 )
 
 // AppModuleBasic defines the basic application module used by the circuit module.
@@ -121,6 +124,11 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// BeginBlock resets the circuit breakers which expired.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.ResetExpiredCircuitBreakers(ctx)
+}
+
 // InitGenesis performs genesis initialization for the circuit module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
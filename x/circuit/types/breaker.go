@@ -0,0 +1,135 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// IsScoped returns true if the circuit breaker only applies to some of the
+// Msgs of its type URL.
+func (b CircuitBreaker) IsScoped() bool {
+	return len(b.Senders) > 0 || !b.MinAmount.Empty() || b.RateLimit != nil
+}
+
+// IsExpired returns true if the circuit breaker expired at the given block
+// height and time.
+func (b CircuitBreaker) IsExpired(height int64, blockTime time.Time) bool {
+	if b.ExpiryHeight > 0 && height >= b.ExpiryHeight {
+		return true
+	}
+
+	return b.ExpiryTime != nil && !blockTime.Before(*b.ExpiryTime)
+}
+
+// AppliesTo returns true if a Msg signed by the given signers and transferring
+// the given amount is in the scope of the circuit breaker. The amount is nil
+// if the Msg does not transfer an amount.
+func (b CircuitBreaker) AppliesTo(signers []string, amount sdk.Coins) bool {
+	if len(b.Senders) > 0 && !containsAny(b.Senders, signers) {
+		return false
+	}
+
+	if !b.MinAmount.Empty() && (amount == nil || !amount.IsAnyGTE(b.MinAmount)) {
+		return false
+	}
+
+	return true
+}
+
+// Validate does a basic validation of the circuit breaker.
+func (b CircuitBreaker) Validate() error {
+	if strings.TrimSpace(b.MsgTypeUrl) == "" {
+		return errors.New("circuit breaker msg type url cannot be blank")
+	}
+
+	for _, sender := range b.Senders {
+		if strings.TrimSpace(sender) == "" {
+			return errors.New("circuit breaker sender cannot be blank")
+		}
+	}
+
+	if err := b.MinAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid circuit breaker min amount: %w", err)
+	}
+
+	if b.ExpiryHeight < 0 {
+		return fmt.Errorf("circuit breaker expiry height cannot be negative: %d", b.ExpiryHeight)
+	}
+
+	if b.RateLimit != nil {
+		if b.RateLimit.MaxMsgs == 0 {
+			return errors.New("rate limit max msgs must be positive")
+		}
+		if b.RateLimit.WindowBlocks == 0 {
+			return errors.New("rate limit window blocks must be positive")
+		}
+	}
+
+	return nil
+}
+
+// CircuitBreakerFor returns the circuit breaker tripped by the Msg for the
+// given type URL. It returns false if the Msg disables the type URL for all
+// Msgs, without expiry.
+func (m MsgTripCircuitBreaker) CircuitBreakerFor(msgTypeURL string) (CircuitBreaker, bool) {
+	breaker := CircuitBreaker{
+		MsgTypeUrl:   msgTypeURL,
+		Senders:      m.Senders,
+		MinAmount:    m.MinAmount,
+		ExpiryHeight: m.ExpiryHeight,
+		ExpiryTime:   m.ExpiryTime,
+		RateLimit:    m.RateLimit,
+	}
+
+	return breaker, breaker.IsScoped() || breaker.ExpiryHeight != 0 || breaker.ExpiryTime != nil
+}
+
+// MsgAmount returns the amount transferred by the Msg. It returns false if the
+// amount of the Msg is unknown.
+func MsgAmount(msg sdk.Msg) (sdk.Coins, bool) {
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		return m.Amount, true
+	case interface{ GetAmount() sdk.Coins }:
+		return m.GetAmount(), true
+	case interface{ GetAmount() []sdk.Coin }:
+		return sdk.Coins(m.GetAmount()), true
+	case interface{ GetAmount() sdk.Coin }:
+		return coins(m.GetAmount())
+	case interface{ GetToken() sdk.Coin }:
+		return coins(m.GetToken())
+	case interface{ GetInputs() []banktypes.Input }:
+		amount := sdk.NewCoins()
+		for _, input := range m.GetInputs() {
+			amount = amount.Add(input.Coins...)
+		}
+		return amount, true
+	default:
+		return nil, false
+	}
+}
+
+func coins(coin sdk.Coin) (sdk.Coins, bool) {
+	if coin.Validate() != nil {
+		return nil, false
+	}
+
+	return sdk.NewCoins(coin), true
+}
+
+func containsAny(list, values []string) bool {
+	for _, v := range values {
+		for _, s := range list {
+			if s == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -20,6 +20,17 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	msgTypeURLs := make(map[string]bool, len(gs.CircuitBreakers))
+	for _, breaker := range gs.CircuitBreakers {
+		if err := breaker.Validate(); err != nil {
+			return err
+		}
+		if msgTypeURLs[breaker.MsgTypeUrl] {
+			return fmt.Errorf("duplicate circuit breaker for %s", breaker.MsgTypeUrl)
+		}
+		msgTypeURLs[breaker.MsgTypeUrl] = true
+	}
+
 	return nil
 }
 
@@ -17,4 +17,6 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	CircuitBreakerPrefix    = collections.NewPrefix(3)
+	RateLimitUsagePrefix    = collections.NewPrefix(4)
 )
@@ -6,21 +6,29 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -150,6 +158,29 @@ type MsgTripCircuitBreaker struct {
 	// authority does not have permissions to trip the specified msg type URLs
 	// (or all URLs), the operation will fail.
 	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// senders restricts the circuit breaker to the Msgs signed by one of these
+	// accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	Senders []string `protobuf:"bytes,3,rep,name=senders,proto3" json:"senders,omitempty"`
+	// min_amount restricts the circuit breaker to the Msgs transferring at least
+	// this amount of one of its denoms.
+	//
+	// Since: cosmos-sdk 0.50
+	MinAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_amount,json=minAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_amount"`
+	// expiry_height is the height at which the circuit breaker is reset.
+	//
+	// Since: cosmos-sdk 0.50
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time is the time at which the circuit breaker is reset.
+	//
+	// Since: cosmos-sdk 0.50
+	ExpiryTime *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+	// rate_limit limits the number of Msgs executed in a window of blocks,
+	// instead of disabling them.
+	//
+	// Since: cosmos-sdk 0.50
+	RateLimit *RateLimit `protobuf:"bytes,7,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
 }
 
 func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
@@ -199,6 +230,41 @@ func (m *MsgTripCircuitBreaker) GetMsgTypeUrls() []string {
 	return nil
 }
 
+func (m *MsgTripCircuitBreaker) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *MsgTripCircuitBreaker) GetMinAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinAmount
+	}
+	return nil
+}
+
+func (m *MsgTripCircuitBreaker) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgTripCircuitBreaker) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *MsgTripCircuitBreaker) GetRateLimit() *RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
 // MsgTripCircuitBreaker defines the Msg/TripCircuitBreaker response type.
 type MsgTripCircuitBreakerResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -358,34 +424,49 @@ func init() {
 func init() { proto.RegisterFile("cosmos/circuit/v1/tx.proto", fileDescriptor_a02145e57a6fbb1d) }
 
 var fileDescriptor_a02145e57a6fbb1d = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0x87, 0x19, 0x1a, 0xff, 0x30, 0xa8, 0x89, 0x35, 0x7a, 0x9b, 0xc9, 0xbd, 0x13, 0xd2, 0x55,
-	0xbd, 0x89, 0xad, 0x60, 0x34, 0x91, 0x85, 0x51, 0x5c, 0x37, 0x31, 0x0d, 0x6e, 0xdc, 0x10, 0xac,
-	0x93, 0x71, 0x84, 0x32, 0xcd, 0x9c, 0x96, 0x80, 0x1b, 0x8d, 0x4f, 0xe0, 0x23, 0xf8, 0x08, 0x3c,
-	0x86, 0x4b, 0x96, 0x2e, 0x0d, 0x2c, 0x78, 0x01, 0x1f, 0xc0, 0x94, 0x52, 0x4a, 0x64, 0x08, 0x98,
-	0xbb, 0x1c, 0xce, 0x37, 0xe7, 0xf7, 0x0d, 0xa7, 0x07, 0x93, 0x50, 0x42, 0x24, 0xc1, 0x0b, 0x85,
-	0x0a, 0x53, 0x91, 0x78, 0xe3, 0xa6, 0x97, 0x4c, 0xdc, 0x58, 0xc9, 0x44, 0x9a, 0x77, 0xf3, 0x9a,
-	0xbb, 0xa9, 0xb9, 0xe3, 0x26, 0x39, 0xdb, 0xe0, 0x11, 0xf0, 0x0c, 0x8d, 0x80, 0xe7, 0x2c, 0xb9,
-	0xd0, 0xf4, 0x99, 0xc6, 0x0c, 0xf2, 0xb2, 0xfd, 0x03, 0x61, 0xe2, 0x03, 0x7f, 0x95, 0x26, 0x1f,
-	0xa5, 0x12, 0x9f, 0xd9, 0xeb, 0x1c, 0xeb, 0x28, 0xd6, 0x1f, 0x30, 0x65, 0x5a, 0xf8, 0x06, 0x57,
-	0xfd, 0x51, 0xc2, 0x94, 0x85, 0x1a, 0xc8, 0xa9, 0x05, 0xc5, 0xb1, 0xac, 0x30, 0xab, 0xba, 0x5b,
-	0x61, 0xe6, 0x4b, 0x5c, 0x8f, 0x99, 0x8a, 0x04, 0x80, 0x90, 0x23, 0xb0, 0x8c, 0x06, 0x72, 0xea,
-	0x2d, 0xea, 0xee, 0x39, 0xbb, 0x6f, 0x4a, 0x2a, 0xd8, 0xbd, 0xd2, 0xbe, 0xf5, 0x6d, 0x35, 0xbb,
-	0x2c, 0x92, 0xec, 0x17, 0xd8, 0x3e, 0x6c, 0x18, 0x30, 0x88, 0xe5, 0x08, 0x58, 0xe6, 0x03, 0x69,
-	0x18, 0x32, 0x80, 0xb5, 0xe9, 0xcd, 0xa0, 0x38, 0xda, 0x02, 0xdf, 0xf7, 0x81, 0x77, 0x95, 0x88,
-	0xff, 0x79, 0xdc, 0x39, 0xae, 0xf5, 0xf3, 0xae, 0xc9, 0x74, 0xf3, 0xbc, 0xf2, 0x07, 0xd3, 0xc6,
-	0xb7, 0x23, 0xe0, 0xbd, 0xec, 0xcf, 0xea, 0xa5, 0x6a, 0x08, 0x56, 0xb5, 0x61, 0x38, 0xb5, 0xa0,
-	0x1e, 0x01, 0xef, 0x4e, 0x63, 0xf6, 0x56, 0x0d, 0xa1, 0x7d, 0x27, 0x13, 0x2d, 0xef, 0xd8, 0xcf,
-	0xf1, 0x85, 0x36, 0xea, 0x04, 0xcb, 0x4f, 0xf8, 0x81, 0x0f, 0x3c, 0x60, 0xc0, 0x92, 0xab, 0x69,
-	0x1a, 0xc7, 0x35, 0xdb, 0x98, 0xea, 0xb3, 0x8e, 0x7b, 0xb6, 0xfe, 0x54, 0xb1, 0xe1, 0x03, 0x37,
-	0xbf, 0xe0, 0xb3, 0x43, 0x1f, 0xcd, 0x23, 0xcd, 0xac, 0x0f, 0x4f, 0x90, 0x3c, 0xfd, 0x2f, 0x7c,
-	0xab, 0x18, 0x63, 0x53, 0x33, 0x53, 0x47, 0xdf, 0x6c, 0x9f, 0x24, 0x8f, 0x4f, 0x25, 0xb7, 0x89,
-	0x80, 0xef, 0xe9, 0xe6, 0xf3, 0x50, 0xdf, 0x48, 0x83, 0x92, 0xe6, 0xc9, 0x68, 0x11, 0x4a, 0xae,
-	0x7d, 0x5d, 0xcd, 0x2e, 0x51, 0xe7, 0xd9, 0xcf, 0x05, 0x45, 0xf3, 0x05, 0x45, 0xbf, 0x17, 0x14,
-	0x7d, 0x5f, 0xd2, 0xca, 0x7c, 0x49, 0x2b, 0xbf, 0x96, 0xb4, 0xf2, 0xee, 0x3c, 0x6f, 0x09, 0x1f,
-	0x06, 0xae, 0x90, 0xde, 0x64, 0xbb, 0xe8, 0xeb, 0x2d, 0x7f, 0x7f, 0x7d, 0xbd, 0xe6, 0x4f, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x89, 0x07, 0x74, 0x02, 0x4f, 0x04, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xeb, 0x3e, 0xc8, 0xa4, 0x45, 0xaa, 0x79, 0xd4, 0x58, 0xad, 0x13, 0x85, 0x8d, 0xa9,
+	0x54, 0x9b, 0x14, 0x81, 0x44, 0x91, 0x10, 0x4d, 0x25, 0xc4, 0x82, 0x48, 0xc8, 0x2a, 0x1b, 0x36,
+	0x91, 0xe3, 0x0e, 0x93, 0xa1, 0x19, 0x8f, 0x35, 0x77, 0x5c, 0x35, 0x6c, 0xa8, 0xf8, 0x82, 0xae,
+	0x59, 0xb1, 0x44, 0xb0, 0xe9, 0x67, 0x74, 0xd9, 0x25, 0x2b, 0x8a, 0xda, 0x45, 0x7f, 0x80, 0x0f,
+	0x40, 0xe3, 0x47, 0x53, 0x11, 0x57, 0x14, 0xb1, 0x49, 0x66, 0xee, 0x3d, 0xf7, 0x31, 0xf7, 0x9c,
+	0x6b, 0x64, 0x85, 0x1c, 0x18, 0x07, 0x2f, 0xa4, 0x22, 0x4c, 0xa8, 0xf4, 0x76, 0x5a, 0x9e, 0xdc,
+	0x75, 0x63, 0xc1, 0x25, 0x37, 0xe6, 0x33, 0x9f, 0x9b, 0xfb, 0xdc, 0x9d, 0x96, 0xb5, 0x90, 0xc3,
+	0x19, 0x10, 0x05, 0x65, 0x40, 0x32, 0xac, 0xb5, 0x54, 0x92, 0x67, 0x18, 0x63, 0xc8, 0xdd, 0xf3,
+	0x01, 0xa3, 0x11, 0xf7, 0xd2, 0xdf, 0xdc, 0x64, 0xe7, 0x11, 0xbd, 0x00, 0xb0, 0xb7, 0xd3, 0xea,
+	0x61, 0x19, 0xb4, 0xbc, 0x90, 0xd3, 0x28, 0xf7, 0xdf, 0x24, 0x9c, 0xf0, 0xf4, 0xe8, 0xa9, 0x53,
+	0x6e, 0xad, 0x13, 0xce, 0xc9, 0x00, 0x7b, 0xe9, 0xad, 0x97, 0xbc, 0xf5, 0x24, 0x65, 0x18, 0x64,
+	0xc0, 0xe2, 0x0c, 0xd0, 0xfc, 0xac, 0x21, 0xab, 0x03, 0x64, 0x3d, 0x91, 0x7d, 0x2e, 0xe8, 0x7b,
+	0xbc, 0x91, 0x35, 0xd4, 0x16, 0x38, 0xd8, 0xc6, 0xc2, 0x30, 0xd1, 0x0c, 0x11, 0x41, 0x24, 0xb1,
+	0x30, 0xb5, 0x86, 0xe6, 0x54, 0xfd, 0xe2, 0x3a, 0xf2, 0x60, 0x73, 0xe2, 0xa2, 0x07, 0x1b, 0xcf,
+	0x50, 0x2d, 0xc6, 0x82, 0x51, 0x00, 0xca, 0x23, 0x30, 0xf5, 0x86, 0xe6, 0xd4, 0x56, 0x6d, 0x77,
+	0x6c, 0x3a, 0xee, 0xab, 0x11, 0xca, 0xbf, 0x18, 0xb2, 0x36, 0xfb, 0xf1, 0xec, 0x60, 0xb9, 0xa8,
+	0xd4, 0x7c, 0x8a, 0x9a, 0x97, 0x77, 0xe8, 0x63, 0x88, 0x79, 0x04, 0x58, 0xf5, 0x03, 0x49, 0x18,
+	0x62, 0x80, 0xb4, 0xd3, 0x6b, 0x7e, 0x71, 0x6d, 0x7e, 0xd3, 0xd1, 0xad, 0x0e, 0x90, 0x4d, 0x41,
+	0xe3, 0x3f, 0x5e, 0xb7, 0x88, 0xaa, 0x41, 0x96, 0x56, 0x0e, 0xf3, 0xf7, 0x8d, 0x0c, 0x46, 0x13,
+	0xcd, 0x31, 0x20, 0x5d, 0xc5, 0x4b, 0x37, 0x11, 0x03, 0x30, 0x27, 0x1a, 0xba, 0x53, 0xf5, 0x6b,
+	0x0c, 0xc8, 0xe6, 0x30, 0xc6, 0xaf, 0xc5, 0x00, 0xd2, 0xaa, 0x38, 0xda, 0xc2, 0x42, 0xbd, 0x53,
+	0x79, 0x8b, 0xab, 0xb1, 0xa7, 0x21, 0xc4, 0x68, 0xd4, 0x0d, 0x18, 0x4f, 0x22, 0x69, 0x4e, 0x36,
+	0x74, 0xa7, 0xb6, 0x7a, 0xa7, 0x98, 0x82, 0x62, 0xd1, 0xcd, 0x59, 0x74, 0x37, 0x38, 0x8d, 0xda,
+	0xcf, 0x0f, 0x7f, 0xd4, 0x2b, 0x5f, 0x8f, 0xeb, 0x0e, 0xa1, 0xb2, 0x9f, 0xf4, 0xdc, 0x90, 0x33,
+	0xaf, 0x10, 0x49, 0xfa, 0xb7, 0x02, 0x5b, 0xdb, 0xb9, 0x48, 0x54, 0x00, 0x7c, 0x3a, 0x3b, 0x58,
+	0x9e, 0x1d, 0x60, 0x12, 0x84, 0xc3, 0xae, 0xd2, 0x01, 0x7c, 0x39, 0x3b, 0x58, 0xd6, 0xfc, 0x2a,
+	0xa3, 0xd1, 0x7a, 0x5a, 0xd3, 0xb8, 0x8b, 0xe6, 0xf0, 0x6e, 0x4c, 0xc5, 0xb0, 0xdb, 0xc7, 0x94,
+	0xf4, 0xa5, 0x39, 0xd5, 0xd0, 0x1c, 0xdd, 0x9f, 0xcd, 0x8c, 0x2f, 0x52, 0x9b, 0xb1, 0x8e, 0x6a,
+	0x39, 0x48, 0x49, 0xc3, 0x9c, 0x4e, 0xd9, 0xb2, 0xdc, 0x4c, 0x37, 0x6e, 0xa1, 0x1b, 0x77, 0xb3,
+	0xd0, 0x4d, 0x7b, 0x72, 0xff, 0xb8, 0xae, 0xf9, 0x28, 0x0b, 0x52, 0x66, 0xe3, 0x09, 0x42, 0x22,
+	0x90, 0xb8, 0x3b, 0xa0, 0x8c, 0x4a, 0x73, 0x26, 0xcd, 0xb0, 0x58, 0xc2, 0xb7, 0x1f, 0x48, 0xfc,
+	0x52, 0x61, 0xfc, 0xaa, 0x28, 0x8e, 0x6b, 0xd7, 0x15, 0xd7, 0xa3, 0xa9, 0x37, 0x1f, 0xa3, 0xa5,
+	0x52, 0xb2, 0xae, 0x40, 0xf4, 0x3b, 0x74, 0xbb, 0x03, 0xc4, 0xc7, 0x80, 0xe5, 0xff, 0x11, 0xad,
+	0x8f, 0x11, 0x3d, 0xd6, 0xe6, 0x1a, 0xb2, 0xcb, 0x6b, 0xfd, 0xbd, 0xcf, 0xd5, 0x5f, 0x13, 0x48,
+	0xef, 0x00, 0x31, 0x3e, 0xa0, 0x85, 0xcb, 0xf6, 0x6e, 0xa5, 0x64, 0x7c, 0x97, 0x2f, 0x81, 0xf5,
+	0xf0, 0x9f, 0xe0, 0xe7, 0x2d, 0xc6, 0xc8, 0x28, 0xd9, 0x0a, 0xa7, 0x3c, 0xd9, 0x38, 0xd2, 0xba,
+	0x7f, 0x55, 0xe4, 0x79, 0x45, 0x40, 0x37, 0xca, 0xf8, 0xb9, 0x57, 0x9e, 0xa8, 0x04, 0x6a, 0xb5,
+	0xae, 0x0c, 0x2d, 0x8a, 0x5a, 0x53, 0x7b, 0x6a, 0x33, 0xda, 0x8f, 0x0e, 0x4f, 0x6c, 0xed, 0xe8,
+	0xc4, 0xd6, 0x7e, 0x9e, 0xd8, 0xda, 0xfe, 0xa9, 0x5d, 0x39, 0x3a, 0xb5, 0x2b, 0xdf, 0x4f, 0xed,
+	0xca, 0x9b, 0xc5, 0x2c, 0x25, 0x6c, 0x6d, 0xbb, 0x94, 0x7b, 0xbb, 0xe7, 0x5f, 0xe5, 0x74, 0xdb,
+	0x7a, 0xd3, 0xe9, 0x12, 0x3c, 0xf8, 0x3d, 0x00, 0xbb, 0xec, 0xce, 0xfb, 0xfc, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -652,6 +733,56 @@ func (m *MsgTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinAmount) > 0 {
+		for iNdEx := len(m.MinAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MsgTypeUrls) > 0 {
 		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MsgTypeUrls[iNdEx])
@@ -836,6 +967,29 @@ func (m *MsgTripCircuitBreaker) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinAmount) > 0 {
+		for _, e := range m.MinAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1201,6 +1355,163 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = append(m.MinAmount, types.Coin{})
+			if err := m.MinAmount[len(m.MinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
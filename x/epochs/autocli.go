@@ -0,0 +1,34 @@
+package epochs
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	epochsv1beta1 "cosmossdk.io/api/cosmos/epochs/v1beta1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: epochsv1beta1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "EpochInfos",
+					Use:       "epoch-infos",
+					Short:     "Query running epoch infos",
+				},
+				{
+					RpcMethod:      "EpochInfo",
+					Use:            "epoch-info [identifier]",
+					Short:          "Query the running epoch info of an identifier",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+				},
+				{
+					RpcMethod:      "CurrentEpoch",
+					Use:            "current-epoch [identifier]",
+					Short:          "Query the current epoch number of an identifier",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/x/epochs/types"
+)
+
+// BeginBlocker of epochs module.
+//
+// The epochs are processed in the order of their identifiers. At most one
+// epoch of each identifier ends per block: an epoch ends at the first block
+// whose time is after its end, and the next epoch starts at that same block.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	blockHeight := sdkCtx.BlockHeight()
+
+	// collect the epochs first, as the hooks may write to the store
+	epochs, err := k.AllEpochInfos(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, epochInfo := range epochs {
+		// If blocktime < initial epoch start time, return
+		if blockTime.Before(epochInfo.StartTime) {
+			continue
+		}
+		// if epoch counting hasn't started, signal we need to start.
+		shouldInitialEpochStart := !epochInfo.EpochCountingStarted
+
+		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+		shouldEpochStart := (blockTime.After(epochEndTime)) || shouldInitialEpochStart
+
+		if !shouldEpochStart {
+			continue
+		}
+		epochInfo.CurrentEpochStartHeight = blockHeight
+
+		if shouldInitialEpochStart {
+			epochInfo.EpochCountingStarted = true
+			epochInfo.CurrentEpoch = 1
+			epochInfo.CurrentEpochStartTime = epochInfo.StartTime
+			sdkCtx.Logger().Debug(fmt.Sprintf("Starting new epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
+		} else {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+					sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochInfo.CurrentEpoch)),
+				),
+			)
+			if err := k.Hooks().AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch); err != nil {
+				return err
+			}
+
+			epochInfo.CurrentEpoch++
+			epochInfo.CurrentEpochStartTime = epochEndTime
+			sdkCtx.Logger().Debug(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
+		}
+
+		// emit new epoch start event, set epoch info, and run BeforeEpochStart hook
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+				sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochInfo.CurrentEpoch)),
+				sdk.NewAttribute(types.AttributeEpochStartTime, fmt.Sprintf("%d", epochInfo.CurrentEpochStartTime.Unix())),
+			),
+		)
+		if err := k.EpochInfo.Set(ctx, epochInfo.Identifier, epochInfo); err != nil {
+			return err
+		}
+		if err := k.Hooks().BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
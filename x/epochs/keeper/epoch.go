@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/x/epochs/types"
+)
+
+// GetEpochInfo returns the epoch info of the given identifier.
+func (k Keeper) GetEpochInfo(ctx context.Context, identifier string) (types.EpochInfo, error) {
+	return k.EpochInfo.Get(ctx, identifier)
+}
+
+// AddEpochInfo registers a new epoch. It errors if the epoch is invalid, or if
+// an epoch with the same identifier already exists. A zero start time and start
+// height default to the time and height of the current block.
+func (k Keeper) AddEpochInfo(ctx context.Context, epoch types.EpochInfo) error {
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+
+	has, err := k.EpochInfo.Has(ctx, epoch.Identifier)
+	if err != nil {
+		return err
+	}
+	if has {
+		return fmt.Errorf("epoch with identifier %s already exists", epoch.Identifier)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = sdkCtx.BlockTime()
+	}
+	if epoch.CurrentEpochStartHeight == 0 {
+		epoch.CurrentEpochStartHeight = sdkCtx.BlockHeight()
+	}
+
+	return k.EpochInfo.Set(ctx, epoch.Identifier, epoch)
+}
+
+// AllEpochInfos returns every epoch info, ordered by identifier.
+func (k Keeper) AllEpochInfos(ctx context.Context) ([]types.EpochInfo, error) {
+	var epochs []types.EpochInfo
+	err := k.EpochInfo.Walk(ctx, nil, func(_ string, epoch types.EpochInfo) (stop bool, err error) {
+		epochs = append(epochs, epoch)
+		return false, nil
+	})
+
+	return epochs, err
+}
+
+// NumBlocksSinceEpochStart returns the number of blocks since the start of the
+// current epoch of the given identifier.
+func (k Keeper) NumBlocksSinceEpochStart(ctx context.Context, identifier string) (int64, error) {
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if err != nil {
+		return 0, fmt.Errorf("epoch with identifier %s not found: %w", identifier, err)
+	}
+
+	return sdk.UnwrapSDKContext(ctx).BlockHeight() - epoch.CurrentEpochStartHeight, nil
+}
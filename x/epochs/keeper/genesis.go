@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/epochs/types"
+)
+
+// InitGenesis sets epoch info from genesis
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, epoch := range genState.Epochs {
+		if err := k.AddEpochInfo(ctx, epoch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the epochs module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	epochs, err := k.AllEpochInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(epochs), nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/epochs/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/epochs keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier initializes new querier.
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// EpochInfos provide running epochInfos.
+func (q Querier) EpochInfos(ctx context.Context, _ *types.QueryEpochInfosRequest) (*types.QueryEpochInfosResponse, error) {
+	epochs, err := q.AllEpochInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochInfosResponse{Epochs: epochs}, nil
+}
+
+// EpochInfo provides the running epochInfo of the given identifier.
+func (q Querier) EpochInfo(ctx context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	epoch, err := q.getEpochInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochInfoResponse{Epoch: epoch}, nil
+}
+
+// CurrentEpoch provides current epoch of specified identifier.
+func (q Querier) CurrentEpoch(ctx context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	epoch, err := q.getEpochInfo(ctx, &types.QueryEpochInfoRequest{Identifier: req.Identifier})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCurrentEpochResponse{CurrentEpoch: epoch.CurrentEpoch}, nil
+}
+
+func (q Querier) getEpochInfo(ctx context.Context, req *types.QueryEpochInfoRequest) (types.EpochInfo, error) {
+	if req == nil {
+		return types.EpochInfo{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateEpochIdentifierString(req.Identifier); err != nil {
+		return types.EpochInfo{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	epoch, err := q.GetEpochInfo(ctx, req.Identifier)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EpochInfo{}, status.Errorf(codes.NotFound, "epoch with identifier %s not found", req.Identifier)
+	}
+
+	return epoch, err
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/epochs/keeper"
+	"cosmossdk.io/x/epochs/types"
+)
+
+func TestQueries(t *testing.T) {
+	f := initFixture(t)
+	q := keeper.NewQuerier(*f.keeper)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *types.DefaultGenesis()))
+	require.NoError(t, f.keeper.BeginBlocker(f.ctx))
+	ctx := nextBlock(f.ctx, 2*time.Minute)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+	infos, err := q.EpochInfos(ctx, &types.QueryEpochInfosRequest{})
+	require.NoError(t, err)
+	require.Len(t, infos.Epochs, 4)
+	require.Equal(t, []string{"day", "hour", "minute", "week"}, []string{
+		infos.Epochs[0].Identifier, infos.Epochs[1].Identifier, infos.Epochs[2].Identifier, infos.Epochs[3].Identifier,
+	})
+
+	info, err := q.EpochInfo(ctx, &types.QueryEpochInfoRequest{Identifier: "minute"})
+	require.NoError(t, err)
+	require.Equal(t, infos.Epochs[2], info.Epoch)
+	require.Equal(t, int64(2), info.Epoch.CurrentEpoch)
+
+	current, err := q.CurrentEpoch(ctx, &types.QueryCurrentEpochRequest{Identifier: "minute"})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), current.CurrentEpoch)
+
+	current, err = q.CurrentEpoch(ctx, &types.QueryCurrentEpochRequest{Identifier: "day"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), current.CurrentEpoch)
+
+	_, err = q.CurrentEpoch(ctx, &types.QueryCurrentEpochRequest{Identifier: "month"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = q.EpochInfo(ctx, &types.QueryEpochInfoRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = q.CurrentEpoch(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
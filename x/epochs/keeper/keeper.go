@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/epochs/types"
+)
+
+// Keeper defines the epochs module's keeper.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	hooks types.EpochHooks
+
+	Schema collections.Schema
+	// EpochInfo contains the state of each epoch, keyed by its identifier
+	EpochInfo collections.Map[string, types.EpochInfo]
+}
+
+// NewKeeper returns a new keeper by codec and storeKey inputs.
+func NewKeeper(storeService store.KVStoreService, cdc codec.BinaryCodec) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		EpochInfo: collections.NewMap(
+			sb,
+			types.EpochInfoPrefix,
+			"epoch_info",
+			collections.StringKey,
+			codec.CollValue[types.EpochInfo](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// SetHooks sets the hooks on the x/epochs keeper.
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epochs hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// Hooks returns the hooks of the x/epochs keeper.
+func (k Keeper) Hooks() types.EpochHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiEpochHooks{}
+	}
+
+	return k.hooks
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/epochs"
+	"cosmossdk.io/x/epochs/keeper"
+	"cosmossdk.io/x/epochs/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var genesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type fixture struct {
+	ctx      sdk.Context
+	keeper   *keeper.Keeper
+	storeKey *storetypes.KVStoreKey
+	hooks    *recordingHooks
+}
+
+func initFixture(t *testing.T) *fixture {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epochs.AppModuleBasic{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	k := keeper.NewKeeper(runtime.NewKVStoreService(storeKey), encCfg.Codec)
+
+	hooks := &recordingHooks{storeKey: storeKey}
+	k.SetHooks(types.NewMultiEpochHooks(hooks))
+
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = ctx.WithBlockHeader(cmproto.Header{Height: 1, Time: genesisTime})
+
+	return &fixture{ctx: ctx, keeper: &k, storeKey: storeKey, hooks: hooks}
+}
+
+// nextBlock returns the context of the block following ctx, d later.
+func nextBlock(ctx sdk.Context, d time.Duration) sdk.Context {
+	return ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(d)).WithEventManager(sdk.NewEventManager())
+}
+
+type hookCall struct {
+	Name        string
+	Identifier  string
+	EpochNumber int64
+}
+
+// recordingHooks records the hook calls, and writes a key to the store at each
+// call so that tests can check which state changes are kept.
+type recordingHooks struct {
+	storeKey *storetypes.KVStoreKey
+	calls    []hookCall
+	err      error
+}
+
+func (h *recordingHooks) record(ctx context.Context, name, identifier string, epochNumber int64) error {
+	h.calls = append(h.calls, hookCall{name, identifier, epochNumber})
+	sdk.UnwrapSDKContext(ctx).KVStore(h.storeKey).Set([]byte("hook/"+name+"/"+identifier), []byte{1})
+	return h.err
+}
+
+func (h *recordingHooks) AfterEpochEnd(ctx context.Context, identifier string, epochNumber int64) error {
+	return h.record(ctx, "AfterEpochEnd", identifier, epochNumber)
+}
+
+func (h *recordingHooks) BeforeEpochStart(ctx context.Context, identifier string, epochNumber int64) error {
+	return h.record(ctx, "BeforeEpochStart", identifier, epochNumber)
+}
+
+func TestAddEpochInfo(t *testing.T) {
+	f := initFixture(t)
+
+	// the start time and height default to the current block
+	require.NoError(t, f.keeper.AddEpochInfo(f.ctx, types.NewGenesisEpochInfo("day", 24*time.Hour)))
+	epoch, err := f.keeper.GetEpochInfo(f.ctx, "day")
+	require.NoError(t, err)
+	require.Equal(t, genesisTime, epoch.StartTime)
+	require.Equal(t, int64(1), epoch.CurrentEpochStartHeight)
+	require.False(t, epoch.EpochCountingStarted)
+
+	startTime := genesisTime.Add(time.Hour)
+	week := types.NewGenesisEpochInfo("week", 7*24*time.Hour)
+	week.StartTime = startTime
+	require.NoError(t, f.keeper.AddEpochInfo(f.ctx, week))
+	epoch, err = f.keeper.GetEpochInfo(f.ctx, "week")
+	require.NoError(t, err)
+	require.Equal(t, startTime, epoch.StartTime)
+
+	require.ErrorContains(t, f.keeper.AddEpochInfo(f.ctx, types.NewGenesisEpochInfo("day", time.Hour)), "already exists")
+	require.ErrorContains(t, f.keeper.AddEpochInfo(f.ctx, types.NewGenesisEpochInfo("", time.Hour)), "empty epoch identifier")
+	require.ErrorContains(t, f.keeper.AddEpochInfo(f.ctx, types.NewGenesisEpochInfo("hour", 0)), "duration must be positive")
+
+	epochs, err := f.keeper.AllEpochInfos(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, epochs, 2)
+	require.Equal(t, "day", epochs[0].Identifier)
+	require.Equal(t, "week", epochs[1].Identifier)
+}
+
+func TestBeginBlocker(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.AddEpochInfo(f.ctx, types.NewGenesisEpochInfo("minute", time.Minute)))
+	later := types.NewGenesisEpochInfo("hour", time.Hour)
+	later.StartTime = genesisTime.Add(90 * time.Second)
+	require.NoError(t, f.keeper.AddEpochInfo(f.ctx, later))
+
+	// the first epoch starts at the genesis block
+	require.NoError(t, f.keeper.BeginBlocker(f.ctx))
+	require.Equal(t, []hookCall{{"BeforeEpochStart", "minute", 1}}, f.hooks.calls)
+	epoch, err := f.keeper.GetEpochInfo(f.ctx, "minute")
+	require.NoError(t, err)
+	require.True(t, epoch.EpochCountingStarted)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+	require.Equal(t, genesisTime, epoch.CurrentEpochStartTime)
+
+	// the epoch does not end before the end of its duration
+	f.hooks.calls = nil
+	ctx := nextBlock(f.ctx, time.Minute)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Empty(t, f.hooks.calls)
+
+	// the epoch ends at the first block after the end of its duration
+	ctx = nextBlock(ctx, time.Second)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, []hookCall{{"AfterEpochEnd", "minute", 1}, {"BeforeEpochStart", "minute", 2}}, f.hooks.calls)
+	epoch, err = f.keeper.GetEpochInfo(ctx, "minute")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), epoch.CurrentEpoch)
+	require.Equal(t, genesisTime.Add(time.Minute), epoch.CurrentEpochStartTime)
+	require.Equal(t, ctx.BlockHeight(), epoch.CurrentEpochStartHeight)
+
+	blocks, err := f.keeper.NumBlocksSinceEpochStart(nextBlock(ctx, time.Second), "minute")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), blocks)
+
+	// a single epoch of an identifier ends per block, and the epochs are
+	// processed in the order of their identifiers
+	f.hooks.calls = nil
+	ctx = nextBlock(ctx, 5*time.Minute)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, []hookCall{
+		{"BeforeEpochStart", "hour", 1},
+		{"AfterEpochEnd", "minute", 2},
+		{"BeforeEpochStart", "minute", 3},
+	}, f.hooks.calls)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{types.EventTypeEpochStart, types.EventTypeEpochEnd, types.EventTypeEpochStart}, events)
+}
+
+func TestBeginBlockerFailingHook(t *testing.T) {
+	f := initFixture(t)
+	f.hooks.err = errors.New("hook failure")
+
+	require.NoError(t, f.keeper.AddEpochInfo(f.ctx, types.NewGenesisEpochInfo("minute", time.Minute)))
+
+	// the epoch still starts, but the state changes of the hook are discarded
+	require.NoError(t, f.keeper.BeginBlocker(f.ctx))
+	require.Len(t, f.hooks.calls, 1)
+	require.False(t, f.ctx.KVStore(f.storeKey).Has([]byte("hook/BeforeEpochStart/minute")))
+
+	epoch, err := f.keeper.GetEpochInfo(f.ctx, "minute")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+
+	f.hooks.err = nil
+	ctx := nextBlock(f.ctx, 2*time.Minute)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.True(t, ctx.KVStore(f.storeKey).Has([]byte("hook/BeforeEpochStart/minute")))
+}
+
+func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *types.DefaultGenesis()))
+	require.NoError(t, f.keeper.BeginBlocker(f.ctx))
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Epochs, 4)
+	for _, epoch := range exported.Epochs {
+		require.Equal(t, genesisTime, epoch.StartTime)
+		require.Equal(t, int64(1), epoch.CurrentEpoch)
+		require.True(t, epoch.EpochCountingStarted)
+	}
+
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
@@ -0,0 +1,11 @@
+package types
+
+// epochs module event types
+const (
+	EventTypeEpochEnd   = "epoch_end"
+	EventTypeEpochStart = "epoch_start"
+
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+)
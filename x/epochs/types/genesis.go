@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NewGenesisState returns a new GenesisState with the given epochs.
+func NewGenesisState(epochs []EpochInfo) *GenesisState {
+	return &GenesisState{Epochs: epochs}
+}
+
+// DefaultGenesis returns the default genesis state, with a minute, an hour, a
+// day and a week epoch starting at the genesis block.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewGenesisEpochInfo("day", time.Hour*24),
+		NewGenesisEpochInfo("hour", time.Hour),
+		NewGenesisEpochInfo("minute", time.Minute),
+		NewGenesisEpochInfo("week", time.Hour*24*7),
+	})
+}
+
+// NewGenesisEpochInfo returns an EpochInfo of the given duration which has not
+// started yet. Its start time and height are set when it is added.
+func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier:              identifier,
+		StartTime:               time.Time{},
+		Duration:                duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: 0,
+		CurrentEpochStartTime:   time.Time{},
+		EpochCountingStarted:    false,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	identifiers := make(map[string]bool, len(gs.Epochs))
+	for _, epoch := range gs.Epochs {
+		if identifiers[epoch.Identifier] {
+			return fmt.Errorf("duplicate epoch identifier %s", epoch.Identifier)
+		}
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		identifiers[epoch.Identifier] = true
+	}
+
+	return nil
+}
+
+// Validate also validates epoch info.
+func (epoch EpochInfo) Validate() error {
+	if err := ValidateEpochIdentifierString(epoch.Identifier); err != nil {
+		return err
+	}
+	if epoch.Duration <= 0 {
+		return fmt.Errorf("epoch %s duration must be positive: %s", epoch.Identifier, epoch.Duration)
+	}
+	if epoch.CurrentEpoch < 0 {
+		return errors.New("epoch CurrentEpoch must be non-negative")
+	}
+	if epoch.CurrentEpochStartHeight < 0 {
+		return errors.New("epoch CurrentEpochStartHeight must be non-negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/epochs/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	negativeEpoch := types.NewGenesisEpochInfo("day", time.Hour)
+	negativeEpoch.CurrentEpoch = -1
+	negativeHeight := types.NewGenesisEpochInfo("day", time.Hour)
+	negativeHeight.CurrentEpochStartHeight = -1
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   string
+	}{
+		{"default", types.DefaultGenesis(), ""},
+		{"empty", types.NewGenesisState(nil), ""},
+		{
+			"duplicate identifier",
+			types.NewGenesisState([]types.EpochInfo{types.NewGenesisEpochInfo("day", time.Hour), types.NewGenesisEpochInfo("day", time.Minute)}),
+			"duplicate epoch identifier day",
+		},
+		{"blank identifier", types.NewGenesisState([]types.EpochInfo{types.NewGenesisEpochInfo(" ", time.Hour)}), "empty epoch identifier"},
+		{"zero duration", types.NewGenesisState([]types.EpochInfo{types.NewGenesisEpochInfo("day", 0)}), "duration must be positive"},
+		{"negative epoch", types.NewGenesisState([]types.EpochInfo{negativeEpoch}), "CurrentEpoch must be non-negative"},
+		{"negative height", types.NewGenesisState([]types.EpochInfo{negativeHeight}), "CurrentEpochStartHeight must be non-negative"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
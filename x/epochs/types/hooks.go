@@ -0,0 +1,86 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks defines the hooks called by the epochs module at the boundaries
+// of the epochs.
+type EpochHooks interface {
+	// AfterEpochEnd is called at the first block whose time is after the end of
+	// the epoch, before the next epoch starts.
+	AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error
+	// BeforeEpochStart is called at the first block of an epoch, which is the
+	// block ending the previous epoch.
+	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
+}
+
+// EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
+type EpochHooksWrapper struct{ EpochHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EpochHooksWrapper) IsOnePerModuleType() {}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines multiple epoch hooks, all hook functions are run
+// in array sequence.
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks returns the hooks called in the given order.
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd is called when epoch is going to be ended, epochNumber is the
+// number of epoch that is ending.
+func (mh MultiEpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range mh {
+		hook := mh[i]
+		callHook(ctx, "AfterEpochEnd", func(ctx context.Context) error {
+			return hook.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		})
+	}
+
+	return nil
+}
+
+// BeforeEpochStart is called when epoch is going to be started, epochNumber is
+// the number of epoch that is starting.
+func (mh MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range mh {
+		hook := mh[i]
+		callHook(ctx, "BeforeEpochStart", func(ctx context.Context) error {
+			return hook.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+		})
+	}
+
+	return nil
+}
+
+// callHook runs the hook in a cached context, and only writes its state
+// changes if it succeeds. A failing or panicking hook is logged and skipped,
+// so that a single module cannot halt the chain at an epoch boundary.
+func callHook(ctx context.Context, name string, hook func(ctx context.Context) error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		return hook(cacheCtx)
+	}()
+	if err != nil {
+		sdkCtx.Logger().Error("epoch hook failed", "hook", name, "err", err)
+		return
+	}
+
+	write()
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateEpochIdentifierInterface validates an epoch identifier param, for
+// modules keeping the identifier of the epoch they run on in their params.
+func ValidateEpochIdentifierInterface(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateEpochIdentifierString(v)
+}
+
+// ValidateEpochIdentifierString validates an epoch identifier.
+func ValidateEpochIdentifierString(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return fmt.Errorf("empty epoch identifier: %q", s)
+	}
+
+	return nil
+}
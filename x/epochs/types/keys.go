@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epochs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// KVStore keys
+var (
+	// EpochInfoPrefix is the prefix of the epoch infos, keyed by identifier
+	EpochInfoPrefix = collections.NewPrefix(1)
+)
@@ -110,6 +110,16 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
+	if err := k.slashEquivocation(ctx, validator, consAddr, evidence.GetValidatorPower(), distributionHeight); err != nil {
+		return err
+	}
+
+	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+}
+
+// slashEquivocation slashes the validator committing an equivocation with the
+// double sign slash fraction, then jails and tombstones it.
+func (k Keeper) slashEquivocation(ctx context.Context, validator stakingtypes.ValidatorI, consAddr sdk.ConsAddress, power, distributionHeight int64) error {
 	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
 	if err != nil {
 		return err
@@ -119,7 +129,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		ctx,
 		consAddr,
 		slashFractionDoubleSign,
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 	if err != nil {
@@ -140,9 +150,82 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		return err
 	}
 
-	err = k.slashingKeeper.Tombstone(ctx, consAddr)
+	return k.slashingKeeper.Tombstone(ctx, consAddr)
+}
+
+// handleVoteExtensionEquivocationEvidence implements the evidence handler of
+// the conflicting vote extensions signed by a validator for the same height and
+// round. Assuming the evidence is valid, the validator is slashed, jailed and
+// tombstoned as for a double sign. Note, the current power of the validator is
+// slashed, as the evidence does not carry its power at the infraction height.
+//
+// The evidence is considered invalid if:
+// - the vote extensions are not older than the current block
+// - the evidence is older than the max age of the evidence in blocks
+// - the validator is unbonded or does not exist
+// - any vote extension signature does not match the validator consensus key
+// - the validator is already tombstoned
+func (k Keeper) handleVoteExtensionEquivocationEvidence(ctx context.Context, evidence *types.VoteExtensionEquivocation) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := k.Logger(sdkCtx)
+	consAddr := evidence.GetConsensusAddress()
+	infractionHeight := evidence.GetHeight()
+
+	// The vote extensions of a height are signed at the end of this height, so
+	// they cannot be included in a block of the same height.
+	if infractionHeight >= sdkCtx.BlockHeight() {
+		return fmt.Errorf("vote extensions height %d must be lower than the current height %d", infractionHeight, sdkCtx.BlockHeight())
+	}
+
+	// Vote extensions are not timestamped, so the evidence is only considered
+	// stale if it is older than the max age of the evidence in blocks.
+	cp := sdkCtx.ConsensusParams()
+	if cp.Evidence != nil && sdkCtx.BlockHeight()-infractionHeight > cp.Evidence.MaxAgeNumBlocks {
+		return fmt.Errorf("evidence too old; vote extensions height %d, max age num blocks %d", infractionHeight, cp.Evidence.MaxAgeNumBlocks)
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(sdkCtx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded or does not exist", consAddr)
+	}
+
+	pubKey, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes())
 	if err != nil {
-		return err
+		return fmt.Errorf("public key for validator %s not found: %w", consAddr, err)
 	}
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+
+	for _, ve := range []struct{ extension, signature []byte }{
+		{evidence.VoteExtensionA, evidence.SignatureA},
+		{evidence.VoteExtensionB, evidence.SignatureB},
+	} {
+		signBytes, err := types.VoteExtensionSignBytes(sdkCtx.ChainID(), infractionHeight, evidence.Round, ve.extension)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, ve.signature) {
+			return fmt.Errorf("failed to verify validator %s vote extension signature", consAddr)
+		}
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		return fmt.Errorf("expected signing info for validator %s but not found", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s already tombstoned", consAddr)
+	}
+
+	logger.Info(
+		"confirmed vote extension equivocation",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_round", evidence.Round,
+	)
+
+	// the vote extensions were signed by the validator set of their height, see
+	// handleEquivocationEvidence
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(sdkCtx))
+
+	return k.slashEquivocation(ctx, validator, consAddr, power, distributionHeight)
 }
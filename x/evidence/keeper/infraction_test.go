@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/evidence/keeper"
+	"cosmossdk.io/x/evidence/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleVoteExtensionEquivocation() {
+	privKey := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+	ctx := suite.ctx.WithIsCheckTx(false).WithChainID("test-chain").WithBlockHeight(10)
+
+	signVoteExtension := func(height, round int64, extension []byte) []byte {
+		signBytes, err := types.VoteExtensionSignBytes(ctx.ChainID(), height, round, extension)
+		suite.Require().NoError(err)
+		sig, err := privKey.Sign(signBytes)
+		suite.Require().NoError(err)
+		return sig
+	}
+	newEvidence := func(height, round int64) *types.VoteExtensionEquivocation {
+		return types.NewVoteExtensionEquivocation(
+			height, round, consAddr,
+			[]byte("price:100"), signVoteExtension(height, round, []byte("price:100")),
+			[]byte("price:200"), signVoteExtension(height, round, []byte("price:200")),
+		)
+	}
+
+	validator := stakingtypes.Validator{Status: stakingtypes.Bonded, Tokens: sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)}
+	slashFraction := sdkmath.LegacyNewDecWithPrec(5, 2)
+
+	testCases := []struct {
+		name      string
+		evidence  func() *types.VoteExtensionEquivocation
+		malleate  func()
+		expErrMsg string
+	}{
+		{
+			name:     "valid",
+			evidence: func() *types.VoteExtensionEquivocation { return newEvidence(8, 1) },
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator)
+				suite.slashingKeeper.EXPECT().GetPubkey(gomock.Any(), consAddr.Bytes()).Return(privKey.PubKey(), nil)
+				suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				suite.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				suite.slashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(slashFraction, nil)
+				suite.slashingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, slashFraction, int64(100), int64(8)-sdk.ValidatorUpdateDelay, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN).Return(nil)
+				suite.slashingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+				suite.slashingKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, types.DoubleSignJailEndTime).Return(nil)
+				suite.slashingKeeper.EXPECT().Tombstone(gomock.Any(), consAddr).Return(nil)
+			},
+		},
+		{
+			name:      "vote extensions of the current height",
+			evidence:  func() *types.VoteExtensionEquivocation { return newEvidence(10, 0) },
+			malleate:  func() {},
+			expErrMsg: "vote extensions height 10 must be lower than the current height 10",
+		},
+		{
+			name:     "unbonded validator",
+			evidence: func() *types.VoteExtensionEquivocation { return newEvidence(8, 0) },
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(stakingtypes.Validator{Status: stakingtypes.Unbonded})
+			},
+			expErrMsg: "is unbonded or does not exist",
+		},
+		{
+			name: "invalid signature",
+			evidence: func() *types.VoteExtensionEquivocation {
+				e := newEvidence(8, 0)
+				// the second vote extension was signed for another round
+				e.SignatureB = signVoteExtension(8, 1, e.VoteExtensionB)
+				return e
+			},
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator)
+				suite.slashingKeeper.EXPECT().GetPubkey(gomock.Any(), consAddr.Bytes()).Return(privKey.PubKey(), nil)
+			},
+			expErrMsg: "failed to verify validator",
+		},
+		{
+			name:     "signed by another validator",
+			evidence: func() *types.VoteExtensionEquivocation { return newEvidence(8, 0) },
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator)
+				suite.slashingKeeper.EXPECT().GetPubkey(gomock.Any(), consAddr.Bytes()).Return(ed25519.GenPrivKey().PubKey(), nil)
+			},
+			expErrMsg: "failed to verify validator",
+		},
+		{
+			name:     "tombstoned validator",
+			evidence: func() *types.VoteExtensionEquivocation { return newEvidence(8, 2) },
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator)
+				suite.slashingKeeper.EXPECT().GetPubkey(gomock.Any(), consAddr.Bytes()).Return(privKey.PubKey(), nil)
+				suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+			},
+			expErrMsg: "already tombstoned",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.malleate()
+			e := tc.evidence()
+
+			msg, err := types.NewMsgSubmitEvidence(sdk.AccAddress(valAddresses[0]), e)
+			suite.Require().NoError(err)
+			res, err := suite.msgServer.SubmitEvidence(ctx, msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				suite.Require().ErrorIs(err, types.ErrInvalidEvidence)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(e.Hash(), res.Hash)
+
+			stored, err := suite.evidenceKeeper.Evidences.Get(ctx, e.Hash())
+			suite.Require().NoError(err)
+			suite.Require().Equal(e, stored)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVoteExtensionEquivocationRoute() {
+	handler, err := suite.evidenceKeeper.GetEvidenceHandler(types.RouteVoteExtensionEquivocation)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(handler)
+
+	// the built-in route cannot be registered by the application
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	k := keeper.NewKeeper(suite.encCfg.Codec, runtime.NewKVStoreService(key), suite.stakingKeeper, suite.slashingKeeper, address.NewBech32Codec("cosmos"), suite.blockInfo)
+	router := types.NewRouter().AddRoute(types.RouteVoteExtensionEquivocation, testEquivocationHandler(k))
+	suite.Require().Panics(func() { k.SetRouter(router) })
+}
@@ -79,6 +79,9 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 	if k.router != nil {
 		panic(fmt.Sprintf("attempting to reset router on x/%s", types.ModuleName))
 	}
+	if rtr.HasRoute(types.RouteVoteExtensionEquivocation) {
+		panic(fmt.Sprintf("route %s is reserved for a built-in evidence type", types.RouteVoteExtensionEquivocation))
+	}
 
 	k.router = rtr
 }
@@ -86,11 +89,37 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 // GetEvidenceHandler returns a registered Handler for a given Evidence type. If
 // no handler exists, an error is returned.
 func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error) {
-	if !k.router.HasRoute(evidenceRoute) {
+	handler, ok := k.getHandler(evidenceRoute)
+	if !ok {
 		return nil, errors.Wrap(types.ErrNoEvidenceHandlerExists, evidenceRoute)
 	}
 
-	return k.router.GetRoute(evidenceRoute), nil
+	return handler, nil
+}
+
+// getHandler returns the Handler of the built-in evidence types, which do not
+// need to be registered in the router, or the registered Handler for a given
+// Evidence type.
+func (k Keeper) getHandler(evidenceRoute string) (types.Handler, bool) {
+	if evidenceRoute == types.RouteVoteExtensionEquivocation {
+		return k.voteExtensionEquivocationHandler, true
+	}
+	if k.router == nil || !k.router.HasRoute(evidenceRoute) {
+		return nil, false
+	}
+
+	return k.router.GetRoute(evidenceRoute), true
+}
+
+// voteExtensionEquivocationHandler is the Handler of the built-in
+// VoteExtensionEquivocation evidence type.
+func (k Keeper) voteExtensionEquivocationHandler(ctx context.Context, evidence exported.Evidence) error {
+	e, ok := evidence.(*types.VoteExtensionEquivocation)
+	if !ok {
+		return fmt.Errorf("unexpected evidence type: %T", evidence)
+	}
+
+	return k.handleVoteExtensionEquivocationEvidence(ctx, e)
 }
 
 // SubmitEvidence attempts to match evidence against the keepers router and execute
@@ -101,11 +130,11 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 	if _, err := k.Evidences.Get(ctx, evidence.Hash()); err == nil {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
-	if !k.router.HasRoute(evidence.Route()) {
+	handler, ok := k.getHandler(evidence.Route())
+	if !ok {
 		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
 
-	handler := k.router.GetRoute(evidence.Route())
 	if err := handler(ctx, evidence); err != nil {
 		return errors.Wrap(types.ErrInvalidEvidence, err.Error())
 	}
@@ -1,20 +1,29 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/x/evidence/exported"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Evidence type constants
-const RouteEquivocation = "equivocation"
+const (
+	RouteEquivocation              = "equivocation"
+	RouteVoteExtensionEquivocation = "voteextensionequivocation"
+)
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &VoteExtensionEquivocation{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -88,3 +97,93 @@ func FromABCIEvidence(e comet.Evidence) *Equivocation {
 		Time:             e.Time(),
 	}
 }
+
+// NewVoteExtensionEquivocation returns a new VoteExtensionEquivocation of the
+// validator with the given consensus address, which signed both vote extensions
+// at the given height and round.
+func NewVoteExtensionEquivocation(
+	height, round int64, consAddr sdk.ConsAddress, voteExtensionA, signatureA, voteExtensionB, signatureB []byte,
+) *VoteExtensionEquivocation {
+	return &VoteExtensionEquivocation{
+		Height:           height,
+		Round:            round,
+		ConsensusAddress: consAddr.String(),
+		VoteExtensionA:   voteExtensionA,
+		SignatureA:       signatureA,
+		VoteExtensionB:   voteExtensionB,
+		SignatureB:       signatureB,
+	}
+}
+
+// Route returns the Evidence Handler route for a VoteExtensionEquivocation type.
+func (e *VoteExtensionEquivocation) Route() string { return RouteVoteExtensionEquivocation }
+
+// Hash returns the hash of a VoteExtensionEquivocation object. The hash does not
+// depend on the order of the conflicting vote extensions, so that the same
+// evidence cannot be submitted twice by swapping them.
+func (e *VoteExtensionEquivocation) Hash() []byte {
+	canonical := *e
+	if bytes.Compare(canonical.VoteExtensionA, canonical.VoteExtensionB) > 0 {
+		canonical.VoteExtensionA, canonical.VoteExtensionB = e.VoteExtensionB, e.VoteExtensionA
+		canonical.SignatureA, canonical.SignatureB = e.SignatureB, e.SignatureA
+	}
+
+	bz, err := canonical.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// VoteExtensionEquivocation object.
+func (e *VoteExtensionEquivocation) ValidateBasic() error {
+	if e.Height < 1 {
+		return fmt.Errorf("invalid vote extension equivocation height: %d", e.Height)
+	}
+	if e.Round < 0 {
+		return fmt.Errorf("invalid vote extension equivocation round: %d", e.Round)
+	}
+	if _, err := sdk.ConsAddressFromBech32(e.ConsensusAddress); err != nil {
+		return fmt.Errorf("invalid vote extension equivocation validator consensus address: %w", err)
+	}
+	if len(e.SignatureA) == 0 || len(e.SignatureB) == 0 {
+		return fmt.Errorf("invalid vote extension equivocation: empty vote extension signature")
+	}
+	if bytes.Equal(e.VoteExtensionA, e.VoteExtensionB) {
+		return fmt.Errorf("invalid vote extension equivocation: vote extensions are identical")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator which
+// signed the conflicting vote extensions.
+func (e VoteExtensionEquivocation) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height of the conflicting vote extensions.
+func (e VoteExtensionEquivocation) GetHeight() int64 {
+	return e.Height
+}
+
+// VoteExtensionSignBytes returns the bytes signed by a validator for a vote
+// extension at the given height and round, i.e. the length-delimited CometBFT
+// CanonicalVoteExtension.
+func VoteExtensionSignBytes(chainID string, height, round int64, extension []byte) ([]byte, error) {
+	cve := cmtproto.CanonicalVoteExtension{
+		Extension: extension,
+		Height:    height,
+		Round:     round,
+		ChainId:   chainID,
+	}
+
+	var buf bytes.Buffer
+	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cve); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
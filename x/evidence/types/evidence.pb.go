@@ -75,8 +75,66 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// VoteExtensionEquivocation implements the Evidence interface and defines
+// evidence of a validator signing two conflicting vote extensions for the same
+// height and round. The signatures are verified against the validator
+// consensus public key over the CometBFT CanonicalVoteExtension sign bytes.
+//
+// Since: cosmos-sdk 0.50
+type VoteExtensionEquivocation struct {
+	// height is the height of the conflicting vote extensions.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// round is the round of the conflicting vote extensions.
+	Round int64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// consensus_address is the equivocation validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,3,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// vote_extension_a is the first vote extension.
+	VoteExtensionA []byte `protobuf:"bytes,4,opt,name=vote_extension_a,json=voteExtensionA,proto3" json:"vote_extension_a,omitempty"`
+	// signature_a is the validator signature of the first vote extension.
+	SignatureA []byte `protobuf:"bytes,5,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	// vote_extension_b is the second vote extension, which conflicts with the
+	// first one.
+	VoteExtensionB []byte `protobuf:"bytes,6,opt,name=vote_extension_b,json=voteExtensionB,proto3" json:"vote_extension_b,omitempty"`
+	// signature_b is the validator signature of the second vote extension.
+	SignatureB []byte `protobuf:"bytes,7,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
+}
+
+func (m *VoteExtensionEquivocation) Reset()         { *m = VoteExtensionEquivocation{} }
+func (m *VoteExtensionEquivocation) String() string { return proto.CompactTextString(m) }
+func (*VoteExtensionEquivocation) ProtoMessage()    {}
+func (*VoteExtensionEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *VoteExtensionEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtensionEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtensionEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtensionEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtensionEquivocation.Merge(m, src)
+}
+func (m *VoteExtensionEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtensionEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtensionEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtensionEquivocation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*VoteExtensionEquivocation)(nil), "cosmos.evidence.v1beta1.VoteExtensionEquivocation")
 }
 
 func init() {
@@ -84,29 +142,36 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0xf4, 0x36, 0x4d, 0x10, 0xdb, 0x82, 0x5a, 0x2b, 0x02, 0x37, 0x12, 0x76, 0x54, 0x55, 0x28,
+	0xaa, 0x54, 0x5b, 0x81, 0x5b, 0x11, 0x87, 0x58, 0xca, 0x0f, 0x18, 0xc4, 0x81, 0x8b, 0x65, 0xc7,
+	0x0f, 0x77, 0x55, 0xb2, 0x2f, 0x78, 0xd7, 0xa6, 0xfc, 0x01, 0xe2, 0xd4, 0x4f, 0xe8, 0xb1, 0xc7,
+	0x1e, 0xf8, 0x88, 0x1e, 0x2b, 0x4e, 0x70, 0x01, 0x94, 0x1c, 0xda, 0xcf, 0x40, 0xd9, 0xdd, 0x04,
+	0x83, 0x82, 0x44, 0x2f, 0xd6, 0xce, 0x78, 0x76, 0x66, 0xde, 0x93, 0x4d, 0x1f, 0x8f, 0x50, 0x8c,
+	0x51, 0x04, 0x50, 0xb1, 0x0c, 0xf8, 0x08, 0x82, 0xaa, 0x9f, 0x82, 0x4c, 0xfa, 0x4b, 0xc2, 0x9f,
+	0x14, 0x28, 0xd1, 0x7e, 0xa8, 0x75, 0xfe, 0x92, 0x36, 0xba, 0xce, 0x76, 0x32, 0x66, 0x1c, 0x03,
+	0xf5, 0xd4, 0xda, 0x4e, 0x3b, 0xc7, 0x1c, 0xd5, 0x31, 0x98, 0x9f, 0x0c, 0xeb, 0xe5, 0x88, 0xf9,
+	0x5b, 0x08, 0x14, 0x4a, 0xcb, 0x37, 0x81, 0x64, 0x63, 0x10, 0x32, 0x19, 0x4f, 0x8c, 0x60, 0x47,
+	0x47, 0xc4, 0xfa, 0xa6, 0xc9, 0x53, 0x60, 0xf7, 0x86, 0xd0, 0xcd, 0xe1, 0xbb, 0x92, 0x55, 0x38,
+	0x4a, 0x24, 0x43, 0x6e, 0x3f, 0xa0, 0xad, 0x23, 0x60, 0xf9, 0x91, 0x74, 0x48, 0x97, 0xf4, 0x1a,
+	0x91, 0x41, 0xf6, 0x73, 0xba, 0x3e, 0xb7, 0x75, 0xd6, 0xba, 0xa4, 0xb7, 0xf1, 0xa4, 0xe3, 0xeb,
+	0x4c, 0x7f, 0x91, 0xe9, 0xbf, 0x5c, 0x64, 0x86, 0xf7, 0x2e, 0xbf, 0x7b, 0xd6, 0xe9, 0x0f, 0x8f,
+	0x9c, 0x5f, 0x5f, 0xec, 0x93, 0x48, 0x5d, 0xb3, 0xdb, 0xb4, 0x39, 0xc1, 0xf7, 0x50, 0x38, 0x0d,
+	0xe5, 0xaa, 0x81, 0x3d, 0xa4, 0xdb, 0x23, 0xe4, 0x02, 0xb8, 0x28, 0x45, 0x9c, 0x64, 0x59, 0x01,
+	0x42, 0x38, 0xeb, 0x5d, 0xd2, 0xbb, 0x1b, 0x3a, 0x5f, 0x3e, 0x1f, 0xb4, 0x4d, 0xd5, 0x81, 0x7e,
+	0xf3, 0x42, 0x16, 0x8c, 0xe7, 0xd1, 0xd6, 0xf2, 0x8a, 0xe1, 0x0f, 0xf7, 0x3e, 0x9e, 0x79, 0xd6,
+	0xcd, 0x99, 0x67, 0x7d, 0xba, 0xbe, 0xd8, 0x37, 0xfb, 0x3c, 0x10, 0xd9, 0x71, 0x50, 0x9f, 0x6c,
+	0xf7, 0xdb, 0x1a, 0xdd, 0x79, 0x85, 0x12, 0x86, 0x27, 0x12, 0xb8, 0x60, 0xc8, 0xff, 0x6b, 0xee,
+	0x36, 0x6d, 0x16, 0x58, 0xf2, 0x4c, 0x0d, 0xde, 0x88, 0x34, 0x58, 0x5d, 0xbc, 0x71, 0xdb, 0xe2,
+	0x76, 0x8f, 0x6e, 0x55, 0x28, 0x21, 0x86, 0x45, 0xa5, 0x38, 0x51, 0xe3, 0x6f, 0x46, 0xf7, 0xab,
+	0x7a, 0xd3, 0x81, 0xed, 0xd1, 0x0d, 0xc1, 0x72, 0x9e, 0xc8, 0xb2, 0x80, 0x38, 0x71, 0x9a, 0x4a,
+	0x44, 0x97, 0xd4, 0x60, 0x85, 0x55, 0xea, 0xb4, 0x56, 0x58, 0x85, 0x7f, 0x5a, 0xa5, 0xce, 0x9d,
+	0xbf, 0xac, 0xc2, 0xc3, 0x7e, 0x7d, 0x9d, 0x7b, 0xb5, 0x75, 0xfe, 0x73, 0x7b, 0xe1, 0xb3, 0xf3,
+	0xa9, 0x4b, 0x2e, 0xa7, 0x2e, 0xb9, 0x9a, 0xba, 0xe4, 0xe7, 0xd4, 0x25, 0xa7, 0x33, 0xd7, 0xba,
+	0x9a, 0xb9, 0xd6, 0xd7, 0x99, 0x6b, 0xbd, 0x7e, 0xa4, 0x3d, 0x44, 0x76, 0xec, 0x33, 0x0c, 0x4e,
+	0x7e, 0xff, 0x12, 0xf2, 0xc3, 0x04, 0x44, 0xda, 0x52, 0x1f, 0xd1, 0xd3, 0x5f, 0x03, 0x00, 0xab,
+	0x4a, 0x52, 0x68, 0x32, 0x03, 0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -157,6 +222,74 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteExtensionEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtensionEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtensionEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignatureB) > 0 {
+		i -= len(m.SignatureB)
+		copy(dAtA[i:], m.SignatureB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.SignatureB)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VoteExtensionB) > 0 {
+		i -= len(m.VoteExtensionB)
+		copy(dAtA[i:], m.VoteExtensionB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.VoteExtensionB)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SignatureA) > 0 {
+		i -= len(m.SignatureA)
+		copy(dAtA[i:], m.SignatureA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.SignatureA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VoteExtensionA) > 0 {
+		i -= len(m.VoteExtensionA)
+		copy(dAtA[i:], m.VoteExtensionA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.VoteExtensionA)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +322,41 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *VoteExtensionEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEvidence(uint64(m.Round))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.VoteExtensionA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.SignatureA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.VoteExtensionB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.SignatureB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +516,262 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteExtensionEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtensionEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtensionEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtensionA = append(m.VoteExtensionA[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtensionA == nil {
+				m.VoteExtensionA = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureA = append(m.SignatureA[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignatureA == nil {
+				m.SignatureA = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtensionB = append(m.VoteExtensionB[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtensionB == nil {
+				m.VoteExtensionB = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureB = append(m.SignatureB[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignatureB == nil {
+				m.SignatureB = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
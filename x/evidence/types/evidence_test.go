@@ -134,3 +134,45 @@ func (v validator) Address() []byte {
 func (v validator) Power() int64 {
 	return v.power
 }
+
+func TestVoteExtensionEquivocationValidateBasic(t *testing.T) {
+	addr := sdk.ConsAddress("foo_________________")
+	sig := []byte("signature")
+
+	testCases := []struct {
+		name      string
+		e         *types.VoteExtensionEquivocation
+		expectErr bool
+	}{
+		{"valid", types.NewVoteExtensionEquivocation(100, 0, addr, []byte("a"), sig, []byte("b"), sig), false},
+		{"valid empty vote extension", types.NewVoteExtensionEquivocation(100, 0, addr, nil, sig, []byte("b"), sig), false},
+		{"invalid height", types.NewVoteExtensionEquivocation(0, 0, addr, []byte("a"), sig, []byte("b"), sig), true},
+		{"invalid round", types.NewVoteExtensionEquivocation(100, -1, addr, []byte("a"), sig, []byte("b"), sig), true},
+		{"invalid address", types.NewVoteExtensionEquivocation(100, 0, sdk.ConsAddress{}, []byte("a"), sig, []byte("b"), sig), true},
+		{"empty signature", types.NewVoteExtensionEquivocation(100, 0, addr, []byte("a"), nil, []byte("b"), sig), true},
+		{"identical vote extensions", types.NewVoteExtensionEquivocation(100, 0, addr, []byte("a"), sig, []byte("a"), sig), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestVoteExtensionEquivocationHash(t *testing.T) {
+	addr := sdk.ConsAddress("foo_________________")
+
+	e := types.NewVoteExtensionEquivocation(100, 1, addr, []byte("a"), []byte("sig_a"), []byte("b"), []byte("sig_b"))
+	require.Equal(t, types.RouteVoteExtensionEquivocation, e.Route())
+	require.Equal(t, addr, e.GetConsensusAddress())
+	require.Equal(t, int64(100), e.GetHeight())
+
+	// the hash does not depend on the order of the vote extensions
+	swapped := types.NewVoteExtensionEquivocation(100, 1, addr, []byte("b"), []byte("sig_b"), []byte("a"), []byte("sig_a"))
+	require.Equal(t, e.Hash(), swapped.Hash())
+
+	other := types.NewVoteExtensionEquivocation(100, 2, addr, []byte("a"), []byte("sig_a"), []byte("b"), []byte("sig_b"))
+	require.NotEqual(t, e.Hash(), other.Hash())
+}
@@ -35,12 +35,13 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 		if err != nil {
 			return false, err
 		}
-		if !params.BurnProposalDepositPrevote {
-			err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id) // refund deposit if proposal got removed without getting 100% of the proposal
-		} else {
-			err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id) // burn the deposit if proposal got removed without getting 100% of the proposal
+		// refund, burn or send to the community pool the deposit if proposal got removed without getting 100% of the proposal
+		err = keeper.DistributeAndDeleteDeposits(ctx, proposal.Id, params.DepositPolicyFor(v1.ProposalOutcomeDepositPeriodExpired))
+		if err != nil {
+			return false, err
 		}
 
+		minDeposit, err := keeper.GetMinDeposit(ctx, params, proposal.Expedited)
 		if err != nil {
 			return false, err
 		}
@@ -61,7 +62,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			"proposal", proposal.Id,
 			"expedited", proposal.Expedited,
 			"title", proposal.Title,
-			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(params)...).String(),
+			"min_deposit", minDeposit.String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -81,22 +82,24 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults, err := keeper.Tally(ctx, proposal)
+		outcome, tallyResults, err := keeper.TallyAndSetBreakdown(ctx, proposal)
 		if err != nil {
 			return false, err
 		}
+		passes := outcome == v1.ProposalOutcomePassed
 
 		// If an expedited proposal fails, we do not want to update
 		// the deposit at this point since the proposal is converted to regular.
-		// As a result, the deposits are either deleted or refunded in all cases
-		// EXCEPT when an expedited proposal fails.
+		// As a result, the deposits are distributed according to the deposit
+		// policy of the proposal outcome in all cases EXCEPT when an expedited
+		// proposal fails.
 		if !(proposal.Expedited && !passes) {
-			if burnDeposits {
-				err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-			} else {
-				err = keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+			params, err := keeper.Params.Get(ctx)
+			if err != nil {
+				return false, err
 			}
 
+			err = keeper.DistributeAndDeleteDeposits(ctx, proposal.Id, params.DepositPolicyFor(outcome))
 			if err != nil {
 				return false, err
 			}
@@ -179,6 +182,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 			tagValue = types.AttributeValueExpeditedProposalRejected
 			logMsg = "expedited proposal converted to regular"
+		case proposal.Optimistic:
+			proposal.Status = v1.StatusRejected
+			tagValue = types.AttributeValueOptimisticProposalRejected
+			logMsg = "optimistic proposal rejected"
 		default:
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
@@ -200,6 +207,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			"proposal", proposal.Id,
 			"status", proposal.Status.String(),
 			"expedited", proposal.Expedited,
+			"optimistic", proposal.Optimistic,
 			"title", proposal.Title,
 			"results", logMsg,
 		)
@@ -218,5 +226,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
 		return err
 	}
-	return nil
+
+	// delete the per voter tally breakdowns whose retention period ended
+	return keeper.PruneVoterTallies(ctx)
 }
@@ -111,7 +111,10 @@ Where proposal.json contains:
   "deposit": "10stake"
   "title: "My proposal"
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  // optimistic proposals pass unless enough no votes are cast, and can only be
+  // submitted by the optimistic authorized addresses
+  "optimistic": false
 }
 
 metadata example: 
@@ -142,6 +145,7 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Optimistic = proposal.Optimistic
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -74,6 +74,54 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		}
 	}
 
+	for _, breakdown := range data.TallyBreakdowns {
+		err := k.TallyBreakdowns.Set(ctx, breakdown.ProposalId, *breakdown)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, valTally := range data.ValidatorTallies {
+		valAddr, err := sdk.ValAddressFromBech32(valTally.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorTallies.Set(ctx, collections.Join(valTally.ProposalId, valAddr), *valTally)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// the per voter tally breakdowns are only imported if they are retained, and are pruned
+	// at the end of the retention period following the end of the voting period of their proposal
+	proposals := make(map[uint64]*v1.Proposal, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		proposals[proposal.Id] = proposal
+	}
+	for _, voterTally := range data.VoterTallies {
+		proposal, ok := proposals[voterTally.ProposalId]
+		if !ok {
+			panic(fmt.Sprintf("voter tally of unknown proposal %d", voterTally.ProposalId))
+		}
+		pruningTime, ok := data.Params.VoterTalliesPruningTime(*proposal)
+		if !ok {
+			continue
+		}
+
+		addr, err := ak.AddressCodec().StringToBytes(voterTally.Voter)
+		if err != nil {
+			panic(err)
+		}
+		err = k.VoterTallies.Set(ctx, collections.Join(voterTally.ProposalId, sdk.AccAddress(addr)), *voterTally)
+		if err != nil {
+			panic(err)
+		}
+		err = k.VoterTalliesQueue.Set(ctx, collections.Join(pruningTime, voterTally.ProposalId))
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,6 +179,33 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		panic(err)
 	}
 
+	var tallyBreakdowns []*v1.TallyBreakdown
+	err = k.TallyBreakdowns.Walk(ctx, nil, func(_ uint64, value v1.TallyBreakdown) (stop bool, err error) {
+		tallyBreakdowns = append(tallyBreakdowns, &value)
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	var validatorTallies []*v1.ValidatorTally
+	err = k.ValidatorTallies.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.ValAddress], value v1.ValidatorTally) (stop bool, err error) {
+		validatorTallies = append(validatorTallies, &value)
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	var voterTallies []*v1.VoterTally
+	err = k.VoterTallies.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], value v1.VoterTally) (stop bool, err error) {
+		voterTallies = append(voterTallies, &value)
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
@@ -138,5 +213,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		Proposals:          proposals,
 		Params:             &params,
 		Constitution:       constitution,
+		TallyBreakdowns:    tallyBreakdowns,
+		ValidatorTallies:   validatorTallies,
+		VoterTallies:       voterTallies,
 	}, nil
 }
@@ -2,7 +2,9 @@ package gov_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
@@ -36,3 +38,85 @@ func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, genState, v1.DefaultGenesisState())
 }
+
+func TestImportExportTallyBreakdowns(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Unix(1000, 0).UTC())
+
+	votingEndTime := ctx.BlockTime().Add(-time.Hour)
+	voter := sdk.AccAddress("voter_______________")
+	valAddr := sdk.ValAddress("validator___________")
+	options := v1.NewNonSplitVoteOption(v1.OptionYes)
+
+	genState := v1.DefaultGenesisState()
+	genState.Proposals = v1.Proposals{
+		{
+			Id:               1,
+			Status:           v1.StatusRejected,
+			FinalTallyResult: &v1.TallyResult{YesCount: "10", AbstainCount: "0", NoCount: "20", NoWithVetoCount: "0"},
+			SubmitTime:       &votingEndTime,
+			DepositEndTime:   &votingEndTime,
+			VotingStartTime:  &votingEndTime,
+			VotingEndTime:    &votingEndTime,
+		},
+	}
+	genState.TallyBreakdowns = []*v1.TallyBreakdown{
+		{
+			ProposalId:       1,
+			DirectTally:      &v1.TallyResult{YesCount: "10", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"},
+			InheritedTally:   &v1.TallyResult{YesCount: "0", AbstainCount: "0", NoCount: "20", NoWithVetoCount: "0"},
+			OverridingVoters: 1,
+		},
+	}
+	genState.ValidatorTallies = []*v1.ValidatorTally{
+		{ProposalId: 1, ValidatorAddress: valAddr.String(), Options: v1.NewNonSplitVoteOption(v1.OptionNo), InheritedPower: "20", OverriddenPower: "10"},
+	}
+	genState.VoterTallies = []*v1.VoterTally{
+		{ProposalId: 1, Voter: voter.String(), Options: options, Power: "10", OverriddenValidators: []string{valAddr.String()}},
+	}
+	require.NoError(t, v1.ValidateGenesis(genState))
+
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, genState)
+	exported, err := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.NoError(t, err)
+	require.Equal(t, genState.TallyBreakdowns, exported.TallyBreakdowns)
+	require.Equal(t, genState.ValidatorTallies, exported.ValidatorTallies)
+	require.Equal(t, genState.VoterTallies, exported.VoterTallies)
+
+	// the voter tallies are queued for pruning at the end of the retention period
+	pruningTime := votingEndTime.Add(*genState.Params.VoterTallyRetention)
+	has, err := suite.GovKeeper.VoterTalliesQueue.Has(ctx, collections.Join(pruningTime, uint64(1)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, suite.GovKeeper.PruneVoterTallies(ctx.WithBlockTime(pruningTime)))
+	exported, err = gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.NoError(t, err)
+	require.Empty(t, exported.VoterTallies)
+	require.Equal(t, genState.TallyBreakdowns, exported.TallyBreakdowns)
+	require.Equal(t, genState.ValidatorTallies, exported.ValidatorTallies)
+}
+
+func TestImportTallyBreakdownsWithoutVoterTallyRetention(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false)
+
+	votingEndTime := time.Unix(1000, 0).UTC()
+	genState := v1.DefaultGenesisState()
+	genState.Params.VoterTallyRetention = nil
+	genState.Proposals = v1.Proposals{
+		{Id: 1, Status: v1.StatusPassed, SubmitTime: &votingEndTime, VotingEndTime: &votingEndTime},
+	}
+	genState.TallyBreakdowns = []*v1.TallyBreakdown{{ProposalId: 1}}
+	genState.VoterTallies = []*v1.VoterTally{
+		{ProposalId: 1, Voter: sdk.AccAddress("voter_______________").String(), Power: "10"},
+	}
+
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, genState)
+	exported, err := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.NoError(t, err)
+	require.Len(t, exported.TallyBreakdowns, 1)
+	require.Empty(t, exported.VoterTallies)
+}
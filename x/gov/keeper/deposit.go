@@ -89,7 +89,10 @@ func (keeper Keeper) AddDeposit(ctx context.Context, proposalID uint64, deposito
 	if err != nil {
 		return false, err
 	}
-	minDepositAmount := proposal.GetMinDepositFromParams(params)
+	minDepositAmount, err := keeper.GetMinDeposit(ctx, params, proposal.Expedited)
+	if err != nil {
+		return false, err
+	}
 
 	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDepositAmount) {
 		err = keeper.ActivateVotingPeriod(ctx, proposal)
@@ -231,6 +234,102 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx context.Context, proposalID uin
 	})
 }
 
+// DistributeAndDeleteDeposits distributes and deletes all the deposits on a specific proposal
+// according to the given deposit policy: the burn ratio of each deposit is burned, the community
+// pool ratio is sent to the community pool and the remaining amount is refunded to the depositor.
+func (keeper Keeper) DistributeAndDeleteDeposits(ctx context.Context, proposalID uint64, policy v1.DepositPolicy) error {
+	burnRatio, err := sdkmath.LegacyNewDecFromStr(policy.BurnRatio)
+	if err != nil {
+		return err
+	}
+	communityPoolRatio, err := sdkmath.LegacyNewDecFromStr(policy.CommunityPoolRatio)
+	if err != nil {
+		return err
+	}
+
+	var coinsToBurn, coinsToCommunityPool sdk.Coins
+	err = keeper.IterateDeposits(ctx, proposalID, func(key collections.Pair[uint64, sdk.AccAddress], deposit v1.Deposit) (bool, error) {
+		var refundAmount sdk.Coins
+		for _, coin := range deposit.Amount {
+			burnAmount := sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(burnRatio).TruncateInt()
+			communityPoolAmount := sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(communityPoolRatio).TruncateInt()
+
+			coinsToBurn = coinsToBurn.Add(sdk.NewCoin(coin.Denom, burnAmount))
+			coinsToCommunityPool = coinsToCommunityPool.Add(sdk.NewCoin(coin.Denom, communityPoolAmount))
+			// refund amount = deposit amount - burn amount - community pool amount
+			refundAmount = refundAmount.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(burnAmount).Sub(communityPoolAmount)))
+		}
+
+		if !refundAmount.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, key.K2(), refundAmount)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		return false, keeper.Deposits.Remove(ctx, key)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !coinsToBurn.IsZero() {
+		if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
+			return err
+		}
+	}
+
+	if !coinsToCommunityPool.IsZero() {
+		if err := keeper.distrKeeper.FundCommunityPool(ctx, coinsToCommunityPool, keeper.ModuleAccountAddress()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMinDeposit returns the minimum deposit for a proposal to enter voting period. The minimum
+// deposit of the params increases by the min deposit increase ratio for each proposal currently
+// in voting period.
+func (keeper Keeper) GetMinDeposit(ctx context.Context, params v1.Params, expedited bool) (sdk.Coins, error) {
+	minDeposit := sdk.NewCoins(params.MinDeposit...)
+	if expedited {
+		minDeposit = sdk.NewCoins(params.ExpeditedMinDeposit...)
+	}
+
+	increaseRatio := params.GetMinDepositIncreaseRatioDec()
+	if increaseRatio.IsZero() {
+		return minDeposit, nil
+	}
+
+	activeProposals, err := keeper.GetActiveProposalsCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// min deposit * (1 + increase ratio * active proposals)
+	multiplier := sdkmath.LegacyOneDec().Add(increaseRatio.MulInt64(int64(activeProposals)))
+	for i := range minDeposit {
+		minDeposit[i].Amount = sdkmath.LegacyNewDecFromInt(minDeposit[i].Amount).Mul(multiplier).Ceil().TruncateInt()
+	}
+
+	return minDeposit, nil
+}
+
+// GetActiveProposalsCount returns the number of proposals in voting period.
+func (keeper Keeper) GetActiveProposalsCount(ctx context.Context) (uint64, error) {
+	var count uint64
+	err := keeper.VotingPeriodProposals.Walk(ctx, nil, func(uint64, []byte) (bool, error) {
+		count++
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
 // the deposit parameters. Returns nil on success, error otherwise.
@@ -248,11 +347,9 @@ func (keeper Keeper) validateInitialDeposit(ctx context.Context, initialDeposit
 		return nil
 	}
 
-	var minDepositCoins sdk.Coins
-	if expedited {
-		minDepositCoins = params.ExpeditedMinDeposit
-	} else {
-		minDepositCoins = params.MinDeposit
+	minDepositCoins, err := keeper.GetMinDeposit(ctx, params, expedited)
+	if err != nil {
+		return err
 	}
 
 	for i := range minDepositCoins {
@@ -374,3 +374,103 @@ func TestChargeDeposit(t *testing.T) {
 		}
 	}
 }
+
+func TestDistributeAndDeleteDeposits(t *testing.T) {
+	testCases := []struct {
+		name               string
+		burnRatio          string
+		communityPoolRatio string
+		expRefund          int64
+		expCommunityPool   int64
+	}{
+		{
+			name:               "refund all",
+			burnRatio:          "0",
+			communityPoolRatio: "0",
+			expRefund:          1000,
+		},
+		{
+			name:               "burn all",
+			burnRatio:          "1",
+			communityPoolRatio: "0",
+			expRefund:          0,
+		},
+		{
+			name:               "burn, send to community pool and refund",
+			burnRatio:          "0.25",
+			communityPoolRatio: "0.5",
+			expRefund:          250,
+			expCommunityPool:   500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000000))
+			authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+			require.NoError(t, err)
+
+			deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], deposit)
+			require.NoError(t, err)
+			// the gov module account balance is not tracked, so credit the deposit to it
+			// for the community pool funding.
+			err = bankKeeper.SendCoinsFromModuleToAccount(ctx, "gov", govKeeper.ModuleAccountAddress(), deposit)
+			require.NoError(t, err)
+
+			depositorBalance := bankKeeper.GetBalance(ctx, TestAddrs[0], sdk.DefaultBondDenom)
+			communityPoolBalance := bankKeeper.GetBalance(ctx, distAcct, sdk.DefaultBondDenom)
+
+			policy := v1.DepositPolicy{BurnRatio: tc.burnRatio, CommunityPoolRatio: tc.communityPoolRatio}
+			require.NoError(t, govKeeper.DistributeAndDeleteDeposits(ctx, proposal.Id, policy))
+
+			deposits, err := govKeeper.GetDeposits(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Empty(t, deposits)
+			require.Equal(t, depositorBalance.AddAmount(sdkmath.NewInt(tc.expRefund)), bankKeeper.GetBalance(ctx, TestAddrs[0], sdk.DefaultBondDenom))
+			require.Equal(t, communityPoolBalance.AddAmount(sdkmath.NewInt(tc.expCommunityPool)), bankKeeper.GetBalance(ctx, distAcct, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+func TestGetMinDeposit(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000000))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	params := v1.DefaultParams()
+	params.MinDepositIncreaseRatio = "0.5"
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	minDeposit, err := govKeeper.GetMinDeposit(ctx, params, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(params.MinDeposit...), minDeposit)
+
+	for i := 0; i < 2; i++ {
+		proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], false)
+		require.NoError(t, err)
+		require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	}
+
+	activeProposals, err := govKeeper.GetActiveProposalsCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), activeProposals)
+
+	// min deposit * (1 + 0.5 * 2)
+	minDeposit, err = govKeeper.GetMinDeposit(ctx, params, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(params.MinDeposit...).MulInt(sdkmath.NewInt(2)), minDeposit)
+
+	expeditedMinDeposit, err := govKeeper.GetMinDeposit(ctx, params, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(params.ExpeditedMinDeposit...).MulInt(sdkmath.NewInt(2)), expeditedMinDeposit)
+
+	// the initial deposit is validated against the increased min deposit
+	params.MinInitialDepositRatio = "1"
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+	require.Error(t, govKeeper.ValidateInitialDeposit(ctx, params.MinDeposit, false))
+	require.NoError(t, govKeeper.ValidateInitialDeposit(ctx, minDeposit, false))
+}
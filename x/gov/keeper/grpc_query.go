@@ -278,6 +278,166 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// MinDeposit queries the current minimum deposit of a proposal
+func (q queryServer) MinDeposit(ctx context.Context, req *v1.QueryMinDepositRequest) (*v1.QueryMinDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minDeposit, err := q.k.GetMinDeposit(ctx, params, false)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	expeditedMinDeposit, err := q.k.GetMinDeposit(ctx, params, true)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	activeProposals, err := q.k.GetActiveProposalsCount(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryMinDepositResponse{
+		MinDeposit:          minDeposit,
+		ExpeditedMinDeposit: expeditedMinDeposit,
+		ActiveProposals:     activeProposals,
+	}, nil
+}
+
+// TallyBreakdown queries the tally breakdown of a proposal, stored when its voting period ended
+func (q queryServer) TallyBreakdown(ctx context.Context, req *v1.QueryTallyBreakdownRequest) (*v1.QueryTallyBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	breakdown, err := q.k.TallyBreakdowns.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "tally breakdown of proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryTallyBreakdownResponse{TallyBreakdown: &breakdown}, nil
+}
+
+// ValidatorTallies returns the per validator tally breakdown of a proposal
+func (q queryServer) ValidatorTallies(ctx context.Context, req *v1.QueryValidatorTalliesRequest) (*v1.QueryValidatorTalliesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var tallies []*v1.ValidatorTally
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, q.k.ValidatorTallies, req.Pagination, func(_ collections.Pair[uint64, sdk.ValAddress], value v1.ValidatorTally) (include bool, err error) {
+		tallies = append(tallies, &value)
+		return false, nil // not including results because they're being appended.
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.ValAddress](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryValidatorTalliesResponse{ValidatorTallies: tallies, Pagination: pageRes}, nil
+}
+
+// VoterTally returns the tally breakdown of a proposal for a single voter
+func (q queryServer) VoterTally(ctx context.Context, req *v1.QueryVoterTallyRequest) (*v1.QueryVoterTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	voterTally, err := q.k.VoterTallies.Get(ctx, collections.Join(req.ProposalId, sdk.AccAddress(voter)))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound,
+				"voter: %v tally not found for proposal: %v", req.Voter, req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoterTallyResponse{VoterTally: &voterTally}, nil
+}
+
+// VoterTallies returns the per voter tally breakdown of a proposal
+func (q queryServer) VoterTallies(ctx context.Context, req *v1.QueryVoterTalliesRequest) (*v1.QueryVoterTalliesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var tallies []*v1.VoterTally
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, q.k.VoterTallies, req.Pagination, func(_ collections.Pair[uint64, sdk.AccAddress], value v1.VoterTally) (include bool, err error) {
+		tallies = append(tallies, &value)
+		return false, nil // not including results because they're being appended.
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoterTalliesResponse{VoterTallies: tallies, Pagination: pageRes}, nil
+}
+
+// LiveTally queries the current tally and tally breakdown of a proposal in voting period
+func (q queryServer) LiveTally(ctx context.Context, req *v1.QueryLiveTallyRequest) (*v1.QueryLiveTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	// tallying removes the votes of the proposal, so it is done on a branch of the state
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	_, tallyResult, breakdown, err := q.k.tally(cacheCtx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryLiveTallyResponse{Tally: &tallyResult, TallyBreakdown: &breakdown.summary}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -885,6 +886,32 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryMinDeposit() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	params := v1.DefaultParams()
+	params.MinDepositIncreaseRatio = "0.1"
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+
+	res, err := queryClient.MinDeposit(gocontext.Background(), &v1.QueryMinDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.ActiveProposals)
+	suite.Require().Equal(sdk.NewCoins(params.MinDeposit...), res.MinDeposit)
+	suite.Require().Equal(sdk.NewCoins(params.ExpeditedMinDeposit...), res.ExpeditedMinDeposit)
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	// min deposit * (1 + 0.1 * 1)
+	res, err = queryClient.MinDeposit(gocontext.Background(), &v1.QueryMinDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.ActiveProposals)
+	suite.Require().Equal(sdk.NewCoins(params.MinDeposit...).MulInt(math.NewInt(11)).QuoInt(math.NewInt(10)), res.MinDeposit)
+	suite.Require().Equal(sdk.NewCoins(params.ExpeditedMinDeposit...).MulInt(math.NewInt(11)).QuoInt(math.NewInt(10)), res.ExpeditedMinDeposit)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryParams() {
 	queryClient := suite.legacyQueryClient
 
@@ -1506,6 +1533,110 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryTallyBreakdown() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.TallyBreakdown(gocontext.Background(), &v1.QueryTallyBreakdownRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.TallyBreakdown(gocontext.Background(), &v1.QueryTallyBreakdownRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	directTally := v1.NewTallyResult(math.NewInt(4), math.ZeroInt(), math.NewInt(1), math.ZeroInt())
+	inheritedTally := v1.NewTallyResult(math.NewInt(10), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
+	breakdown := v1.TallyBreakdown{ProposalId: 1, DirectTally: &directTally, InheritedTally: &inheritedTally, OverridingVoters: 1}
+	suite.Require().NoError(suite.govKeeper.TallyBreakdowns.Set(ctx, 1, breakdown))
+
+	res, err := queryClient.TallyBreakdown(gocontext.Background(), &v1.QueryTallyBreakdownRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(breakdown.String(), res.TallyBreakdown.String())
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorAndVoterTallies() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+	valAddr := sdk.ValAddress(addrs[0])
+
+	valTally := v1.ValidatorTally{
+		ProposalId:       1,
+		ValidatorAddress: valAddr.String(),
+		Options:          v1.NewNonSplitVoteOption(v1.OptionYes),
+		InheritedPower:   "10",
+		OverriddenPower:  "1",
+	}
+	suite.Require().NoError(suite.govKeeper.ValidatorTallies.Set(ctx, collections.Join(uint64(1), valAddr), valTally))
+
+	voterTallies := []v1.VoterTally{
+		{ProposalId: 1, Voter: addrs[0].String(), Options: v1.NewNonSplitVoteOption(v1.OptionYes), Power: "4"},
+		{ProposalId: 1, Voter: addrs[1].String(), Options: v1.NewNonSplitVoteOption(v1.OptionNo), Power: "1", OverriddenValidators: []string{valAddr.String()}},
+	}
+	for i, voterTally := range voterTallies {
+		suite.Require().NoError(suite.govKeeper.VoterTallies.Set(ctx, collections.Join(uint64(1), addrs[i]), voterTally))
+	}
+
+	valRes, err := queryClient.ValidatorTallies(gocontext.Background(), &v1.QueryValidatorTalliesRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(valRes.ValidatorTallies, 1)
+	suite.Require().Equal(valTally.String(), valRes.ValidatorTallies[0].String())
+
+	valRes, err = queryClient.ValidatorTallies(gocontext.Background(), &v1.QueryValidatorTalliesRequest{ProposalId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Empty(valRes.ValidatorTallies)
+
+	votersRes, err := queryClient.VoterTallies(gocontext.Background(), &v1.QueryVoterTalliesRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(votersRes.VoterTallies, 2)
+
+	voterRes, err := queryClient.VoterTally(gocontext.Background(), &v1.QueryVoterTallyRequest{ProposalId: 1, Voter: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(voterTallies[1].String(), voterRes.VoterTally.String())
+
+	_, err = queryClient.VoterTally(gocontext.Background(), &v1.QueryVoterTallyRequest{ProposalId: 1, Voter: addrs[2].String()})
+	suite.Require().Error(err)
+
+	_, err = queryClient.VoterTally(gocontext.Background(), &v1.QueryVoterTallyRequest{ProposalId: 1})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryLiveTally() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.LiveTally(gocontext.Background(), &v1.QueryLiveTallyRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	propTime := time.Now()
+	proposal := v1.Proposal{
+		Id:              1,
+		Status:          v1.StatusDepositPeriod,
+		SubmitTime:      &propTime,
+		VotingStartTime: &propTime,
+		VotingEndTime:   &propTime,
+		Metadata:        "proposal metadata",
+	}
+	suite.Require().NoError(suite.govKeeper.SetProposal(ctx, proposal))
+
+	_, err = queryClient.LiveTally(gocontext.Background(), &v1.QueryLiveTallyRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	proposal.Status = v1.StatusVotingPeriod
+	suite.Require().NoError(suite.govKeeper.SetProposal(ctx, proposal))
+
+	res, err := queryClient.LiveTally(gocontext.Background(), &v1.QueryLiveTallyRequest{ProposalId: 1})
+	suite.Require().NoError(err)
+	emptyTally := v1.EmptyTallyResult()
+	suite.Require().Equal(emptyTally.String(), res.Tally.String())
+	suite.Require().Equal(emptyTally.String(), res.TallyBreakdown.DirectTally.String())
+	suite.Require().Equal(emptyTally.String(), res.TallyBreakdown.InheritedTally.String())
+	suite.Require().Zero(res.TallyBreakdown.OverridingVoters)
+
+	// the live tally does not persist a breakdown
+	has, err := suite.govKeeper.TallyBreakdowns.Has(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	ctx, queryClient := suite.ctx, suite.legacyQueryClient
@@ -57,6 +57,10 @@ type Keeper struct {
 	ActiveProposalsQueue   collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	VotingPeriodProposals  collections.Map[uint64, []byte]                              // TODO(tip): this could be a keyset or index.
+	TallyBreakdowns        collections.Map[uint64, v1.TallyBreakdown]
+	ValidatorTallies       collections.Map[collections.Pair[uint64, sdk.ValAddress], v1.ValidatorTally]
+	VoterTallies           collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.VoterTally]
+	VoterTalliesQueue      collections.KeySet[collections.Pair[time.Time, uint64]]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -110,6 +114,10 @@ func NewKeeper(
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
 		VotingPeriodProposals:  collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
+		TallyBreakdowns:        collections.NewMap(sb, types.TallyBreakdownsKeyPrefix, "tally_breakdowns", collections.Uint64Key, codec.CollValue[v1.TallyBreakdown](cdc)),
+		ValidatorTallies:       collections.NewMap(sb, types.ValidatorTalliesKeyPrefix, "validator_tallies", collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey), codec.CollValue[v1.ValidatorTally](cdc)),
+		VoterTallies:           collections.NewMap(sb, types.VoterTalliesKeyPrefix, "voter_tallies", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[v1.VoterTally](cdc)),
+		VoterTalliesQueue:      collections.NewKeySet(sb, types.VoterTalliesQueuePrefix, "voter_tallies_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -39,6 +39,10 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
 	}
 
+	if msg.Expedited && msg.Optimistic {
+		return nil, errors.Wrap(govtypes.ErrInvalidProposal, "proposal cannot be both expedited and optimistic")
+	}
+
 	proposer, err := k.authKeeper.AddressCodec().StringToBytes(msg.GetProposer())
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
@@ -82,7 +86,12 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	var proposal v1.Proposal
+	if msg.Optimistic {
+		proposal, err = k.Keeper.SubmitOptimisticProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	} else {
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	}
 	if err != nil {
 		return nil, err
 	}
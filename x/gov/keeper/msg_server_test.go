@@ -57,6 +57,40 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 			expErr:    true,
 			expErrMsg: "invalid proposer address",
 		},
+		"expedited and optimistic": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					true,
+				)
+				msg.Optimistic = true
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "proposal cannot be both expedited and optimistic",
+		},
+		"unauthorized optimistic proposer": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.Optimistic = true
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "not authorized to submit optimistic proposals",
+		},
 		"empty msgs and metadata": {
 			preRun: func() (*v1.MsgSubmitProposal, error) {
 				return v1.NewMsgSubmitProposal(
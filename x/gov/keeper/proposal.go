@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -16,6 +17,32 @@ import (
 
 // SubmitProposal creates a new proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, expedited, false)
+}
+
+// SubmitOptimisticProposal creates a new optimistic proposal given an array of messages.
+// An optimistic proposal passes at the end of its voting period unless the optimistic
+// rejected threshold of no votes is reached. Only the optimistic authorized addresses
+// of the params can submit optimistic proposals.
+func (keeper Keeper) SubmitOptimisticProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	proposerAddr, err := keeper.authKeeper.AddressCodec().BytesToString(proposer)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if !params.IsOptimisticAuthorized(proposerAddr) {
+		return v1.Proposal{}, types.ErrInvalidProposer.Wrapf("%s is not authorized to submit optimistic proposals", proposerAddr)
+	}
+
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, false, true)
+}
+
+func (keeper Keeper) submitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited, optimistic bool) (v1.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
@@ -102,6 +129,7 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Optimistic = optimistic
 
 	err = keeper.SetProposal(ctx, proposal)
 	if err != nil {
@@ -120,6 +148,7 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 			types.EventTypeSubmitProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyProposalOptimistic, strconv.FormatBool(optimistic)),
 		),
 	)
 
@@ -244,9 +273,12 @@ func (keeper Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Propo
 		return err
 	}
 
-	if proposal.Expedited {
+	switch {
+	case proposal.Expedited:
 		votingPeriod = params.ExpeditedVotingPeriod
-	} else {
+	case proposal.Optimistic:
+		votingPeriod = params.GetOptimisticVotingPeriodOrDefault()
+	default:
 		votingPeriod = params.VotingPeriod
 	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
@@ -173,6 +173,29 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposal() {
+	suite.reset()
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.OptimisticAuthorizedAddresses = []string{suite.addrs[0].String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	// unauthorized proposer
+	_, err = suite.govKeeper.SubmitOptimisticProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[1])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	proposal, err := suite.govKeeper.SubmitOptimisticProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.Optimistic)
+	suite.Require().False(proposal.Expedited)
+
+	// the optimistic voting period applies
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal))
+	proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockHeader().Time.Add(*params.OptimisticVotingPeriod), *proposal.VotingEndTime)
+}
+
 func (suite *KeeperTestSuite) TestCancelProposal() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
 	tp := v1beta1.TextProposal{Title: "title", Description: "description"}
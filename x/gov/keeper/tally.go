@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"cosmossdk.io/collections"
 
@@ -18,18 +19,113 @@ import (
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
 // voters
 func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	outcome, tallyResults, err := keeper.TallyWithOutcome(ctx, proposal)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	switch outcome {
+	case v1.ProposalOutcomePassed:
+		return true, false, tallyResults, nil
+	case v1.ProposalOutcomeQuorumNotMet:
+		return false, params.BurnVoteQuorum, tallyResults, nil
+	case v1.ProposalOutcomeVetoed:
+		return false, params.BurnVoteVeto, tallyResults, nil
+	default:
+		return false, false, tallyResults, nil
+	}
+}
+
+// TallyWithOutcome iterates over the votes and updates the tally of a proposal based on the voting
+// power of the voters, and returns the outcome of the proposal.
+func (keeper Keeper) TallyWithOutcome(ctx context.Context, proposal v1.Proposal) (outcome v1.ProposalOutcome, tallyResults v1.TallyResult, err error) {
+	outcome, tallyResults, _, err = keeper.tally(ctx, proposal)
+	return outcome, tallyResults, err
+}
+
+// TallyAndSetBreakdown tallies a proposal like TallyWithOutcome and stores its tally breakdown,
+// replacing any breakdown previously stored for the proposal. The breakdown of an expedited
+// proposal which did not pass is not stored, as the proposal is converted to a regular proposal
+// and tallied again at the end of its extended voting period.
+func (keeper Keeper) TallyAndSetBreakdown(ctx context.Context, proposal v1.Proposal) (outcome v1.ProposalOutcome, tallyResults v1.TallyResult, err error) {
+	outcome, tallyResults, breakdown, err := keeper.tally(ctx, proposal)
+	if err != nil {
+		return outcome, tallyResults, err
+	}
+
+	if proposal.Expedited && outcome != v1.ProposalOutcomePassed {
+		return outcome, tallyResults, nil
+	}
+
+	return outcome, tallyResults, keeper.setTallyBreakdown(ctx, proposal, breakdown)
+}
+
+// PruneVoterTallies deletes the per voter tally breakdowns whose retention period ended.
+func (keeper Keeper) PruneVoterTallies(ctx context.Context) error {
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](sdk.UnwrapSDKContext(ctx).BlockTime())
+	err := keeper.VoterTalliesQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if err := keeper.deleteVoterTallies(ctx, key.K2()); err != nil {
+			return false, err
+		}
+
+		return false, keeper.VoterTalliesQueue.Remove(ctx, key)
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	return nil
+}
+
+// tallyBreakdown holds the breakdown of the tally of a proposal between direct and inherited votes.
+type tallyBreakdown struct {
+	summary    v1.TallyBreakdown
+	validators []v1.ValidatorTally
+	voters     []v1.VoterTally
+}
+
+// delegatedPower is the voting power of a voter's delegation to a bonded validator.
+type delegatedPower struct {
+	valAddr string
+	power   math.LegacyDec
+}
+
+func newTallyResultsMap() map[v1.VoteOption]math.LegacyDec {
 	results := make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
 	results[v1.OptionNo] = math.LegacyZeroDec()
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
+	return results
+}
+
+// tally iterates over the votes of a proposal, removing them, and returns the outcome, the tally
+// and the tally breakdown of the proposal.
+func (keeper Keeper) tally(ctx context.Context, proposal v1.Proposal) (outcome v1.ProposalOutcome, tallyResults v1.TallyResult, breakdown tallyBreakdown, err error) {
+	results := newTallyResultsMap()
+	directResults := newTallyResultsMap()
+	inheritedResults := newTallyResultsMap()
+
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
+	// valAddrs keeps the bonded validators ordered by power, as map iteration is not deterministic
+	var valAddrs []string
+
+	var (
+		voterValAddrs    []string
+		voterDelegations [][]delegatedPower
+	)
 
 	// fetch all the bonded validators, insert them into currValidators
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	keeper.sk.IterateBondedValidatorsByPower(sdkCtx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valAddrs = append(valAddrs, validator.GetOperator().String())
 		currValidators[validator.GetOperator().String()] = v1.NewValidatorGovInfo(
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
@@ -54,6 +150,9 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 			currValidators[valAddrStr] = val
 		}
 
+		voterPower := math.LegacyZeroDec()
+		var delegations []delegatedPower
+
 		// iterate over all delegations from voter, deduct from any delegated-to validators
 		keeper.sk.IterateDelegations(sdkCtx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
@@ -71,22 +170,56 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 					weight, _ := math.LegacyNewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
 					results[option.Option] = results[option.Option].Add(subPower)
+					directResults[option.Option] = directResults[option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
+				voterPower = voterPower.Add(votingPower)
+				delegations = append(delegations, delegatedPower{valAddr: valAddrStr, power: votingPower})
 			}
 
 			return false
 		})
 
+		breakdown.voters = append(breakdown.voters, v1.VoterTally{
+			ProposalId: vote.ProposalId,
+			Voter:      vote.Voter,
+			Options:    vote.Options,
+			Power:      voterPower.TruncateInt().String(),
+		})
+		voterValAddrs = append(voterValAddrs, valAddrStr)
+		voterDelegations = append(voterDelegations, delegations)
+
 		return false, keeper.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
 	})
 
 	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
-		return false, false, tallyResults, err
+		return v1.ProposalOutcomeRejected, tallyResults, breakdown, err
+	}
+
+	// a voter overrides the vote of the validators it delegates to, except its own validator
+	overriddenPowers := make(map[string]math.LegacyDec)
+	for i := range breakdown.voters {
+		for _, delegation := range voterDelegations[i] {
+			if delegation.valAddr == voterValAddrs[i] || len(currValidators[delegation.valAddr].Vote) == 0 {
+				continue
+			}
+
+			overriddenPower, ok := overriddenPowers[delegation.valAddr]
+			if !ok {
+				overriddenPower = math.LegacyZeroDec()
+			}
+			overriddenPowers[delegation.valAddr] = overriddenPower.Add(delegation.power)
+			breakdown.voters[i].OverriddenValidators = append(breakdown.voters[i].OverriddenValidators, delegation.valAddr)
+		}
+
+		if len(breakdown.voters[i].OverriddenValidators) > 0 {
+			breakdown.summary.OverridingVoters++
+		}
 	}
 
 	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
+	for _, valAddrStr := range valAddrs {
+		val := currValidators[valAddrStr]
 		if len(val.Vote) == 0 {
 			continue
 		}
@@ -98,38 +231,61 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 			weight, _ := math.LegacyNewDecFromStr(option.Weight)
 			subPower := votingPower.Mul(weight)
 			results[option.Option] = results[option.Option].Add(subPower)
+			inheritedResults[option.Option] = inheritedResults[option.Option].Add(subPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		overriddenPower, ok := overriddenPowers[valAddrStr]
+		if !ok {
+			overriddenPower = math.LegacyZeroDec()
+		}
+		breakdown.validators = append(breakdown.validators, v1.ValidatorTally{
+			ProposalId:       proposal.Id,
+			ValidatorAddress: valAddrStr,
+			Options:          val.Vote,
+			InheritedPower:   votingPower.TruncateInt().String(),
+			OverriddenPower:  overriddenPower.TruncateInt().String(),
+		})
 	}
 
+	directTally := v1.NewTallyResultFromMap(directResults)
+	inheritedTally := v1.NewTallyResultFromMap(inheritedResults)
+	breakdown.summary.ProposalId = proposal.Id
+	breakdown.summary.DirectTally = &directTally
+	breakdown.summary.InheritedTally = &inheritedTally
+
 	params, err := keeper.Params.Get(ctx)
 	if err != nil {
-		return false, false, tallyResults, err
+		return v1.ProposalOutcomeRejected, tallyResults, breakdown, err
 	}
 	tallyResults = v1.NewTallyResultFromMap(results)
 
+	if proposal.Optimistic {
+		return keeper.tallyOptimistic(sdkCtx, params, results), tallyResults, breakdown, nil
+	}
+
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
 	if keeper.sk.TotalBondedTokens(sdkCtx).IsZero() {
-		return false, false, tallyResults, nil
+		return v1.ProposalOutcomeRejected, tallyResults, breakdown, nil
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(keeper.sk.TotalBondedTokens(sdkCtx)))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
-		return false, params.BurnVoteQuorum, tallyResults, nil
+		return v1.ProposalOutcomeQuorumNotMet, tallyResults, breakdown, nil
 	}
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults, nil
+		return v1.ProposalOutcomeRejected, tallyResults, breakdown, nil
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, params.BurnVoteVeto, tallyResults, nil
+		return v1.ProposalOutcomeVetoed, tallyResults, breakdown, nil
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
@@ -144,9 +300,93 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
 
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults, nil
+		return v1.ProposalOutcomePassed, tallyResults, breakdown, nil
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults, nil
+	return v1.ProposalOutcomeRejected, tallyResults, breakdown, nil
+}
+
+// tallyOptimistic returns the outcome of an optimistic proposal: it passes unless the
+// no and no_with_veto votes reach the optimistic rejected threshold of the total bonded
+// tokens. Quorum does not apply to optimistic proposals, but they are rejected if there
+// are no bonded tokens, as nobody could have vetoed them.
+func (keeper Keeper) tallyOptimistic(ctx sdk.Context, params v1.Params, results map[v1.VoteOption]math.LegacyDec) v1.ProposalOutcome {
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return v1.ProposalOutcomeRejected
+	}
+
+	noVotes := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+	if noVotes.Quo(math.LegacyNewDecFromInt(totalBonded)).GTE(params.GetOptimisticRejectedThresholdDec()) {
+		return v1.ProposalOutcomeRejected
+	}
+
+	return v1.ProposalOutcomePassed
+}
+
+// setTallyBreakdown stores the tally breakdown of a proposal, replacing any breakdown previously
+// stored for the proposal. The per voter tally breakdown is only stored if it is retained.
+func (keeper Keeper) setTallyBreakdown(ctx context.Context, proposal v1.Proposal, breakdown tallyBreakdown) error {
+	proposalID := breakdown.summary.ProposalId
+
+	valRng := collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID)
+	err := keeper.ValidatorTallies.Walk(ctx, valRng, func(key collections.Pair[uint64, sdk.ValAddress], _ v1.ValidatorTally) (bool, error) {
+		return false, keeper.ValidatorTallies.Remove(ctx, key)
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	if err := keeper.deleteVoterTallies(ctx, proposalID); err != nil {
+		return err
+	}
+
+	for _, valTally := range breakdown.validators {
+		valAddr, err := sdk.ValAddressFromBech32(valTally.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.ValidatorTallies.Set(ctx, collections.Join(proposalID, valAddr), valTally); err != nil {
+			return err
+		}
+	}
+
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if pruningTime, ok := params.VoterTalliesPruningTime(proposal); ok {
+		for _, voterTally := range breakdown.voters {
+			voter, err := keeper.authKeeper.AddressCodec().StringToBytes(voterTally.Voter)
+			if err != nil {
+				return err
+			}
+
+			if err := keeper.VoterTallies.Set(ctx, collections.Join(proposalID, sdk.AccAddress(voter)), voterTally); err != nil {
+				return err
+			}
+		}
+
+		if err := keeper.VoterTalliesQueue.Set(ctx, collections.Join(pruningTime, proposalID)); err != nil {
+			return err
+		}
+	}
+
+	return keeper.TallyBreakdowns.Set(ctx, proposalID, breakdown.summary)
+}
+
+// deleteVoterTallies deletes the per voter tally breakdown of a proposal.
+func (keeper Keeper) deleteVoterTallies(ctx context.Context, proposalID uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err := keeper.VoterTallies.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], _ v1.VoterTally) (bool, error) {
+		return false, keeper.VoterTallies.Remove(ctx, key)
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	return nil
 }
@@ -109,6 +109,7 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"optimistic": false,
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
@@ -125,11 +126,14 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"starting_proposal_id": "1",
+	"tally_breakdowns": [],
 	"tally_params": {
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
 	},
+	"validator_tallies": [],
+	"voter_tallies": [],
 	"votes": [
 		{
 			"metadata": "",
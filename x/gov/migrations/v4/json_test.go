@@ -39,6 +39,12 @@ func TestMigrateJSON(t *testing.T) {
 		},
 	}
 
+	// the params added after v0.47 are left unset by the migration
+	govGenState.Params.MinDepositIncreaseRatio = ""
+	govGenState.Params.OptimisticVotingPeriod = nil
+	govGenState.Params.OptimisticRejectedThreshold = ""
+	govGenState.Params.VoterTallyRetention = nil
+
 	migrated, err := v4.MigrateJSON(oldGovState)
 	require.NoError(t, err)
 	require.Equal(t, migrated, govGenState)
@@ -63,6 +69,7 @@ func TestMigrateJSON(t *testing.T) {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
+		"deposit_period_expired_deposit_policy": null,
 		"expedited_min_deposit": [
 			{
 				"amount": "50000000",
@@ -78,17 +85,29 @@ func TestMigrateJSON(t *testing.T) {
 				"denom": "stake"
 			}
 		],
+		"min_deposit_increase_ratio": "",
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_authorized_addresses": [],
+		"optimistic_rejected_threshold": "",
+		"optimistic_voting_period": null,
+		"passed_deposit_policy": null,
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
+		"quorum_not_met_deposit_policy": null,
+		"rejected_deposit_policy": null,
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
+		"vetoed_deposit_policy": null,
+		"voter_tally_retention": null,
 		"voting_period": "172800s"
 	},
 	"proposals": [],
 	"starting_proposal_id": "1",
+	"tally_breakdowns": [],
 	"tally_params": null,
+	"validator_tallies": [],
+	"voter_tallies": [],
 	"votes": [],
 	"voting_params": null
 }`
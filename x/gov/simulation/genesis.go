@@ -28,6 +28,8 @@ const (
 	ExpeditedThreshold    = "expedited_threshold"
 	Veto                  = "veto"
 	ProposalCancelRate    = "proposal_cancel_rate"
+	MinDepositIncrease    = "min_deposit_increase_ratio"
+	VoterTallyRetention   = "voter_tally_retention"
 
 	// ExpeditedThreshold must be at least as large as the regular Threshold
 	// Therefore, we use this break out point in randomization.
@@ -64,6 +66,16 @@ func GenProposalCancelRate(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyNewDec(int64(simulation.RandIntBetween(r, 0, 99))).Quo(sdkmath.LegacyNewDec(100))
 }
 
+// GenMinDepositIncreaseRatio returns randomized MinDepositIncreaseRatio
+func GenMinDepositIncreaseRatio(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDec(int64(simulation.RandIntBetween(r, 0, 10))).Quo(sdkmath.LegacyNewDec(100))
+}
+
+// GenVoterTallyRetention returns randomized VoterTallyRetention
+func GenVoterTallyRetention(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 2*expeditedMaxVotingPeriod)) * time.Second
+}
+
 // GenVotingPeriod returns randomized VotingPeriod
 func GenVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, expeditedMaxVotingPeriod, 2*expeditedMaxVotingPeriod)) * time.Second
@@ -136,6 +148,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 		v1.NewParams(minDeposit, expeditedMinDeposit, depositPeriod, votingPeriod, expeditedVotingPeriod, quorum.String(), threshold.String(), expitedVotingThreshold.String(), veto.String(), minInitialDepositRatio.String(), proposalCancelRate.String(), "", simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0),
 	)
 
+	var minDepositIncreaseRatio sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(MinDepositIncrease, &minDepositIncreaseRatio, simState.Rand, func(r *rand.Rand) { minDepositIncreaseRatio = GenMinDepositIncreaseRatio(r) })
+	govGenesis.Params.MinDepositIncreaseRatio = minDepositIncreaseRatio.String()
+
+	var voterTallyRetention time.Duration
+	simState.AppParams.GetOrGenerate(VoterTallyRetention, &voterTallyRetention, simState.Rand, func(r *rand.Rand) { voterTallyRetention = GenVoterTallyRetention(r) })
+	govGenesis.Params.VoterTallyRetention = &voterTallyRetention
+
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
 	if err != nil {
 		panic(err)
@@ -559,9 +559,9 @@ func randomDeposit(
 	}
 
 	params, _ := k.Params.Get(ctx)
-	minDeposit := params.MinDeposit
-	if expedited {
-		minDeposit = params.ExpeditedMinDeposit
+	minDeposit, err := k.GetMinDeposit(ctx, params, expedited)
+	if err != nil {
+		return nil, false, err
 	}
 	denomIndex := r.Intn(len(minDeposit))
 	denom := minDeposit[denomIndex].Denom
@@ -25,4 +25,8 @@ var (
 	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
 	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
 	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	TallyBreakdownsKeyPrefix      = collections.NewPrefix(64) // TallyBreakdownsKeyPrefix stores the tally breakdowns of proposals.
+	ValidatorTalliesKeyPrefix     = collections.NewPrefix(65) // ValidatorTalliesKeyPrefix stores the per validator tally breakdowns of proposals.
+	VoterTalliesKeyPrefix         = collections.NewPrefix(66) // VoterTalliesKeyPrefix stores the per voter tally breakdowns of proposals.
+	VoterTalliesQueuePrefix       = collections.NewPrefix(67) // VoterTalliesQueuePrefix stores the proposals whose per voter tally breakdowns are pruned, by pruning time.
 )
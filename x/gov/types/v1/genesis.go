@@ -2,8 +2,10 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -33,7 +35,65 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
-	return data.Params.ValidateBasic()
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return data.validateTallyBreakdowns()
+}
+
+// validateTallyBreakdowns checks that the tally breakdowns are the ones of ended proposals of the
+// genesis state, with valid addresses and without duplicates.
+func (data GenesisState) validateTallyBreakdowns() error {
+	ended := make(map[uint64]bool, len(data.Proposals))
+	for _, p := range data.Proposals {
+		ended[p.Id] = p.Status != StatusDepositPeriod && p.Status != StatusVotingPeriod && p.VotingEndTime != nil
+	}
+
+	breakdowns := make(map[uint64]bool, len(data.TallyBreakdowns))
+	for _, breakdown := range data.TallyBreakdowns {
+		if !ended[breakdown.ProposalId] {
+			return fmt.Errorf("tally breakdown of proposal %d, which is not an ended proposal", breakdown.ProposalId)
+		}
+		if breakdowns[breakdown.ProposalId] {
+			return fmt.Errorf("duplicate tally breakdown of proposal %d", breakdown.ProposalId)
+		}
+		breakdowns[breakdown.ProposalId] = true
+	}
+
+	seenValidators := make(map[string]bool, len(data.ValidatorTallies))
+	for _, valTally := range data.ValidatorTallies {
+		if !breakdowns[valTally.ProposalId] {
+			return fmt.Errorf("validator tally of proposal %d, which has no tally breakdown", valTally.ProposalId)
+		}
+		if _, err := sdk.ValAddressFromBech32(valTally.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address of validator tally: %s", valTally.ValidatorAddress)
+		}
+
+		key := fmt.Sprintf("%d/%s", valTally.ProposalId, valTally.ValidatorAddress)
+		if seenValidators[key] {
+			return fmt.Errorf("duplicate tally of validator %s for proposal %d", valTally.ValidatorAddress, valTally.ProposalId)
+		}
+		seenValidators[key] = true
+	}
+
+	seenVoters := make(map[string]bool, len(data.VoterTallies))
+	for _, voterTally := range data.VoterTallies {
+		if !breakdowns[voterTally.ProposalId] {
+			return fmt.Errorf("voter tally of proposal %d, which has no tally breakdown", voterTally.ProposalId)
+		}
+		if _, err := sdk.AccAddressFromBech32(voterTally.Voter); err != nil {
+			return fmt.Errorf("invalid voter address of voter tally: %s", voterTally.Voter)
+		}
+
+		key := fmt.Sprintf("%d/%s", voterTally.ProposalId, voterTally.Voter)
+		if seenVoters[key] {
+			return fmt.Errorf("duplicate tally of voter %s for proposal %d", voterTally.Voter, voterTally.ProposalId)
+		}
+		seenVoters[key] = true
+	}
+
+	return nil
 }
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
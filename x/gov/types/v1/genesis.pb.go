@@ -52,6 +52,20 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// tally_breakdowns defines the tally breakdowns of the ended proposals.
+	//
+	// Since: cosmos-sdk 0.50
+	TallyBreakdowns []*TallyBreakdown `protobuf:"bytes,10,rep,name=tally_breakdowns,json=tallyBreakdowns,proto3" json:"tally_breakdowns,omitempty"`
+	// validator_tallies defines the per validator tally breakdowns of the ended
+	// proposals.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidatorTallies []*ValidatorTally `protobuf:"bytes,11,rep,name=validator_tallies,json=validatorTallies,proto3" json:"validator_tallies,omitempty"`
+	// voter_tallies defines the per voter tally breakdowns of the ended proposals
+	// which are still retained.
+	//
+	// Since: cosmos-sdk 0.50
+	VoterTallies []*VoterTally `protobuf:"bytes,12,rep,name=voter_tallies,json=voterTallies,proto3" json:"voter_tallies,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +167,27 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetTallyBreakdowns() []*TallyBreakdown {
+	if m != nil {
+		return m.TallyBreakdowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorTallies() []*ValidatorTally {
+	if m != nil {
+		return m.ValidatorTallies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoterTallies() []*VoterTally {
+	if m != nil {
+		return m.VoterTallies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,31 +195,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0x30, 0x94, 0xff, 0x62, 0xfc, 0xa0, 0x01, 0xd3, 0x34, 0xac, 0x6a,
-	0x0c, 0xad, 0x60, 0x7c, 0x00, 0x09, 0x86, 0xb8, 0x23, 0xd5, 0xb8, 0x70, 0x43, 0x0a, 0x6d, 0x6a,
-	0x23, 0xf4, 0x36, 0x9d, 0x61, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8,
-	0x31, 0xcc, 0xb4, 0x02, 0xd5, 0xd5, 0x24, 0xf7, 0xfc, 0xce, 0x99, 0x93, 0x9b, 0x8b, 0x5b, 0x53,
-	0xa0, 0x73, 0xa0, 0x96, 0x0f, 0xdc, 0xe2, 0x5d, 0xcb, 0xf7, 0x42, 0x8f, 0x06, 0xd4, 0x8c, 0x62,
-	0x60, 0x40, 0xea, 0x52, 0x34, 0x7d, 0xe0, 0x26, 0xef, 0x36, 0x1b, 0x19, 0x16, 0xb8, 0xe4, 0xda,
-	0x5f, 0x05, 0xac, 0x0c, 0xa5, 0xf3, 0x9e, 0x39, 0xcc, 0x23, 0x97, 0xf8, 0x98, 0x32, 0x27, 0x66,
-	0x41, 0xe8, 0x8f, 0xa3, 0x18, 0x22, 0xa0, 0xce, 0x6c, 0x1c, 0xb8, 0x2a, 0xd2, 0x91, 0x51, 0xb4,
-	0x49, 0xaa, 0x8d, 0x12, 0xe9, 0xce, 0x25, 0x3d, 0x5c, 0x71, 0xbd, 0x08, 0x68, 0xc0, 0xa8, 0x9a,
-	0xd7, 0x0b, 0x46, 0xad, 0x77, 0x6a, 0x1e, 0xfc, 0x6e, 0x0e, 0xa4, 0x6c, 0xff, 0x70, 0xe4, 0x1c,
-	0x97, 0x38, 0x30, 0x8f, 0xaa, 0x05, 0x61, 0x38, 0xca, 0x18, 0x1e, 0x81, 0x79, 0xb6, 0x24, 0xc8,
-	0x35, 0xae, 0xa6, 0x3d, 0xa8, 0x5a, 0x14, 0x78, 0x23, 0x83, 0xa7, 0x65, 0xec, 0x1d, 0x49, 0x86,
-	0xf8, 0x7f, 0xf2, 0xdb, 0x38, 0x72, 0x62, 0x67, 0x4e, 0xd5, 0x92, 0x8e, 0x8c, 0x5a, 0xef, 0xec,
-	0xef, 0x6e, 0x23, 0xc1, 0xf4, 0xf3, 0x2a, 0xb2, 0xeb, 0xee, 0xfe, 0x88, 0x0c, 0x70, 0x9d, 0x83,
-	0x5c, 0x87, 0xcc, 0x29, 0x8b, 0x9c, 0xd6, 0xef, 0xca, 0xdb, 0xb5, 0xec, 0x62, 0x14, 0xbe, 0x37,
-	0x21, 0x37, 0x58, 0x61, 0xce, 0x6c, 0xb6, 0x4c, 0x43, 0xfe, 0x89, 0x90, 0x66, 0x26, 0xe4, 0x61,
-	0x8b, 0xec, 0x65, 0xd4, 0xd8, 0x6e, 0x40, 0x3a, 0xb8, 0x9c, 0x98, 0x2b, 0xc2, 0x7c, 0x92, 0xdd,
-	0x82, 0x10, 0xed, 0x04, 0x22, 0x6d, 0xac, 0x4c, 0x21, 0xa4, 0x2c, 0x60, 0x0b, 0x16, 0x40, 0xa8,
-	0x56, 0x75, 0x64, 0x54, 0xed, 0x83, 0x59, 0xff, 0xf6, 0x7d, 0xad, 0xa1, 0xd5, 0x5a, 0x43, 0x9f,
-	0x6b, 0x0d, 0xbd, 0x6d, 0xb4, 0xdc, 0x6a, 0xa3, 0xe5, 0x3e, 0x36, 0x5a, 0xee, 0xe9, 0xc2, 0x0f,
-	0xd8, 0xf3, 0x62, 0x62, 0x4e, 0x61, 0x6e, 0x25, 0xb7, 0x23, 0x9f, 0x0e, 0x75, 0x5f, 0xac, 0x57,
-	0x71, 0x48, 0x6c, 0x19, 0x79, 0xd4, 0xe2, 0xdd, 0x49, 0x59, 0xdc, 0xd2, 0xd5, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x69, 0x07, 0x35, 0x92, 0x02, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x6d, 0x2d, 0xab, 0x9b, 0xc2, 0x30, 0x3f, 0x66, 0x36, 0x88, 0xaa, 0x9d, 0x8a,
+	0xd0, 0x12, 0x5a, 0xc4, 0x15, 0x89, 0x6a, 0x68, 0xc0, 0x69, 0x32, 0x68, 0x07, 0x2e, 0x95, 0xdb,
+	0x58, 0xc1, 0x5a, 0x9b, 0x17, 0xe5, 0x79, 0x86, 0xfd, 0x17, 0xfc, 0x59, 0x1c, 0xc7, 0x8d, 0x23,
+	0x6a, 0xff, 0x11, 0x54, 0x3b, 0xa1, 0x6d, 0xc8, 0xa9, 0xf5, 0x7b, 0x9f, 0xf7, 0xf1, 0x57, 0x4f,
+	0x31, 0x39, 0x9e, 0x02, 0xce, 0x01, 0xa3, 0x04, 0x4c, 0x64, 0x06, 0x51, 0x22, 0x53, 0x89, 0x0a,
+	0xc3, 0x2c, 0x07, 0x0d, 0xb4, 0xeb, 0x9a, 0x61, 0x02, 0x26, 0x34, 0x83, 0xa3, 0xc3, 0x0a, 0x0b,
+	0xc6, 0x71, 0x27, 0xbf, 0x9a, 0xc4, 0x3f, 0x77, 0x93, 0x9f, 0xb4, 0xd0, 0x92, 0xbe, 0x24, 0x0f,
+	0x51, 0x8b, 0x5c, 0xab, 0x34, 0x19, 0x67, 0x39, 0x64, 0x80, 0x62, 0x36, 0x56, 0x31, 0xf3, 0x7a,
+	0x5e, 0x7f, 0x8f, 0xd3, 0xb2, 0x77, 0x51, 0xb4, 0x3e, 0xc4, 0x74, 0x48, 0xf6, 0x63, 0x99, 0x01,
+	0x2a, 0x8d, 0x6c, 0xa7, 0xb7, 0xdb, 0xef, 0x0c, 0x1f, 0x87, 0x5b, 0xb7, 0x87, 0x67, 0xae, 0xcd,
+	0xff, 0x71, 0xf4, 0x39, 0x69, 0x1a, 0xd0, 0x12, 0xd9, 0xae, 0x1d, 0x78, 0x50, 0x19, 0xb8, 0x04,
+	0x2d, 0xb9, 0x23, 0xe8, 0x6b, 0xd2, 0x2e, 0x73, 0x20, 0xdb, 0xb3, 0xf8, 0x61, 0x05, 0x2f, 0xc3,
+	0xf0, 0x35, 0x49, 0xcf, 0xc9, 0xdd, 0xe2, 0xb6, 0x71, 0x26, 0x72, 0x31, 0x47, 0xd6, 0xec, 0x79,
+	0xfd, 0xce, 0xf0, 0x69, 0x7d, 0xb6, 0x0b, 0xcb, 0x8c, 0x76, 0x98, 0xc7, 0xbb, 0xf1, 0x66, 0x89,
+	0x9e, 0x91, 0xae, 0x01, 0xb7, 0x0e, 0xe7, 0x69, 0x59, 0xcf, 0xf1, 0xff, 0x91, 0x57, 0x6b, 0x59,
+	0x6b, 0x7c, 0xb3, 0x51, 0xa1, 0x6f, 0x89, 0xaf, 0xc5, 0x6c, 0x76, 0x53, 0x4a, 0xee, 0x58, 0xc9,
+	0x51, 0x45, 0xf2, 0x79, 0x85, 0x6c, 0x38, 0x3a, 0x7a, 0x5d, 0xa0, 0xa7, 0xa4, 0x55, 0x0c, 0xef,
+	0xdb, 0xe1, 0x47, 0xd5, 0x2d, 0xd8, 0x26, 0x2f, 0x20, 0x7a, 0x42, 0xfc, 0x29, 0xa4, 0xa8, 0x95,
+	0xbe, 0xd6, 0x0a, 0x52, 0xd6, 0xee, 0x79, 0xfd, 0x36, 0xdf, 0xaa, 0xd1, 0xf7, 0xe4, 0xc0, 0xa5,
+	0x9a, 0xe4, 0x52, 0x5c, 0xc5, 0xf0, 0x2d, 0x45, 0x46, 0xec, 0x8a, 0x9f, 0xd5, 0x25, 0x1b, 0x95,
+	0x14, 0xbf, 0xa7, 0xb7, 0xce, 0x48, 0x3f, 0x92, 0xfb, 0x46, 0xcc, 0x54, 0x2c, 0x34, 0xe4, 0xe3,
+	0x55, 0x53, 0x49, 0x64, 0x9d, 0x5a, 0xd5, 0x65, 0xc9, 0x59, 0x27, 0x3f, 0x30, 0x9b, 0x67, 0x25,
+	0x91, 0xbe, 0xb1, 0x1b, 0x97, 0x6b, 0x8f, 0x6f, 0x3d, 0x4f, 0x6a, 0x3e, 0x92, 0xc2, 0xe1, 0x9b,
+	0xf2, 0xbf, 0x92, 0x38, 0x7a, 0xf7, 0x73, 0x11, 0x78, 0xb7, 0x8b, 0xc0, 0xfb, 0xb3, 0x08, 0xbc,
+	0x1f, 0xcb, 0xa0, 0x71, 0xbb, 0x0c, 0x1a, 0xbf, 0x97, 0x41, 0xe3, 0xcb, 0x8b, 0x44, 0xe9, 0xaf,
+	0xd7, 0x93, 0x70, 0x0a, 0xf3, 0xa8, 0x78, 0x11, 0xee, 0xe7, 0x14, 0xe3, 0xab, 0xe8, 0xbb, 0x7d,
+	0x1e, 0xfa, 0x26, 0x93, 0x18, 0x99, 0xc1, 0xa4, 0x65, 0x5f, 0xc8, 0xab, 0xbf, 0x03, 0x00, 0xcb,
+	0x77, 0x06, 0x92, 0x68, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +247,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoterTallies) > 0 {
+		for iNdEx := len(m.VoterTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ValidatorTallies) > 0 {
+		for iNdEx := len(m.ValidatorTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TallyBreakdowns) > 0 {
+		for iNdEx := len(m.TallyBreakdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyBreakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -370,6 +452,24 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.TallyBreakdowns) > 0 {
+		for _, e := range m.TallyBreakdowns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorTallies) > 0 {
+		for _, e := range m.ValidatorTallies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoterTallies) > 0 {
+		for _, e := range m.VoterTallies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -705,6 +805,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyBreakdowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyBreakdowns = append(m.TallyBreakdowns, &TallyBreakdown{})
+			if err := m.TallyBreakdowns[len(m.TallyBreakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorTallies = append(m.ValidatorTallies, &ValidatorTally{})
+			if err := m.ValidatorTallies[len(m.ValidatorTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterTallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterTallies = append(m.VoterTallies, &VoterTally{})
+			if err := m.VoterTallies[len(m.VoterTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -92,6 +93,83 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid tally breakdowns",
+			genesisState: func() *v1.GenesisState {
+				return withTallyBreakdowns(params)
+			},
+		},
+		{
+			name: "tally breakdown of unknown proposal",
+			genesisState: func() *v1.GenesisState {
+				state := withTallyBreakdowns(params)
+				state.TallyBreakdowns = append(state.TallyBreakdowns, &v1.TallyBreakdown{ProposalId: 2})
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "tally breakdown of proposal in voting period",
+			genesisState: func() *v1.GenesisState {
+				state := withTallyBreakdowns(params)
+				state.Proposals[0].Status = v1.StatusVotingPeriod
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate tally breakdown",
+			genesisState: func() *v1.GenesisState {
+				state := withTallyBreakdowns(params)
+				state.TallyBreakdowns = append(state.TallyBreakdowns, state.TallyBreakdowns[0])
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "validator tally without tally breakdown",
+			genesisState: func() *v1.GenesisState {
+				state := withTallyBreakdowns(params)
+				state.TallyBreakdowns = nil
+				state.VoterTallies = nil
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid validator address of validator tally",
+			genesisState: func() *v1.GenesisState {
+				state := withTallyBreakdowns(params)
+				state.ValidatorTallies[0].ValidatorAddress = "invalid"
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate voter tally",
+			genesisState: func() *v1.GenesisState {
+				state := withTallyBreakdowns(params)
+				state.VoterTallies = append(state.VoterTallies, state.VoterTallies[0])
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid voter address of voter tally",
+			genesisState: func() *v1.GenesisState {
+				state := withTallyBreakdowns(params)
+				state.VoterTallies[0].Voter = "invalid"
+
+				return state
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -106,3 +184,21 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+// withTallyBreakdowns returns a genesis state with a rejected proposal and its tally breakdowns.
+func withTallyBreakdowns(params v1.Params) *v1.GenesisState {
+	votingEndTime := time.Unix(1000, 0).UTC()
+	state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+	state.Proposals = v1.Proposals{
+		{Id: 1, Status: v1.StatusRejected, VotingEndTime: &votingEndTime},
+	}
+	state.TallyBreakdowns = []*v1.TallyBreakdown{{ProposalId: 1}}
+	state.ValidatorTallies = []*v1.ValidatorTally{
+		{ProposalId: 1, ValidatorAddress: sdk.ValAddress("validator___________").String(), InheritedPower: "10"},
+	}
+	state.VoterTallies = []*v1.VoterTally{
+		{ProposalId: 1, Voter: sdk.AccAddress("voter_______________").String(), Power: "10"},
+	}
+
+	return state
+}
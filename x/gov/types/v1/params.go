@@ -11,25 +11,29 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod                         time.Duration = time.Hour * 24 * 2 // 2 days
-	DefaultExpeditedPeriod                time.Duration = time.Hour * 24 * 1 // 1 day
+	DefaultPeriod                         time.Duration = time.Hour * 24 * 2  // 2 days
+	DefaultExpeditedPeriod                time.Duration = time.Hour * 24 * 1  // 1 day
+	DefaultOptimisticPeriod               time.Duration = time.Hour * 24 * 2  // 2 days
+	DefaultVoterTallyRetention            time.Duration = time.Hour * 24 * 14 // 2 weeks
 	DefaultMinExpeditedDepositTokensRatio               = 5
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
-	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
-	DefaultQuorum                    = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
-	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
-	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
-	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
-	DefaultProposalCancelDestAddress = ""
-	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositTokens            = sdkmath.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
+	DefaultQuorum                      = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdkmath.LegacyNewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio      = sdkmath.LegacyZeroDec()
+	DefaultProposalCancelRatio         = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultProposalCancelDestAddress   = ""
+	DefaultBurnProposalPrevote         = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom              = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositIncreaseRatio     = sdkmath.LegacyZeroDec()
+	DefaultOptimisticRejectedThreshold = sdkmath.LegacyNewDecWithPrec(1, 1)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -80,9 +84,17 @@ func NewParams(
 	}
 }
 
+// NewDepositPolicy creates a new DepositPolicy instance with given ratios.
+func NewDepositPolicy(burnRatio, communityPoolRatio sdkmath.LegacyDec) *DepositPolicy {
+	return &DepositPolicy{
+		BurnRatio:          burnRatio.String(),
+		CommunityPoolRatio: communityPoolRatio.String(),
+	}
+}
+
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -99,6 +111,14 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 	)
+	params.MinDepositIncreaseRatio = DefaultMinDepositIncreaseRatio.String()
+	optimisticVotingPeriod := DefaultOptimisticPeriod
+	params.OptimisticVotingPeriod = &optimisticVotingPeriod
+	params.OptimisticRejectedThreshold = DefaultOptimisticRejectedThreshold.String()
+	voterTallyRetention := DefaultVoterTallyRetention
+	params.VoterTallyRetention = &voterTallyRetention
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -215,5 +235,179 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	// an empty min deposit increase ratio is accepted for params stored before it was introduced
+	if len(p.MinDepositIncreaseRatio) != 0 {
+		minDepositIncreaseRatio, err := sdkmath.LegacyNewDecFromStr(p.MinDepositIncreaseRatio)
+		if err != nil {
+			return fmt.Errorf("invalid min deposit increase ratio: %w", err)
+		}
+		if minDepositIncreaseRatio.IsNegative() {
+			return fmt.Errorf("min deposit increase ratio must be positive: %s", minDepositIncreaseRatio)
+		}
+	}
+
+	depositPolicies := []struct {
+		name   string
+		policy *DepositPolicy
+	}{
+		{"passed", p.PassedDepositPolicy},
+		{"rejected", p.RejectedDepositPolicy},
+		{"vetoed", p.VetoedDepositPolicy},
+		{"quorum not met", p.QuorumNotMetDepositPolicy},
+		{"deposit period expired", p.DepositPeriodExpiredDepositPolicy},
+	}
+	for _, dp := range depositPolicies {
+		if dp.policy == nil {
+			continue
+		}
+		if err := dp.policy.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid %s deposit policy: %w", dp.name, err)
+		}
+	}
+
+	// the optimistic proposal params are accepted unset for params stored before they were introduced
+	if p.OptimisticVotingPeriod != nil && p.OptimisticVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("optimistic voting period must be positive: %s", p.OptimisticVotingPeriod)
+	}
+
+	if len(p.OptimisticRejectedThreshold) != 0 {
+		optimisticRejectedThreshold, err := sdkmath.LegacyNewDecFromStr(p.OptimisticRejectedThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+		}
+		if !optimisticRejectedThreshold.IsPositive() {
+			return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+		}
+		if optimisticRejectedThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+		}
+	}
+
+	// the voter tally retention is accepted unset, the per voter tally breakdowns are then not stored
+	if p.VoterTallyRetention != nil && p.VoterTallyRetention.Seconds() <= 0 {
+		return fmt.Errorf("voter tally retention must be positive: %s", p.VoterTallyRetention)
+	}
+
+	seenAuthorizedAddresses := make(map[string]bool, len(p.OptimisticAuthorizedAddresses))
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address: %s", addr)
+		}
+		if seenAuthorizedAddresses[addr] {
+			return fmt.Errorf("duplicate optimistic authorized address: %s", addr)
+		}
+		seenAuthorizedAddresses[addr] = true
+	}
+
+	return nil
+}
+
+// GetOptimisticVotingPeriodOrDefault returns the voting period of optimistic proposals,
+// defaulting to the regular voting period if it is unset.
+func (p Params) GetOptimisticVotingPeriodOrDefault() *time.Duration {
+	if p.OptimisticVotingPeriod == nil {
+		return p.VotingPeriod
+	}
+
+	return p.OptimisticVotingPeriod
+}
+
+// GetOptimisticRejectedThresholdDec returns the optimistic rejected threshold as a
+// decimal, defaulting to DefaultOptimisticRejectedThreshold if it is unset.
+func (p Params) GetOptimisticRejectedThresholdDec() sdkmath.LegacyDec {
+	if len(p.OptimisticRejectedThreshold) == 0 {
+		return DefaultOptimisticRejectedThreshold
+	}
+
+	return sdkmath.LegacyMustNewDecFromStr(p.OptimisticRejectedThreshold)
+}
+
+// IsOptimisticAuthorized returns true if the given address is allowed to submit
+// optimistic proposals.
+func (p Params) IsOptimisticAuthorized(addr string) bool {
+	for _, authorized := range p.OptimisticAuthorizedAddresses {
+		if authorized == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VoterTalliesPruningTime returns the time at which the per voter tally breakdown of an
+// ended proposal is pruned, and false if it is not retained.
+func (p Params) VoterTalliesPruningTime(proposal Proposal) (time.Time, bool) {
+	if p.VoterTallyRetention == nil || proposal.VotingEndTime == nil {
+		return time.Time{}, false
+	}
+
+	return proposal.VotingEndTime.Add(*p.VoterTallyRetention), true
+}
+
+// GetMinDepositIncreaseRatioDec returns the min deposit increase ratio as a
+// decimal, defaulting to zero if the ratio is unset.
+func (p Params) GetMinDepositIncreaseRatioDec() sdkmath.LegacyDec {
+	if len(p.MinDepositIncreaseRatio) == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	return sdkmath.LegacyMustNewDecFromStr(p.MinDepositIncreaseRatio)
+}
+
+// DepositPolicyFor returns the deposit policy of a proposal with the given outcome.
+// If no policy is set for the outcome, the deposits are burned or refunded according
+// to the legacy burn_vote_quorum, burn_vote_veto and burn_proposal_deposit_prevote params.
+func (p Params) DepositPolicyFor(outcome ProposalOutcome) DepositPolicy {
+	var (
+		policy *DepositPolicy
+		burn   bool
+	)
+
+	switch outcome {
+	case ProposalOutcomePassed:
+		policy = p.PassedDepositPolicy
+	case ProposalOutcomeVetoed:
+		policy, burn = p.VetoedDepositPolicy, p.BurnVoteVeto
+	case ProposalOutcomeQuorumNotMet:
+		policy, burn = p.QuorumNotMetDepositPolicy, p.BurnVoteQuorum
+	case ProposalOutcomeDepositPeriodExpired:
+		policy, burn = p.DepositPeriodExpiredDepositPolicy, p.BurnProposalDepositPrevote
+	default:
+		policy = p.RejectedDepositPolicy
+	}
+
+	if policy != nil {
+		return *policy
+	}
+
+	if burn {
+		return *NewDepositPolicy(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec())
+	}
+
+	return *NewDepositPolicy(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec())
+}
+
+// ValidateBasic performs basic validation on a deposit policy.
+func (dp DepositPolicy) ValidateBasic() error {
+	burnRatio, err := sdkmath.LegacyNewDecFromStr(dp.BurnRatio)
+	if err != nil {
+		return fmt.Errorf("invalid burn ratio: %w", err)
+	}
+	if burnRatio.IsNegative() {
+		return fmt.Errorf("burn ratio must be positive: %s", burnRatio)
+	}
+
+	communityPoolRatio, err := sdkmath.LegacyNewDecFromStr(dp.CommunityPoolRatio)
+	if err != nil {
+		return fmt.Errorf("invalid community pool ratio: %w", err)
+	}
+	if communityPoolRatio.IsNegative() {
+		return fmt.Errorf("community pool ratio must be positive: %s", communityPoolRatio)
+	}
+
+	if burnRatio.Add(communityPoolRatio).GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("sum of burn ratio and community pool ratio is too large: %s", burnRatio.Add(communityPoolRatio))
+	}
+
 	return nil
 }
@@ -0,0 +1,146 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestParamsDepositPolicyFor(t *testing.T) {
+	refund := *v1.NewDepositPolicy(math.LegacyZeroDec(), math.LegacyZeroDec())
+	burn := *v1.NewDepositPolicy(math.LegacyOneDec(), math.LegacyZeroDec())
+	custom := v1.NewDepositPolicy(math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(3, 1))
+
+	params := v1.DefaultParams()
+	params.BurnVoteQuorum = true
+	params.BurnVoteVeto = false
+	params.BurnProposalDepositPrevote = true
+
+	// legacy burn params are used when no deposit policy is set
+	require.Equal(t, refund, params.DepositPolicyFor(v1.ProposalOutcomePassed))
+	require.Equal(t, refund, params.DepositPolicyFor(v1.ProposalOutcomeRejected))
+	require.Equal(t, refund, params.DepositPolicyFor(v1.ProposalOutcomeVetoed))
+	require.Equal(t, burn, params.DepositPolicyFor(v1.ProposalOutcomeQuorumNotMet))
+	require.Equal(t, burn, params.DepositPolicyFor(v1.ProposalOutcomeDepositPeriodExpired))
+
+	params.RejectedDepositPolicy = custom
+	params.QuorumNotMetDepositPolicy = custom
+	require.Equal(t, *custom, params.DepositPolicyFor(v1.ProposalOutcomeRejected))
+	require.Equal(t, *custom, params.DepositPolicyFor(v1.ProposalOutcomeQuorumNotMet))
+	require.Equal(t, burn, params.DepositPolicyFor(v1.ProposalOutcomeDepositPeriodExpired))
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(params *v1.Params)
+		expErr   string
+	}{
+		{
+			name:     "default params",
+			malleate: func(*v1.Params) {},
+		},
+		{
+			name: "unset min deposit increase ratio",
+			malleate: func(params *v1.Params) {
+				params.MinDepositIncreaseRatio = ""
+			},
+		},
+		{
+			name: "negative min deposit increase ratio",
+			malleate: func(params *v1.Params) {
+				params.MinDepositIncreaseRatio = "-0.1"
+			},
+			expErr: "min deposit increase ratio must be positive",
+		},
+		{
+			name: "valid deposit policy",
+			malleate: func(params *v1.Params) {
+				params.VetoedDepositPolicy = v1.NewDepositPolicy(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1))
+			},
+		},
+		{
+			name: "negative burn ratio",
+			malleate: func(params *v1.Params) {
+				params.PassedDepositPolicy = v1.NewDepositPolicy(math.LegacyNewDec(-1), math.LegacyZeroDec())
+			},
+			expErr: "invalid passed deposit policy: burn ratio must be positive",
+		},
+		{
+			name: "invalid community pool ratio",
+			malleate: func(params *v1.Params) {
+				params.RejectedDepositPolicy = &v1.DepositPolicy{BurnRatio: "0", CommunityPoolRatio: "abc"}
+			},
+			expErr: "invalid rejected deposit policy: invalid community pool ratio",
+		},
+		{
+			name: "ratios sum too large",
+			malleate: func(params *v1.Params) {
+				params.QuorumNotMetDepositPolicy = v1.NewDepositPolicy(math.LegacyNewDecWithPrec(6, 1), math.LegacyNewDecWithPrec(5, 1))
+			},
+			expErr: "invalid quorum not met deposit policy: sum of burn ratio and community pool ratio is too large",
+		},
+		{
+			name: "unset optimistic params",
+			malleate: func(params *v1.Params) {
+				params.OptimisticVotingPeriod = nil
+				params.OptimisticRejectedThreshold = ""
+			},
+		},
+		{
+			name: "optimistic rejected threshold too large",
+			malleate: func(params *v1.Params) {
+				params.OptimisticRejectedThreshold = "1.1"
+			},
+			expErr: "optimistic rejected threshold too large",
+		},
+		{
+			name: "invalid optimistic authorized address",
+			malleate: func(params *v1.Params) {
+				params.OptimisticAuthorizedAddresses = []string{"invalid"}
+			},
+			expErr: "invalid optimistic authorized address",
+		},
+		{
+			name: "duplicate optimistic authorized address",
+			malleate: func(params *v1.Params) {
+				addr := sdk.AccAddress("addr1_______________").String()
+				params.OptimisticAuthorizedAddresses = []string{addr, addr}
+			},
+			expErr: "duplicate optimistic authorized address",
+		},
+		{
+			name: "unset voter tally retention",
+			malleate: func(params *v1.Params) {
+				params.VoterTallyRetention = nil
+			},
+		},
+		{
+			name: "zero voter tally retention",
+			malleate: func(params *v1.Params) {
+				retention := time.Duration(0)
+				params.VoterTallyRetention = &retention
+			},
+			expErr: "voter tally retention must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := v1.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ProposalOutcome defines the outcome of a proposal once it leaves the deposit
+// or voting period. It selects the deposit policy applied to the proposal deposits.
+type ProposalOutcome int
+
+const (
+	// ProposalOutcomeRejected defines a proposal which did not reach the threshold of yes votes.
+	ProposalOutcomeRejected ProposalOutcome = iota
+	// ProposalOutcomePassed defines a proposal which reached the threshold of yes votes.
+	ProposalOutcomePassed
+	// ProposalOutcomeVetoed defines a proposal which reached the veto threshold.
+	ProposalOutcomeVetoed
+	// ProposalOutcomeQuorumNotMet defines a proposal which did not reach quorum.
+	ProposalOutcomeQuorumNotMet
+	// ProposalOutcomeDepositPeriodExpired defines a proposal which did not get
+	// enough deposits to enter voting period.
+	ProposalOutcomeDepositPeriodExpired
+)
+
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
 	Address             sdk.ValAddress      // address of the validator operator
@@ -61,6 +61,11 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// optimistic defines if the proposal is optimistic or not. Optimistic proposals
+	// can only be submitted by the optimistic authorized addresses.
+	//
+	// Since: cosmos-sdk 0.50
+	Optimistic bool `protobuf:"varint,8,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -145,6 +150,13 @@ func (m *MsgSubmitProposal) GetExpedited() bool {
 	return false
 }
 
+func (m *MsgSubmitProposal) GetOptimistic() bool {
+	if m != nil {
+		return m.Optimistic
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -845,75 +857,76 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0xc3, 0x4e, 0x26, 0x5f, 0xca, 0xca, 0x6d, 0xd7, 0xab, 0xb2, 0x76, 0xb7, 0x08,
-	0xac, 0x84, 0xec, 0xe2, 0x40, 0x2b, 0x64, 0x2a, 0xa4, 0x3a, 0x14, 0xa8, 0x84, 0xa1, 0xda, 0x42,
-	0x91, 0x10, 0x92, 0xb5, 0xf6, 0x0e, 0x9b, 0x15, 0xde, 0x9d, 0x95, 0x67, 0x6c, 0xc5, 0x37, 0xc4,
-	0x31, 0xa7, 0x9e, 0xb9, 0x23, 0x21, 0x4e, 0x39, 0xf4, 0xd6, 0x13, 0xb7, 0x8a, 0x53, 0xc5, 0x89,
-	0x53, 0x8b, 0x12, 0x41, 0x10, 0xff, 0x04, 0x68, 0x3e, 0x76, 0xbd, 0x5f, 0x71, 0x0a, 0x87, 0x5e,
-	0x12, 0xcf, 0xfb, 0x9a, 0xf7, 0xfb, 0xbd, 0x79, 0xef, 0x2d, 0xb8, 0xdc, 0x47, 0xd8, 0x47, 0xd8,
-	0x74, 0xd1, 0xd8, 0x1c, 0x37, 0x4d, 0x72, 0x68, 0x84, 0x43, 0x44, 0x90, 0xbc, 0xce, 0xe5, 0x86,
-	0x8b, 0xc6, 0xc6, 0xb8, 0xa9, 0x6a, 0xc2, 0xac, 0x67, 0x63, 0x68, 0x8e, 0x9b, 0x3d, 0x48, 0xec,
-	0xa6, 0xd9, 0x47, 0x5e, 0xc0, 0xcd, 0xd5, 0x2b, 0xe9, 0x30, 0xd4, 0x8b, 0x2b, 0x2a, 0x2e, 0x72,
-	0x11, 0xfb, 0x69, 0xd2, 0x5f, 0x42, 0x5a, 0xe5, 0xe6, 0x5d, 0xae, 0x10, 0x57, 0x09, 0x95, 0x8b,
-	0x90, 0x3b, 0x80, 0x26, 0x3b, 0xf5, 0x46, 0x5f, 0x9b, 0x76, 0x30, 0xc9, 0x5c, 0xe2, 0x63, 0x97,
-	0x5e, 0xe2, 0x63, 0x57, 0x28, 0xb6, 0x6c, 0xdf, 0x0b, 0x90, 0xc9, 0xfe, 0x0a, 0x51, 0x2d, 0x1b,
-	0x86, 0x78, 0x3e, 0xc4, 0xc4, 0xf6, 0x43, 0x6e, 0xa0, 0xff, 0xb0, 0x00, 0xb6, 0x3a, 0xd8, 0xbd,
-	0x3f, 0xea, 0xf9, 0x1e, 0xb9, 0x37, 0x44, 0x21, 0xc2, 0xf6, 0x40, 0x7e, 0x13, 0x2c, 0xfb, 0x10,
-	0x63, 0xdb, 0x85, 0x58, 0x91, 0xea, 0x0b, 0x8d, 0xd5, 0xbd, 0x8a, 0xc1, 0x23, 0x19, 0x51, 0x24,
-	0xe3, 0x76, 0x30, 0xb1, 0x62, 0x2b, 0xf9, 0x48, 0x02, 0x9b, 0x5e, 0xe0, 0x11, 0xcf, 0x1e, 0x74,
-	0x1d, 0x18, 0x22, 0xec, 0x11, 0x65, 0x9e, 0x79, 0x56, 0x0d, 0x01, 0x8c, 0x92, 0x66, 0x08, 0xd2,
-	0x8c, 0x7d, 0xe4, 0x05, 0xed, 0x0f, 0x9e, 0x3c, 0xab, 0xcd, 0xfd, 0xf4, 0xbc, 0xd6, 0x70, 0x3d,
-	0x72, 0x30, 0xea, 0x19, 0x7d, 0xe4, 0x0b, 0x16, 0xc4, 0xbf, 0x5d, 0xec, 0x7c, 0x63, 0x92, 0x49,
-	0x08, 0x31, 0x73, 0xc0, 0xdf, 0x9f, 0x1d, 0x6f, 0xaf, 0x0d, 0xa0, 0x6b, 0xf7, 0x27, 0x5d, 0x4a,
-	0x3b, 0xfe, 0xf1, 0xec, 0x78, 0x5b, 0xb2, 0x36, 0xc4, 0xcd, 0xef, 0xf3, 0x8b, 0xe5, 0xb7, 0xc1,
-	0x72, 0xc8, 0xa0, 0xc0, 0xa1, 0xb2, 0x50, 0x97, 0x1a, 0x2b, 0x6d, 0xe5, 0xd7, 0x47, 0xbb, 0x15,
-	0x91, 0xc7, 0x6d, 0xc7, 0x19, 0x42, 0x8c, 0xef, 0x93, 0xa1, 0x17, 0xb8, 0x56, 0x6c, 0x29, 0xab,
-	0x14, 0x34, 0xb1, 0x1d, 0x9b, 0xd8, 0xca, 0x22, 0xf5, 0xb2, 0xe2, 0xb3, 0x5c, 0x01, 0x4b, 0xc4,
-	0x23, 0x03, 0xa8, 0x2c, 0x31, 0x05, 0x3f, 0xc8, 0x0a, 0x28, 0xe3, 0x91, 0xef, 0xdb, 0xc3, 0x89,
-	0x52, 0x62, 0xf2, 0xe8, 0x28, 0x5f, 0x05, 0x2b, 0xf0, 0x30, 0x84, 0x8e, 0x47, 0xa0, 0xa3, 0x94,
-	0xeb, 0x52, 0x63, 0xd9, 0x9a, 0x0a, 0x5a, 0xcd, 0xef, 0xce, 0x8e, 0xb7, 0xe3, 0x8b, 0x8f, 0xce,
-	0x8e, 0xb7, 0x6b, 0x09, 0xbc, 0xe3, 0xa6, 0x99, 0xab, 0x88, 0x7e, 0x0b, 0x54, 0x73, 0x42, 0x0b,
-	0xe2, 0x10, 0x05, 0x18, 0xca, 0x35, 0xb0, 0x1a, 0x0a, 0x59, 0xd7, 0x73, 0x14, 0xa9, 0x2e, 0x35,
-	0x16, 0x2d, 0x10, 0x89, 0xee, 0x3a, 0xfa, 0x63, 0x09, 0x54, 0x3a, 0xd8, 0xbd, 0x73, 0x08, 0xfb,
-	0x1f, 0x33, 0xf6, 0xf6, 0x51, 0x40, 0x60, 0x40, 0xe4, 0x4f, 0x40, 0xb9, 0xcf, 0x7f, 0x32, 0xaf,
-	0x73, 0xea, 0xdc, 0xd6, 0x7e, 0x79, 0xb4, 0xab, 0xa6, 0x5a, 0x21, 0xaa, 0x22, 0xf3, 0xb5, 0xa2,
-	0x20, 0x14, 0xb7, 0x3d, 0x22, 0x07, 0x68, 0xe8, 0x91, 0x89, 0x32, 0xcf, 0x38, 0x99, 0x0a, 0x5a,
-	0x37, 0x28, 0xee, 0xe9, 0x99, 0x02, 0xd7, 0x73, 0xc0, 0x73, 0x49, 0xea, 0x1a, 0xb8, 0x5a, 0x24,
-	0x8f, 0xe0, 0xeb, 0x7f, 0x48, 0xa0, 0xdc, 0xc1, 0xee, 0x03, 0x44, 0xa0, 0x7c, 0xa3, 0x80, 0x8a,
-	0x76, 0xe5, 0xef, 0x67, 0xb5, 0xa4, 0x98, 0xbf, 0x9a, 0x04, 0x41, 0xb2, 0x01, 0x96, 0xc6, 0x88,
-	0xc0, 0x21, 0xcf, 0x79, 0xc6, 0x73, 0xe1, 0x66, 0x72, 0x13, 0x94, 0x50, 0x48, 0x3c, 0x14, 0xb0,
-	0xf7, 0xb5, 0x31, 0x7d, 0xe4, 0x9c, 0x1d, 0x83, 0xe6, 0xf2, 0x29, 0x33, 0xb0, 0x84, 0xe1, 0xac,
-	0xe7, 0xd5, 0x7a, 0x95, 0x12, 0xc3, 0x43, 0x53, 0x52, 0x2e, 0xe5, 0x48, 0xa1, 0xf1, 0xf4, 0x2d,
-	0xb0, 0x29, 0x7e, 0xc6, 0xd0, 0xff, 0x91, 0x62, 0xd9, 0x17, 0xd0, 0x73, 0x0f, 0x08, 0x74, 0x5e,
-	0x16, 0x05, 0xef, 0x82, 0x32, 0x47, 0x86, 0x95, 0x05, 0xd6, 0xe8, 0xd7, 0x32, 0x1c, 0x44, 0x09,
-	0x25, 0xb8, 0x88, 0x3c, 0x66, 0x92, 0xf1, 0x46, 0x9a, 0x8c, 0x57, 0x0a, 0xc9, 0x88, 0x82, 0xeb,
-	0x55, 0x70, 0x25, 0x23, 0x8a, 0xc9, 0xf9, 0x53, 0x02, 0xa0, 0x83, 0xdd, 0x68, 0x2a, 0xfc, 0x4f,
-	0x5e, 0x6e, 0x82, 0x15, 0x31, 0xd0, 0xd0, 0xc5, 0xdc, 0x4c, 0x4d, 0xe5, 0x5b, 0xa0, 0x64, 0xfb,
-	0x68, 0x14, 0x10, 0x41, 0xcf, 0x8c, 0x39, 0xb8, 0x42, 0xe7, 0x20, 0xbf, 0x59, 0xf8, 0xb4, 0x76,
-	0x58, 0xab, 0xc4, 0xd1, 0x28, 0x11, 0x4a, 0x8e, 0x08, 0x81, 0x4c, 0xaf, 0x00, 0x79, 0x7a, 0x8a,
-	0xe1, 0x3f, 0xe6, 0x6f, 0xe3, 0xf3, 0xd0, 0xb1, 0x09, 0xbc, 0x67, 0x0f, 0x6d, 0x1f, 0x53, 0x30,
-	0xd3, 0xfe, 0x94, 0x2e, 0x02, 0x13, 0x9b, 0xca, 0xef, 0x80, 0x52, 0xc8, 0x22, 0x30, 0x06, 0x56,
-	0xf7, 0x2e, 0x65, 0x6a, 0xcd, 0xc3, 0xa7, 0x80, 0x70, 0xfb, 0xd6, 0xcd, 0x7c, 0xcf, 0x5f, 0x4f,
-	0x00, 0x39, 0x8c, 0x76, 0x65, 0x26, 0x53, 0x51, 0xd7, 0xa4, 0x28, 0x06, 0x76, 0x24, 0xb1, 0x9d,
-	0xb5, 0x6f, 0x07, 0x7d, 0x38, 0x48, 0xec, 0xac, 0x82, 0xf2, 0x6e, 0x66, 0xca, 0x9b, 0xaa, 0x6c,
-	0x72, 0x4d, 0xcc, 0xbf, 0xe8, 0x9a, 0x68, 0xad, 0xa7, 0x86, 0xb7, 0xfe, 0xb3, 0xc4, 0x26, 0x73,
-	0x3a, 0x99, 0x78, 0x32, 0xff, 0xf7, 0xa4, 0xee, 0x82, 0xf5, 0x3e, 0x8b, 0x05, 0x9d, 0x2e, 0x5d,
-	0xd6, 0x82, 0x70, 0x35, 0x37, 0x97, 0x3f, 0x8b, 0x36, 0x79, 0x7b, 0x99, 0xb2, 0xfe, 0xf0, 0x79,
-	0x4d, 0xb2, 0xd6, 0x22, 0x57, 0xaa, 0x94, 0x5f, 0x07, 0x9b, 0x71, 0xa8, 0x03, 0xd6, 0x1c, 0x6c,
-	0x5a, 0x2d, 0x5a, 0x1b, 0x91, 0xf8, 0x23, 0x26, 0xdd, 0xfb, 0x6b, 0x11, 0x2c, 0x74, 0xb0, 0x2b,
-	0x7f, 0x05, 0x36, 0x32, 0x1f, 0x02, 0xf5, 0x4c, 0x9d, 0x73, 0x3b, 0x48, 0x6d, 0x5c, 0x64, 0x11,
-	0x73, 0x01, 0xc1, 0x56, 0x7e, 0x01, 0x5d, 0xcf, 0xbb, 0xe7, 0x8c, 0xd4, 0x9d, 0x17, 0x30, 0x8a,
-	0xaf, 0x79, 0x0f, 0x2c, 0xb2, 0x4d, 0x70, 0x39, 0xef, 0x44, 0xe5, 0xaa, 0x56, 0x2c, 0x8f, 0xfd,
-	0x1f, 0x80, 0xb5, 0xd4, 0x38, 0x3d, 0xc7, 0x3e, 0xd2, 0xab, 0xaf, 0xcd, 0xd6, 0xc7, 0x71, 0x3f,
-	0x04, 0xe5, 0x68, 0x12, 0x55, 0xf3, 0x2e, 0x42, 0xa5, 0x5e, 0x3b, 0x57, 0x95, 0x4c, 0x30, 0xd5,
-	0xd3, 0x05, 0x09, 0x26, 0xf5, 0x45, 0x09, 0x16, 0xb5, 0x15, 0xad, 0x7e, 0xa6, 0xa5, 0x0a, 0xaa,
-	0x9f, 0xb6, 0x28, 0xaa, 0x7e, 0x71, 0x27, 0xa8, 0x4b, 0xdf, 0xd2, 0xb1, 0xd0, 0xbe, 0xf3, 0xe4,
-	0x44, 0x93, 0x9e, 0x9e, 0x68, 0xd2, 0xef, 0x27, 0x9a, 0xf4, 0xf0, 0x54, 0x9b, 0x7b, 0x7a, 0xaa,
-	0xcd, 0xfd, 0x76, 0xaa, 0xcd, 0x7d, 0xb9, 0x33, 0xf3, 0x23, 0x90, 0xcf, 0x09, 0xf6, 0x29, 0x48,
-	0x3f, 0xbc, 0x4b, 0xac, 0x0d, 0xde, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x6f, 0x84, 0xd5,
-	0xb8, 0x0b, 0x00, 0x00,
+	// 1094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x26, 0x8e, 0xed, 0x4c, 0x12, 0x47, 0x59, 0xb9, 0xed, 0x7a, 0xd5, 0xef, 0xda, 0xdd,
+	0x7e, 0x55, 0xac, 0x84, 0xec, 0xe2, 0x40, 0x2b, 0x64, 0x2a, 0xa4, 0x3a, 0x14, 0xa8, 0x84, 0xa1,
+	0xda, 0x42, 0x91, 0x50, 0x25, 0x6b, 0xed, 0x1d, 0x36, 0x2b, 0xbc, 0x3b, 0x2b, 0xcf, 0xd8, 0x8a,
+	0x6f, 0x88, 0x63, 0x4e, 0x3d, 0xf3, 0x17, 0x20, 0x4e, 0x39, 0xf4, 0xd6, 0x13, 0x07, 0xa4, 0x8a,
+	0x53, 0xc5, 0x89, 0x53, 0x8b, 0x12, 0x41, 0x10, 0xff, 0x04, 0x68, 0x7e, 0xec, 0x7a, 0xbd, 0xeb,
+	0xd8, 0x85, 0x03, 0x17, 0x7b, 0xe7, 0xf3, 0x7e, 0xcc, 0x7b, 0x9f, 0x37, 0xf3, 0xde, 0x80, 0xcb,
+	0x3d, 0x84, 0x7d, 0x84, 0x4d, 0x17, 0x8d, 0xcc, 0x51, 0xc3, 0x24, 0x47, 0x46, 0x38, 0x40, 0x04,
+	0xc9, 0x9b, 0x1c, 0x37, 0x5c, 0x34, 0x32, 0x46, 0x0d, 0x55, 0x13, 0x6a, 0x5d, 0x1b, 0x43, 0x73,
+	0xd4, 0xe8, 0x42, 0x62, 0x37, 0xcc, 0x1e, 0xf2, 0x02, 0xae, 0xae, 0x5e, 0x99, 0x76, 0x43, 0xad,
+	0xb8, 0xa0, 0xec, 0x22, 0x17, 0xb1, 0x4f, 0x93, 0x7e, 0x09, 0xb4, 0xc2, 0xd5, 0x3b, 0x5c, 0x20,
+	0xb6, 0x12, 0x22, 0x17, 0x21, 0xb7, 0x0f, 0x4d, 0xb6, 0xea, 0x0e, 0xbf, 0x34, 0xed, 0x60, 0x9c,
+	0xda, 0xc4, 0xc7, 0x2e, 0xdd, 0xc4, 0xc7, 0xae, 0x10, 0x6c, 0xdb, 0xbe, 0x17, 0x20, 0x93, 0xfd,
+	0x0a, 0xa8, 0x9a, 0x76, 0x43, 0x3c, 0x1f, 0x62, 0x62, 0xfb, 0x21, 0x57, 0xd0, 0x7f, 0x5c, 0x01,
+	0xdb, 0x6d, 0xec, 0x3e, 0x18, 0x76, 0x7d, 0x8f, 0xdc, 0x1f, 0xa0, 0x10, 0x61, 0xbb, 0x2f, 0xbf,
+	0x01, 0x8a, 0x3e, 0xc4, 0xd8, 0x76, 0x21, 0x56, 0xa4, 0xda, 0x4a, 0x7d, 0x7d, 0xbf, 0x6c, 0x70,
+	0x4f, 0x46, 0xe4, 0xc9, 0xb8, 0x13, 0x8c, 0xad, 0x58, 0x4b, 0x3e, 0x96, 0xc0, 0x96, 0x17, 0x78,
+	0xc4, 0xb3, 0xfb, 0x1d, 0x07, 0x86, 0x08, 0x7b, 0x44, 0x59, 0x66, 0x96, 0x15, 0x43, 0x24, 0x46,
+	0x49, 0x33, 0x04, 0x69, 0xc6, 0x01, 0xf2, 0x82, 0xd6, 0xfb, 0xcf, 0x5e, 0x54, 0x97, 0xbe, 0x7f,
+	0x59, 0xad, 0xbb, 0x1e, 0x39, 0x1c, 0x76, 0x8d, 0x1e, 0xf2, 0x05, 0x0b, 0xe2, 0x6f, 0x0f, 0x3b,
+	0x5f, 0x99, 0x64, 0x1c, 0x42, 0xcc, 0x0c, 0xf0, 0xb7, 0xe7, 0x27, 0x3b, 0x1b, 0x7d, 0xe8, 0xda,
+	0xbd, 0x71, 0x87, 0xd2, 0x8e, 0xbf, 0x3b, 0x3f, 0xd9, 0x91, 0xac, 0x92, 0xd8, 0xf9, 0x3d, 0xbe,
+	0xb1, 0xfc, 0x16, 0x28, 0x86, 0x2c, 0x15, 0x38, 0x50, 0x56, 0x6a, 0x52, 0x7d, 0xad, 0xa5, 0xfc,
+	0xfc, 0x64, 0xaf, 0x2c, 0xe2, 0xb8, 0xe3, 0x38, 0x03, 0x88, 0xf1, 0x03, 0x32, 0xf0, 0x02, 0xd7,
+	0x8a, 0x35, 0x65, 0x95, 0x26, 0x4d, 0x6c, 0xc7, 0x26, 0xb6, 0x92, 0xa3, 0x56, 0x56, 0xbc, 0x96,
+	0xcb, 0x60, 0x95, 0x78, 0xa4, 0x0f, 0x95, 0x55, 0x26, 0xe0, 0x0b, 0x59, 0x01, 0x05, 0x3c, 0xf4,
+	0x7d, 0x7b, 0x30, 0x56, 0xf2, 0x0c, 0x8f, 0x96, 0xf2, 0x55, 0xb0, 0x06, 0x8f, 0x42, 0xe8, 0x78,
+	0x04, 0x3a, 0x4a, 0xa1, 0x26, 0xd5, 0x8b, 0xd6, 0x04, 0x90, 0x35, 0x00, 0x50, 0x48, 0x3c, 0xdf,
+	0xc3, 0xc4, 0xeb, 0x29, 0x45, 0x26, 0x4e, 0x20, 0xcd, 0xc6, 0x37, 0xe7, 0x27, 0x3b, 0x71, 0x60,
+	0xc7, 0xe7, 0x27, 0x3b, 0xd5, 0x04, 0x1f, 0xa3, 0x86, 0x99, 0xa9, 0x98, 0x7e, 0x1b, 0x54, 0x32,
+	0xa0, 0x05, 0x71, 0x88, 0x02, 0x0c, 0xe5, 0x2a, 0x58, 0x0f, 0x05, 0xd6, 0xf1, 0x1c, 0x45, 0xaa,
+	0x49, 0xf5, 0x9c, 0x05, 0x22, 0xe8, 0x9e, 0xa3, 0x3f, 0x95, 0x40, 0xb9, 0x8d, 0xdd, 0xbb, 0x47,
+	0xb0, 0xf7, 0x11, 0x63, 0xf7, 0x00, 0x05, 0x04, 0x06, 0x44, 0xfe, 0x18, 0x14, 0x7a, 0xfc, 0x93,
+	0x59, 0x5d, 0x70, 0x0e, 0x5a, 0xda, 0x4f, 0x4f, 0xf6, 0xd4, 0xa9, 0xab, 0x12, 0x55, 0x99, 0xd9,
+	0x5a, 0x91, 0x13, 0xca, 0x8b, 0x3d, 0x24, 0x87, 0x68, 0xe0, 0x91, 0xb1, 0xb2, 0xcc, 0x38, 0x9b,
+	0x00, 0xcd, 0x9b, 0x34, 0xef, 0xc9, 0x9a, 0x26, 0xae, 0x67, 0x12, 0xcf, 0x04, 0xa9, 0x6b, 0xe0,
+	0xea, 0x2c, 0x3c, 0x4a, 0x5f, 0xff, 0x4d, 0x02, 0x85, 0x36, 0x76, 0x1f, 0x22, 0x02, 0xe5, 0x9b,
+	0x33, 0xa8, 0x68, 0x95, 0xff, 0x7c, 0x51, 0x4d, 0xc2, 0xfc, 0x54, 0x25, 0x08, 0x92, 0x0d, 0xb0,
+	0x3a, 0x42, 0x04, 0x0e, 0x94, 0xe5, 0x05, 0xc7, 0x89, 0xab, 0xc9, 0x0d, 0x90, 0xa7, 0xf5, 0x44,
+	0x01, 0x3b, 0x7f, 0xa5, 0xc9, 0x25, 0xe0, 0xec, 0x18, 0x34, 0x96, 0x4f, 0x98, 0x82, 0x25, 0x14,
+	0xe7, 0x1d, 0xbf, 0xe6, 0xff, 0x29, 0x31, 0xdc, 0x35, 0x25, 0xe5, 0x52, 0x86, 0x14, 0xea, 0x4f,
+	0xdf, 0x06, 0x5b, 0xe2, 0x33, 0x4e, 0xfd, 0x2f, 0x29, 0xc6, 0x3e, 0x87, 0x9e, 0x7b, 0x48, 0x4f,
+	0xdf, 0x7f, 0x44, 0xc1, 0x3b, 0xa0, 0xc0, 0x33, 0xc3, 0xca, 0x0a, 0x6b, 0x04, 0xd7, 0x52, 0x1c,
+	0x44, 0x01, 0x25, 0xb8, 0x88, 0x2c, 0xe6, 0x92, 0xf1, 0xfa, 0x34, 0x19, 0xff, 0x9b, 0x49, 0x46,
+	0xe4, 0x5c, 0xaf, 0x80, 0x2b, 0x29, 0x28, 0x26, 0xe7, 0x77, 0x09, 0x80, 0x36, 0x76, 0xa3, 0xae,
+	0xf1, 0x2f, 0x79, 0xb9, 0x05, 0xd6, 0x44, 0xc3, 0x43, 0x8b, 0xb9, 0x99, 0xa8, 0xca, 0xb7, 0x41,
+	0xde, 0xf6, 0xd1, 0x30, 0x20, 0x82, 0x9e, 0x39, 0x7d, 0x72, 0x8d, 0xf6, 0x49, 0xbe, 0xb3, 0xb0,
+	0x69, 0xee, 0xb2, 0xab, 0x12, 0x7b, 0xa3, 0x44, 0x28, 0x19, 0x22, 0x44, 0x66, 0x7a, 0x19, 0xc8,
+	0x93, 0x55, 0x9c, 0xfe, 0x53, 0x7e, 0x36, 0x3e, 0x0b, 0x1d, 0x9b, 0xc0, 0xfb, 0xf6, 0xc0, 0xf6,
+	0x31, 0x4d, 0x66, 0x72, 0x3f, 0xa5, 0x45, 0xc9, 0xc4, 0xaa, 0xf2, 0xdb, 0x20, 0x1f, 0x32, 0x0f,
+	0x8c, 0x81, 0xf5, 0xfd, 0x4b, 0xa9, 0x5a, 0x73, 0xf7, 0x53, 0x89, 0x70, 0xfd, 0xe6, 0xad, 0xec,
+	0x9d, 0xbf, 0x9e, 0x48, 0xe4, 0x28, 0x9a, 0xa5, 0xa9, 0x48, 0x45, 0x5d, 0x93, 0x50, 0x9c, 0xd8,
+	0xb1, 0xc4, 0x66, 0xda, 0x81, 0x1d, 0xf4, 0x60, 0x3f, 0x31, 0xd3, 0x66, 0x94, 0x77, 0x2b, 0x55,
+	0xde, 0xa9, 0xca, 0x26, 0xc7, 0xc8, 0xf2, 0xab, 0x8e, 0x91, 0xe6, 0xe6, 0x54, 0xf3, 0xd6, 0x7f,
+	0x90, 0x40, 0x25, 0x13, 0x4c, 0xdc, 0x99, 0xff, 0x79, 0x50, 0xf7, 0xc0, 0x66, 0x8f, 0xf9, 0x82,
+	0x4e, 0x87, 0x0e, 0x73, 0x41, 0xb8, 0x9a, 0xe9, 0xcb, 0x9f, 0x46, 0x93, 0xbe, 0x55, 0xa4, 0xac,
+	0x3f, 0x7e, 0x59, 0x95, 0xac, 0x8d, 0xc8, 0x94, 0x0a, 0xe5, 0xd7, 0xc0, 0x56, 0xec, 0xea, 0x90,
+	0x5d, 0x0e, 0xd6, 0xad, 0x72, 0x56, 0x29, 0x82, 0x3f, 0x64, 0xe8, 0xfe, 0x1f, 0x39, 0xb0, 0xd2,
+	0xc6, 0xae, 0xfc, 0x08, 0x94, 0x52, 0x0f, 0x85, 0x5a, 0xaa, 0xce, 0x99, 0x19, 0xa4, 0xd6, 0x17,
+	0x69, 0xc4, 0x5c, 0x40, 0xb0, 0x9d, 0x1d, 0x40, 0xd7, 0xb3, 0xe6, 0x19, 0x25, 0x75, 0xf7, 0x15,
+	0x94, 0xe2, 0x6d, 0xde, 0x05, 0x39, 0x36, 0x09, 0x2e, 0x67, 0x8d, 0x28, 0xae, 0x6a, 0xb3, 0xf1,
+	0xd8, 0xfe, 0x21, 0xd8, 0x98, 0x6a, 0xa7, 0x17, 0xe8, 0x47, 0x72, 0xf5, 0xc6, 0x7c, 0x79, 0xec,
+	0xf7, 0x03, 0x50, 0x88, 0x3a, 0x51, 0x25, 0x6b, 0x22, 0x44, 0xea, 0xb5, 0x0b, 0x45, 0xc9, 0x00,
+	0xa7, 0xee, 0xf4, 0x8c, 0x00, 0x93, 0x72, 0xf5, 0xc6, 0x7c, 0x79, 0xec, 0xf7, 0x11, 0x28, 0xa5,
+	0xae, 0xd4, 0x8c, 0xea, 0x4f, 0x6b, 0xa8, 0xf5, 0x45, 0x1a, 0x91, 0x77, 0x75, 0xf5, 0x6b, 0xda,
+	0x16, 0x5a, 0x77, 0x9f, 0x9d, 0x6a, 0xd2, 0xf3, 0x53, 0x4d, 0xfa, 0xf5, 0x54, 0x93, 0x1e, 0x9f,
+	0x69, 0x4b, 0xcf, 0xcf, 0xb4, 0xa5, 0x5f, 0xce, 0xb4, 0xa5, 0x2f, 0x76, 0xe7, 0x3e, 0x12, 0x79,
+	0x9f, 0x60, 0x4f, 0x45, 0xfa, 0x30, 0xcf, 0xb3, 0x6b, 0xf0, 0xe6, 0xdf, 0x03, 0x00, 0xb7, 0x8a,
+	0x2f, 0xbf, 0xd8, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1258,6 +1271,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Optimistic {
+		i--
+		if m.Optimistic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1828,6 +1851,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.Optimistic {
+		n += 2
+	}
 	return n
 }
 
@@ -2275,6 +2301,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optimistic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2,8 +2,11 @@ package mint
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
@@ -25,6 +28,16 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, ic types.InflationCalcul
 		return err
 	}
 
+	schedule, err := k.GetEmissionSchedule(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the emission schedule replaces the inflation rate
+	if len(schedule.Epochs) > 0 {
+		return mintScheduled(ctx, k, minter, params, schedule)
+	}
+
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
@@ -37,6 +50,7 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, ic types.InflationCalcul
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
+	mintedCoin.Amount = k.CapProvision(ctx, params, mintedCoin.Amount)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err = k.MintCoins(ctx, mintedCoins)
@@ -67,3 +81,50 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, ic types.InflationCalcul
 
 	return nil
 }
+
+// mintScheduled mints the block provision of the epoch of the block, and
+// distributes it to the splits of the epoch.
+func mintScheduled(ctx context.Context, k keeper.Keeper, minter types.Minter, params types.Params, schedule types.EmissionSchedule) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	index, epoch, ok := schedule.EpochAt(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+	if !ok {
+		// the schedule has not started yet
+		return nil
+	}
+
+	// keep the minter consistent with the emission of the epoch
+	supply := k.StakingTokenSupply(ctx)
+	minter.AnnualProvisions = math.LegacyNewDecFromInt(epoch.BlockProvision).MulInt64(int64(params.BlocksPerYear))
+	minter.Inflation = math.LegacyZeroDec()
+	if supply.IsPositive() {
+		minter.Inflation = minter.AnnualProvisions.QuoInt(supply)
+	}
+	if err := k.Minter.Set(ctx, minter); err != nil {
+		return err
+	}
+
+	mintedCoin := sdk.NewCoin(params.MintDenom, k.CapProvision(ctx, params, epoch.BlockProvision))
+	if err := k.MintCoins(ctx, sdk.NewCoins(mintedCoin)); err != nil {
+		return err
+	}
+
+	if err := k.DistributeEmission(ctx, epoch, mintedCoin); err != nil {
+		return err
+	}
+
+	if mintedCoin.Amount.IsInt64() {
+		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.Itoa(index)),
+			sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
+			sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -24,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryEmissionSchedule(),
+		GetCmdQuerySupplyProjection(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +119,67 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryEmissionSchedule implements a command to return the current
+// emission schedule.
+func GetCmdQueryEmissionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-schedule",
+		Short: "Query the current emission schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEmissionScheduleRequest{}
+			res, err := queryClient.EmissionSchedule(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.EmissionSchedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySupplyProjection implements a command to return the projected
+// supply of the mint denom at a future height.
+func GetCmdQuerySupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-projection [height]",
+		Short:   "Query the projected supply of the mint denom at a future height",
+		Example: fmt.Sprintf("$ %s query %s supply-projection 1000000", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			params := &types.QuerySupplyProjectionRequest{Height: height}
+			res, err := queryClient.SupplyProjection(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -45,7 +45,7 @@ func TestGetCmdQueryParams(t *testing.T) {
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
 			`[--height=1 --output=json]`,
-			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0"}`,
+			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0","max_supply":"0"}`,
 		},
 		{
 			"text output",
@@ -56,6 +56,7 @@ goal_bonded: "0"
 inflation_max: "0"
 inflation_min: "0"
 inflation_rate_change: "0"
+max_supply: "0"
 mint_denom: ""`,
 		},
 	}
@@ -205,3 +206,54 @@ func TestGetCmdQueryAnnualProvisions(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCmdQuerySupplyProjection(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	baseCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithClient(clitestutil.MockCometRPC{Client: rpcclientmock.Client{}}).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{
+			"missing height",
+			[]string{},
+			"accepts 1 arg(s), received 0",
+		},
+		{
+			"invalid height",
+			[]string{"abc"},
+			"invalid height abc",
+		},
+		{
+			"valid height",
+			[]string{"100", fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := mintcli.GetCmdQuerySupplyProjection()
+			cmd.SetOut(io.Discard)
+
+			_, err := clitestutil.ExecTestCLICmd(baseCtx, cmd, tc.args)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
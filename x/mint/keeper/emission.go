@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+const (
+	// hoursPerYear is the duration of a year used to estimate the duration of
+	// a block from the blocks per year param.
+	hoursPerYear = 8766
+	// maxProjectionYears bounds the number of blocks of a supply projection.
+	maxProjectionYears = 100
+)
+
+// GetEmissionSchedule returns the emission schedule, which is empty if the
+// module mints based on the inflation rate.
+func (k Keeper) GetEmissionSchedule(ctx context.Context) (types.EmissionSchedule, error) {
+	schedule, err := k.EmissionSchedule.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EmissionSchedule{}, nil
+	}
+
+	return schedule, err
+}
+
+// ValidateEmissionSchedule validates the emission schedule, and checks that
+// the module accounts of its splits exist and can receive the minted tokens.
+//
+// Module accounts with the burner or staking permission, such as the staking
+// pools or the gov deposits escrow, hold tokens whose amount is accounted for
+// by their module, hence cannot be split recipients.
+func (k Keeper) ValidateEmissionSchedule(schedule types.EmissionSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	for _, epoch := range schedule.Epochs {
+		for _, split := range epoch.Splits {
+			addr, permissions := k.authKeeper.GetModuleAddressAndPermissions(split.Module)
+			if addr == nil {
+				return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", split.Module)
+			}
+
+			for _, permission := range permissions {
+				if permission == authtypes.Burner || permission == authtypes.Staking {
+					return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s with the %s permission cannot receive minted tokens", split.Module, permission)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// CapProvision returns the part of the provision which can be minted without
+// the supply of the mint denom exceeding the max supply.
+func (k Keeper) CapProvision(ctx context.Context, params types.Params, provision math.Int) math.Int {
+	if !params.HasMaxSupply() {
+		return provision
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	if supply.GTE(params.MaxSupply) {
+		return math.ZeroInt()
+	}
+
+	return math.MinInt(provision, params.MaxSupply.Sub(supply))
+}
+
+// DistributeEmission sends the tokens minted during the epoch to the module
+// accounts of its splits, or to the fee collector if the epoch has no split.
+func (k Keeper) DistributeEmission(ctx context.Context, epoch types.EmissionEpoch, minted sdk.Coin) error {
+	if len(epoch.Splits) == 0 {
+		return k.AddCollectedFees(ctx, sdk.NewCoins(minted))
+	}
+
+	for i, amount := range epoch.SplitProvision(minted) {
+		if amount.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, epoch.Splits[i].Module, sdk.NewCoins(amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProjectSupply returns the projected supply of the mint denom at the end of
+// the block at the given height, and whether the max supply is reached by then.
+//
+// The projection follows the emission schedule, estimating the height of the
+// epochs starting at a time from the blocks per year param. Without emission
+// schedule, the supply grows at the current inflation rate.
+func (k Keeper) ProjectSupply(ctx context.Context, height int64) (sdk.Coin, bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blocks := height - sdkCtx.BlockHeight()
+	if blocks <= 0 {
+		return sdk.Coin{}, false, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "height %d must be greater than the current height %d", height, sdkCtx.BlockHeight())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, false, err
+	}
+
+	if uint64(blocks) > maxProjectionYears*params.BlocksPerYear {
+		return sdk.Coin{}, false, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot project the supply more than %d years ahead", maxProjectionYears)
+	}
+
+	schedule, err := k.GetEmissionSchedule(ctx)
+	if err != nil {
+		return sdk.Coin{}, false, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+	var projected math.Int
+	if len(schedule.Epochs) > 0 {
+		blockDuration := hoursPerYear * time.Hour / time.Duration(params.BlocksPerYear)
+		if blockDuration <= 0 {
+			return sdk.Coin{}, false, fmt.Errorf("invalid block duration for %d blocks per year", params.BlocksPerYear)
+		}
+
+		projected = schedule.ProjectSupply(supply, sdkCtx.BlockHeight(), sdkCtx.BlockTime(), blockDuration, height)
+	} else {
+		minter, err := k.Minter.Get(ctx)
+		if err != nil {
+			return sdk.Coin{}, false, err
+		}
+
+		// (1 + inflation / blocksPerYear) ^ blocks
+		growth := math.LegacyOneDec().Add(minter.Inflation.QuoInt64(int64(params.BlocksPerYear))).Power(uint64(blocks))
+		projected = growth.MulInt(supply).TruncateInt()
+	}
+
+	reached := params.HasMaxSupply() && projected.GTE(params.MaxSupply)
+	if reached {
+		projected = math.MaxInt(supply, params.MaxSupply)
+	}
+
+	return sdk.NewCoin(params.MintDenom, projected), reached, nil
+}
@@ -15,6 +15,14 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 		panic(err)
 	}
 
+	if err := keeper.ValidateEmissionSchedule(data.EmissionSchedule); err != nil {
+		panic(err)
+	}
+
+	if err := keeper.EmissionSchedule.Set(ctx, data.EmissionSchedule); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -30,5 +38,12 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(minter, params)
+	schedule, err := keeper.GetEmissionSchedule(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(minter, params)
+	genState.EmissionSchedule = schedule
+	return genState
 }
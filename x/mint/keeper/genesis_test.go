@@ -70,6 +70,7 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 		math.LegacyNewDecWithPrec(69, 2),
 		uint64(60*60*8766/5),
 	)
+	genesisState.EmissionSchedule = types.NewHalvingSchedule(10, 100, math.NewInt(1000), 2)
 
 	s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
 
@@ -85,6 +86,10 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 	s.Require().Equal(genesisState.Params, params)
 	s.Require().NoError(err)
 
+	schedule, err := s.keeper.GetEmissionSchedule(s.sdkCtx)
+	s.Require().Equal(genesisState.EmissionSchedule, schedule)
+	s.Require().NoError(err)
+
 	genesisState2 := s.keeper.ExportGenesis(s.sdkCtx)
 	s.Require().Equal(genesisState, genesisState2)
 }
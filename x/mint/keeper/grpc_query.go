@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -49,3 +52,27 @@ func (q queryServer) AnnualProvisions(c context.Context, _ *types.QueryAnnualPro
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// EmissionSchedule returns the emission schedule of the mint module.
+func (q queryServer) EmissionSchedule(c context.Context, _ *types.QueryEmissionScheduleRequest) (*types.QueryEmissionScheduleResponse, error) {
+	schedule, err := q.k.GetEmissionSchedule(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEmissionScheduleResponse{EmissionSchedule: schedule}, nil
+}
+
+// SupplyProjection returns the projected supply of the mint denom at a future height.
+func (q queryServer) SupplyProjection(c context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	supply, reached, err := q.k.ProjectSupply(c, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySupplyProjectionResponse{Supply: supply, MaxSupplyReached: reached}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -83,6 +84,19 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, minter.AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCEmissionSchedule() {
+	res, err := suite.queryClient.EmissionSchedule(gocontext.Background(), &types.QueryEmissionScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.EmissionSchedule.Epochs)
+
+	schedule := types.NewHalvingSchedule(10, 100, math.NewInt(1000), 3)
+	suite.Require().NoError(suite.mintKeeper.EmissionSchedule.Set(suite.ctx, schedule))
+
+	res, err = suite.queryClient.EmissionSchedule(gocontext.Background(), &types.QueryEmissionScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(schedule, res.EmissionSchedule)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -20,6 +20,7 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeService     storetypes.KVStoreService
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
 
@@ -27,9 +28,10 @@ type Keeper struct {
 	// should be the x/gov module account.
 	authority string
 
-	Schema collections.Schema
-	Params collections.Item[types.Params]
-	Minter collections.Item[types.Minter]
+	Schema           collections.Schema
+	Params           collections.Item[types.Params]
+	Minter           collections.Item[types.Minter]
+	EmissionSchedule collections.Item[types.EmissionSchedule]
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -52,11 +54,13 @@ func NewKeeper(
 		cdc:              cdc,
 		storeService:     storeService,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		EmissionSchedule: collections.NewItem(sb, types.EmissionScheduleKey, "emission_schedule", codec.CollValue[types.EmissionSchedule](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -28,6 +28,7 @@ type IntegrationTestSuite struct {
 	ctx           sdk.Context
 	msgServer     types.MsgServer
 	stakingKeeper *minttestutil.MockStakingKeeper
+	accountKeeper *minttestutil.MockAccountKeeper
 	bankKeeper    *minttestutil.MockBankKeeper
 }
 
@@ -60,6 +61,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	s.stakingKeeper = stakingKeeper
+	s.accountKeeper = accountKeeper
 	s.bankKeeper = bankKeeper
 
 	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
@@ -90,3 +92,75 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestBeginBlockerEmissionSchedule() {
+	split := types.NewEmissionSplit("distribution", math.LegacyNewDecWithPrec(6, 1))
+	feeSplit := types.NewEmissionSplit(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(4, 1))
+	schedule := types.NewHalvingSchedule(10, 100, math.NewInt(1000), 2, split, feeSplit)
+	s.Require().NoError(s.mintKeeper.EmissionSchedule.Set(s.ctx, schedule))
+
+	params := types.DefaultParams()
+	params.MaxSupply = math.NewInt(1_000_500)
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	// the schedule has not started
+	s.Require().NoError(mint.BeginBlocker(s.ctx.WithBlockHeight(9), s.mintKeeper, types.DefaultInflationCalculationFn))
+
+	// first epoch
+	ctx := s.ctx.WithBlockHeight(110)
+	s.stakingKeeper.EXPECT().StakingTokenSupply(ctx).Return(math.NewInt(1_000_000))
+	s.bankKeeper.EXPECT().GetSupply(ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))
+	minted := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	s.bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, minted).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, "distribution", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))).Return(nil)
+	s.Require().NoError(mint.BeginBlocker(ctx, s.mintKeeper, types.DefaultInflationCalculationFn))
+
+	minter, err := s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(int64(500*params.BlocksPerYear)), minter.AnnualProvisions)
+
+	// max supply reached
+	ctx = s.ctx.WithBlockHeight(111)
+	s.stakingKeeper.EXPECT().StakingTokenSupply(ctx).Return(math.NewInt(1_000_500))
+	s.bankKeeper.EXPECT().GetSupply(ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_500))
+	s.bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, sdk.NewCoins()).Return(nil).AnyTimes()
+	s.Require().NoError(mint.BeginBlocker(ctx, s.mintKeeper, types.DefaultInflationCalculationFn))
+}
+
+func (s *IntegrationTestSuite) TestProjectSupply() {
+	ctx := s.ctx.WithBlockHeight(5)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)).AnyTimes()
+
+	_, _, err := s.mintKeeper.ProjectSupply(ctx, 5)
+	s.Require().Error(err)
+
+	// inflation rate
+	supply, reached, err := s.mintKeeper.ProjectSupply(ctx, 6)
+	s.Require().NoError(err)
+	s.Require().False(reached)
+	minter, err := s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	params, err := s.mintKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	expected := math.LegacyOneDec().Add(minter.Inflation.QuoInt64(int64(params.BlocksPerYear))).MulInt64(1_000_000).TruncateInt()
+	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, expected), supply)
+
+	// emission schedule
+	s.Require().NoError(s.mintKeeper.EmissionSchedule.Set(ctx, types.NewHalvingSchedule(10, 100, math.NewInt(1000), 2)))
+	supply, reached, err = s.mintKeeper.ProjectSupply(ctx, 110)
+	s.Require().NoError(err)
+	s.Require().False(reached)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000+100*1000+500), supply)
+
+	// max supply
+	params.MaxSupply = math.NewInt(1_050_000)
+	s.Require().NoError(s.mintKeeper.Params.Set(ctx, params))
+	supply, reached, err = s.mintKeeper.ProjectSupply(ctx, 110)
+	s.Require().NoError(err)
+	s.Require().True(reached)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_050_000), supply)
+
+	_, _, err = s.mintKeeper.ProjectSupply(ctx, 5+101*int64(params.BlocksPerYear))
+	s.Require().Error(err)
+}
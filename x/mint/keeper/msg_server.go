@@ -41,3 +41,20 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateEmissionSchedule replaces the emission schedule.
+func (ms msgServer) UpdateEmissionSchedule(goCtx context.Context, msg *types.MsgUpdateEmissionSchedule) (*types.MsgUpdateEmissionScheduleResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.ValidateEmissionSchedule(msg.EmissionSchedule); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EmissionSchedule.Set(goCtx, msg.EmissionSchedule); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEmissionScheduleResponse{}, nil
+}
@@ -4,6 +4,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -71,3 +73,88 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestUpdateEmissionSchedule() {
+	split := types.NewEmissionSplit(authtypes.FeeCollectorName, sdkmath.LegacyOneDec())
+	s.accountKeeper.EXPECT().GetModuleAddressAndPermissions(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName), nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddressAndPermissions("bonded_tokens_pool").Return(authtypes.NewModuleAddress("bonded_tokens_pool"), []string{authtypes.Burner, authtypes.Staking}).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddressAndPermissions(govtypes.ModuleName).Return(authtypes.NewModuleAddress(govtypes.ModuleName), []string{authtypes.Burner}).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddressAndPermissions("unknown").Return(nil, nil).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateEmissionSchedule
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateEmissionSchedule{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid schedule",
+			request: &types.MsgUpdateEmissionSchedule{
+				Authority:        s.mintKeeper.GetAuthority(),
+				EmissionSchedule: types.NewEmissionSchedule(types.NewHeightEpoch(0, sdkmath.NewInt(100))),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set schedule with unknown split module",
+			request: &types.MsgUpdateEmissionSchedule{
+				Authority:        s.mintKeeper.GetAuthority(),
+				EmissionSchedule: types.NewHalvingSchedule(1, 100, sdkmath.NewInt(100), 2, types.NewEmissionSplit("unknown", sdkmath.LegacyOneDec())),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set schedule with staking pool split module",
+			request: &types.MsgUpdateEmissionSchedule{
+				Authority:        s.mintKeeper.GetAuthority(),
+				EmissionSchedule: types.NewHalvingSchedule(1, 100, sdkmath.NewInt(100), 2, types.NewEmissionSplit("bonded_tokens_pool", sdkmath.LegacyOneDec())),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set schedule with gov split module",
+			request: &types.MsgUpdateEmissionSchedule{
+				Authority:        s.mintKeeper.GetAuthority(),
+				EmissionSchedule: types.NewHalvingSchedule(1, 100, sdkmath.NewInt(100), 2, types.NewEmissionSplit(govtypes.ModuleName, sdkmath.LegacyOneDec())),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set valid schedule",
+			request: &types.MsgUpdateEmissionSchedule{
+				Authority:        s.mintKeeper.GetAuthority(),
+				EmissionSchedule: types.NewHalvingSchedule(1, 100, sdkmath.NewInt(100), 2, split),
+			},
+			expectErr: false,
+		},
+		{
+			name: "set empty schedule",
+			request: &types.MsgUpdateEmissionSchedule{
+				Authority: s.mintKeeper.GetAuthority(),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateEmissionSchedule(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			schedule, err := s.mintKeeper.GetEmissionSchedule(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(len(tc.request.EmissionSchedule.Epochs), len(schedule.Epochs))
+		})
+	}
+}
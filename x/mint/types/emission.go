@@ -0,0 +1,208 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEmissionSchedule returns an EmissionSchedule with the given epochs.
+func NewEmissionSchedule(epochs ...EmissionEpoch) EmissionSchedule {
+	return EmissionSchedule{Epochs: epochs}
+}
+
+// NewHeightEpoch returns an EmissionEpoch starting at the given height.
+func NewHeightEpoch(startHeight int64, blockProvision math.Int, splits ...EmissionSplit) EmissionEpoch {
+	return EmissionEpoch{
+		StartHeight:    startHeight,
+		BlockProvision: blockProvision,
+		Splits:         splits,
+	}
+}
+
+// NewTimeEpoch returns an EmissionEpoch starting at the given time.
+func NewTimeEpoch(startTime time.Time, blockProvision math.Int, splits ...EmissionSplit) EmissionEpoch {
+	return EmissionEpoch{
+		StartTime:      &startTime,
+		BlockProvision: blockProvision,
+		Splits:         splits,
+	}
+}
+
+// NewEmissionSplit returns an EmissionSplit sending the given share of the
+// minted tokens to the module account.
+func NewEmissionSplit(module string, weight math.LegacyDec) EmissionSplit {
+	return EmissionSplit{Module: module, Weight: weight}
+}
+
+// NewHalvingSchedule returns an EmissionSchedule of count epochs of epochBlocks
+// blocks each, from startHeight. The block provision of the first epoch is
+// initialProvision, and is halved at each epoch.
+func NewHalvingSchedule(startHeight, epochBlocks int64, initialProvision math.Int, count int, splits ...EmissionSplit) EmissionSchedule {
+	epochs := make([]EmissionEpoch, count)
+	provision := initialProvision
+	for i := range epochs {
+		epochs[i] = NewHeightEpoch(startHeight+int64(i)*epochBlocks, provision, splits...)
+		provision = provision.QuoRaw(2)
+	}
+
+	return NewEmissionSchedule(epochs...)
+}
+
+// IsTimeBased returns true if the epochs of the schedule start at a time.
+func (s EmissionSchedule) IsTimeBased() bool {
+	return len(s.Epochs) > 0 && s.Epochs[0].StartTime != nil
+}
+
+// Validate does a basic validation of the emission schedule.
+func (s EmissionSchedule) Validate() error {
+	timeBased := s.IsTimeBased()
+	for i, epoch := range s.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return fmt.Errorf("invalid emission epoch %d: %w", i, err)
+		}
+
+		if (epoch.StartTime != nil) != timeBased {
+			return errors.New("emission epochs must either all start at a height or all start at a time")
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := s.Epochs[i-1]
+		if timeBased && !epoch.StartTime.After(*prev.StartTime) {
+			return fmt.Errorf("emission epoch %d must start after epoch %d: %s <= %s", i, i-1, epoch.StartTime, prev.StartTime)
+		}
+		if !timeBased && epoch.StartHeight <= prev.StartHeight {
+			return fmt.Errorf("emission epoch %d must start after epoch %d: %d <= %d", i, i-1, epoch.StartHeight, prev.StartHeight)
+		}
+	}
+
+	return nil
+}
+
+// EpochAt returns the index and the epoch of the block at the given height and
+// time. It returns false if the schedule has not started.
+func (s EmissionSchedule) EpochAt(height int64, blockTime time.Time) (int, EmissionEpoch, bool) {
+	for i := len(s.Epochs) - 1; i >= 0; i-- {
+		if s.Epochs[i].startsBefore(height, blockTime) {
+			return i, s.Epochs[i], true
+		}
+	}
+
+	return 0, EmissionEpoch{}, false
+}
+
+// ProjectSupply returns the supply at the end of the block at targetHeight,
+// from the supply at the end of the block at height, without maximum supply.
+// The heights of the epochs starting at a time are estimated from the time of
+// the block at height and the duration of a block.
+func (s EmissionSchedule) ProjectSupply(supply math.Int, height int64, blockTime time.Time, blockDuration time.Duration, targetHeight int64) math.Int {
+	startHeights := make([]int64, len(s.Epochs))
+	for i, epoch := range s.Epochs {
+		switch {
+		case epoch.StartTime == nil:
+			startHeights[i] = epoch.StartHeight
+		case !epoch.StartTime.After(blockTime):
+			startHeights[i] = height
+		default:
+			// first block with an estimated time after the start of the epoch
+			blocks := (epoch.StartTime.Sub(blockTime) + blockDuration - 1) / blockDuration
+			startHeights[i] = height + int64(blocks)
+		}
+	}
+
+	for i, epoch := range s.Epochs {
+		from, to := startHeights[i], targetHeight
+		if from <= height {
+			from = height + 1
+		}
+		if i+1 < len(s.Epochs) && startHeights[i+1] <= to {
+			to = startHeights[i+1] - 1
+		}
+
+		if to >= from {
+			supply = supply.Add(epoch.BlockProvision.MulRaw(to - from + 1))
+		}
+	}
+
+	return supply
+}
+
+// Validate does a basic validation of the emission epoch.
+func (e EmissionEpoch) Validate() error {
+	if e.StartTime != nil && e.StartHeight != 0 {
+		return errors.New("epoch cannot start both at a height and at a time")
+	}
+	if e.StartTime == nil && e.StartHeight <= 0 {
+		return fmt.Errorf("epoch start height must be positive: %d", e.StartHeight)
+	}
+	if e.BlockProvision.IsNil() || e.BlockProvision.IsNegative() {
+		return fmt.Errorf("epoch block provision cannot be negative: %s", e.BlockProvision)
+	}
+
+	if len(e.Splits) == 0 {
+		return nil
+	}
+
+	modules := make(map[string]bool, len(e.Splits))
+	total := math.LegacyZeroDec()
+	for _, split := range e.Splits {
+		if strings.TrimSpace(split.Module) == "" {
+			return errors.New("split module cannot be blank")
+		}
+		if split.Module == ModuleName {
+			return fmt.Errorf("split module cannot be the %s module", ModuleName)
+		}
+		if modules[split.Module] {
+			return fmt.Errorf("duplicate split module %s", split.Module)
+		}
+		modules[split.Module] = true
+
+		if split.Weight.IsNil() || !split.Weight.IsPositive() {
+			return fmt.Errorf("split weight must be positive: %s", split.Weight)
+		}
+		total = total.Add(split.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("split weights must add up to one: %s", total)
+	}
+
+	return nil
+}
+
+// SplitProvision returns the amounts of the minted coin sent to the module
+// accounts of the splits of the epoch. The remainder of the truncated amounts
+// is sent to the last split.
+func (e EmissionEpoch) SplitProvision(minted sdk.Coin) []sdk.Coin {
+	amounts := make([]sdk.Coin, len(e.Splits))
+	remaining := minted.Amount
+	for i, split := range e.Splits {
+		amount := split.Weight.MulInt(minted.Amount).TruncateInt()
+		if i == len(e.Splits)-1 {
+			amount = remaining
+		}
+
+		amounts[i] = sdk.NewCoin(minted.Denom, amount)
+		remaining = remaining.Sub(amount)
+	}
+
+	return amounts
+}
+
+// startsBefore returns true if the block at the given height and time belongs
+// to the epoch or to a later epoch.
+func (e EmissionEpoch) startsBefore(height int64, blockTime time.Time) bool {
+	if e.StartTime != nil {
+		return !blockTime.Before(*e.StartTime)
+	}
+
+	return height >= e.StartHeight
+}
@@ -0,0 +1,175 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEmissionScheduleValidate(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	provision := math.NewInt(100)
+	half := math.LegacyNewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name     string
+		schedule EmissionSchedule
+		expErr   string
+	}{
+		{"empty schedule", NewEmissionSchedule(), ""},
+		{"height epochs", NewEmissionSchedule(NewHeightEpoch(1, provision), NewHeightEpoch(10, provision)), ""},
+		{"time epochs", NewEmissionSchedule(NewTimeEpoch(start, provision), NewTimeEpoch(start.Add(time.Hour), provision)), ""},
+		{
+			"splits",
+			NewEmissionSchedule(NewHeightEpoch(1, provision, NewEmissionSplit("distribution", half), NewEmissionSplit("fee_collector", half))),
+			"",
+		},
+		{"zero start height", NewEmissionSchedule(NewHeightEpoch(0, provision)), "start height must be positive"},
+		{
+			"both starts",
+			NewEmissionSchedule(EmissionEpoch{StartHeight: 1, StartTime: &start, BlockProvision: provision}),
+			"cannot start both at a height and at a time",
+		},
+		{"nil provision", NewEmissionSchedule(NewHeightEpoch(1, math.Int{})), "block provision cannot be negative"},
+		{"negative provision", NewEmissionSchedule(NewHeightEpoch(1, math.NewInt(-1))), "block provision cannot be negative"},
+		{
+			"mixed starts",
+			NewEmissionSchedule(NewHeightEpoch(1, provision), NewTimeEpoch(start, provision)),
+			"either all start at a height or all start at a time",
+		},
+		{"unordered heights", NewEmissionSchedule(NewHeightEpoch(10, provision), NewHeightEpoch(10, provision)), "must start after epoch 0"},
+		{
+			"unordered times",
+			NewEmissionSchedule(NewTimeEpoch(start, provision), NewTimeEpoch(start.Add(-time.Hour), provision)),
+			"must start after epoch 0",
+		},
+		{
+			"blank split module",
+			NewEmissionSchedule(NewHeightEpoch(1, provision, NewEmissionSplit(" ", math.LegacyOneDec()))),
+			"split module cannot be blank",
+		},
+		{
+			"mint module split",
+			NewEmissionSchedule(NewHeightEpoch(1, provision, NewEmissionSplit(ModuleName, math.LegacyOneDec()))),
+			"cannot be the mint module",
+		},
+		{
+			"duplicate split module",
+			NewEmissionSchedule(NewHeightEpoch(1, provision, NewEmissionSplit("distribution", half), NewEmissionSplit("distribution", half))),
+			"duplicate split module",
+		},
+		{
+			"zero split weight",
+			NewEmissionSchedule(NewHeightEpoch(1, provision, NewEmissionSplit("distribution", math.LegacyOneDec()), NewEmissionSplit("fee_collector", math.LegacyZeroDec()))),
+			"split weight must be positive",
+		},
+		{
+			"split weights below one",
+			NewEmissionSchedule(NewHeightEpoch(1, provision, NewEmissionSplit("distribution", half))),
+			"split weights must add up to one",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestNewHalvingSchedule(t *testing.T) {
+	schedule := NewHalvingSchedule(10, 100, math.NewInt(1000), 4)
+	require.NoError(t, schedule.Validate())
+	require.Len(t, schedule.Epochs, 4)
+
+	for i, exp := range []int64{1000, 500, 250, 125} {
+		require.Equal(t, int64(10+100*i), schedule.Epochs[i].StartHeight)
+		require.Equal(t, math.NewInt(exp), schedule.Epochs[i].BlockProvision)
+	}
+}
+
+func TestEmissionScheduleEpochAt(t *testing.T) {
+	heights := NewHalvingSchedule(10, 100, math.NewInt(1000), 2)
+
+	_, _, ok := heights.EpochAt(9, time.Time{})
+	require.False(t, ok)
+
+	index, epoch, ok := heights.EpochAt(10, time.Time{})
+	require.True(t, ok)
+	require.Equal(t, 0, index)
+	require.Equal(t, math.NewInt(1000), epoch.BlockProvision)
+
+	index, _, ok = heights.EpochAt(109, time.Time{})
+	require.True(t, ok)
+	require.Equal(t, 0, index)
+
+	index, _, ok = heights.EpochAt(1000, time.Time{})
+	require.True(t, ok)
+	require.Equal(t, 1, index)
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := NewEmissionSchedule(NewTimeEpoch(start, math.NewInt(10)), NewTimeEpoch(start.Add(time.Hour), math.NewInt(5)))
+
+	_, _, ok = times.EpochAt(100, start.Add(-time.Second))
+	require.False(t, ok)
+
+	index, _, ok = times.EpochAt(1, start)
+	require.True(t, ok)
+	require.Equal(t, 0, index)
+
+	index, _, ok = times.EpochAt(1, start.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, 1, index)
+}
+
+func TestEmissionScheduleProjectSupply(t *testing.T) {
+	supply := math.NewInt(1_000_000)
+
+	// epochs of 100 blocks from height 10, minting 1000, 500 and 250 per block
+	heights := NewHalvingSchedule(10, 100, math.NewInt(1000), 3)
+	require.Equal(t, supply, heights.ProjectSupply(supply, 5, time.Time{}, time.Second, 9))
+	require.Equal(t, supply.AddRaw(1000), heights.ProjectSupply(supply, 5, time.Time{}, time.Second, 10))
+	require.Equal(t, supply.AddRaw(100*1000+500), heights.ProjectSupply(supply, 5, time.Time{}, time.Second, 110))
+	require.Equal(t, supply.AddRaw(50*1000+100*500+100*250+10*250), heights.ProjectSupply(supply, 59, time.Time{}, time.Second, 319))
+
+	// epochs starting in 10s and 20s, with blocks of 5s
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := NewEmissionSchedule(NewTimeEpoch(now.Add(10*time.Second), math.NewInt(10)), NewTimeEpoch(now.Add(20*time.Second), math.NewInt(5)))
+	require.Equal(t, supply, times.ProjectSupply(supply, 100, now, 5*time.Second, 101))
+	require.Equal(t, supply.AddRaw(2*10+3*5), times.ProjectSupply(supply, 100, now, 5*time.Second, 106))
+
+	// started epochs
+	require.Equal(t, supply.AddRaw(4*5), times.ProjectSupply(supply, 100, now.Add(time.Minute), 5*time.Second, 104))
+}
+
+func TestEmissionEpochSplitProvision(t *testing.T) {
+	epoch := NewHeightEpoch(1, math.NewInt(100),
+		NewEmissionSplit("distribution", math.LegacyNewDecWithPrec(333, 3)),
+		NewEmissionSplit("community", math.LegacyNewDecWithPrec(333, 3)),
+		NewEmissionSplit("fee_collector", math.LegacyNewDecWithPrec(334, 3)),
+	)
+
+	amounts := epoch.SplitProvision(sdk.NewInt64Coin("stake", 100))
+	require.Equal(t, []sdk.Coin{
+		sdk.NewInt64Coin("stake", 33),
+		sdk.NewInt64Coin("stake", 33),
+		sdk.NewInt64Coin("stake", 34),
+	}, amounts)
+
+	amounts = epoch.SplitProvision(sdk.NewInt64Coin("stake", 10))
+	require.Equal(t, []sdk.Coin{
+		sdk.NewInt64Coin("stake", 3),
+		sdk.NewInt64Coin("stake", 3),
+		sdk.NewInt64Coin("stake", 4),
+	}, amounts)
+}
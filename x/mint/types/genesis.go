@@ -29,8 +29,9 @@ func NewGenesisState(minter Minter, params Params) *GenesisState {
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Minter: DefaultInitialMinter(),
-		Params: DefaultParams(),
+		Minter:           DefaultInitialMinter(),
+		Params:           DefaultParams(),
+		EmissionSchedule: EmissionSchedule{},
 	}
 }
 
@@ -41,5 +42,9 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateMinter(data.Minter)
+	if err := ValidateMinter(data.Minter); err != nil {
+		return err
+	}
+
+	return data.EmissionSchedule.Validate()
 }
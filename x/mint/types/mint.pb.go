@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -81,6 +85,11 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// maximum supply of the mint denom. Minting stops once the total supply
+	// reaches it, zero means there is no maximum supply.
+	//
+	// Since: cosmos-sdk 0.50
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,42 +139,234 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+// EmissionSchedule defines the epochs of the scheduled minting. When the
+// schedule has epochs, the tokens minted at each block are given by the epoch
+// of the block instead of the inflation rate.
+//
+// Since: cosmos-sdk 0.50
+type EmissionSchedule struct {
+	// epochs are the emission epochs, ordered by start. They either all start at
+	// a height or all start at a time.
+	Epochs []EmissionEpoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *EmissionSchedule) Reset()         { *m = EmissionSchedule{} }
+func (m *EmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*EmissionSchedule) ProtoMessage()    {}
+func (*EmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *EmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionSchedule.Merge(m, src)
+}
+func (m *EmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionSchedule proto.InternalMessageInfo
+
+func (m *EmissionSchedule) GetEpochs() []EmissionEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// EmissionEpoch defines the tokens minted at each block from the start of the
+// epoch until the start of the next epoch.
+//
+// Since: cosmos-sdk 0.50
+type EmissionEpoch struct {
+	// start_height is the height of the first block of the epoch, zero if the
+	// epoch starts at start_time.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the time from which the blocks belong to the epoch, if the
+	// epoch does not start at start_height.
+	StartTime *time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// block_provision is the amount of tokens minted at each block of the epoch.
+	BlockProvision github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=block_provision,json=blockProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"block_provision"`
+	// splits distribute the minted tokens to module accounts. The tokens are sent
+	// to the fee collector when the epoch has no split.
+	Splits []EmissionSplit `protobuf:"bytes,4,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *EmissionEpoch) Reset()         { *m = EmissionEpoch{} }
+func (m *EmissionEpoch) String() string { return proto.CompactTextString(m) }
+func (*EmissionEpoch) ProtoMessage()    {}
+func (*EmissionEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *EmissionEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionEpoch.Merge(m, src)
+}
+func (m *EmissionEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionEpoch proto.InternalMessageInfo
+
+func (m *EmissionEpoch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EmissionEpoch) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *EmissionEpoch) GetSplits() []EmissionSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+// EmissionSplit defines the share of the tokens minted during an epoch which
+// is sent to a module account.
+//
+// Since: cosmos-sdk 0.50
+type EmissionSplit struct {
+	// module is the name of the module account receiving the tokens.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// weight is the share of the minted tokens, the weights of the splits of an
+	// epoch add up to one.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *EmissionSplit) Reset()         { *m = EmissionSplit{} }
+func (m *EmissionSplit) String() string { return proto.CompactTextString(m) }
+func (*EmissionSplit) ProtoMessage()    {}
+func (*EmissionSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *EmissionSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionSplit.Merge(m, src)
+}
+func (m *EmissionSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionSplit proto.InternalMessageInfo
+
+func (m *EmissionSplit) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*EmissionSchedule)(nil), "cosmos.mint.v1beta1.EmissionSchedule")
+	proto.RegisterType((*EmissionEpoch)(nil), "cosmos.mint.v1beta1.EmissionEpoch")
+	proto.RegisterType((*EmissionSplit)(nil), "cosmos.mint.v1beta1.EmissionSplit")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0x8a, 0x4d, 0x18, 0xdd, 0xe8, 0xce, 0x6a, 0x52, 0x37, 0xd9, 0xee, 0x66, 0x0f,
-	0x04, 0x49, 0x68, 0x43, 0xbc, 0x19, 0x2f, 0x02, 0x57, 0x12, 0xd2, 0x9b, 0x5c, 0x9a, 0x69, 0x3b,
-	0x94, 0x09, 0xed, 0x0c, 0x99, 0x19, 0x08, 0x7c, 0x05, 0x4f, 0x5e, 0xfd, 0x06, 0x1e, 0x39, 0xf8,
-	0x21, 0xb8, 0x49, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0x81, 0xaf, 0x61, 0x3a, 0xd3, 0x14, 0xe3, 0xc1,
-	0x8b, 0x78, 0xe9, 0x9f, 0xe7, 0x79, 0xfb, 0x7b, 0x9e, 0x36, 0x7d, 0xa1, 0x1b, 0x73, 0x99, 0x73,
-	0xe9, 0xe7, 0x94, 0x29, 0x7f, 0xd1, 0x89, 0x88, 0xc2, 0x1d, 0x7d, 0xe3, 0xcd, 0x04, 0x57, 0x1c,
-	0x5d, 0x19, 0xdf, 0xd3, 0x52, 0xe9, 0x5f, 0x3f, 0x4f, 0x79, 0xca, 0xb5, 0xef, 0x17, 0x57, 0x66,
-	0xf4, 0xfa, 0xa5, 0x19, 0x0d, 0x8d, 0x51, 0x3e, 0x67, 0xac, 0x4b, 0x9c, 0x53, 0xc6, 0x7d, 0x7d,
-	0x34, 0xd2, 0xfd, 0x57, 0x00, 0xed, 0x01, 0x65, 0x8a, 0x08, 0x34, 0x82, 0x75, 0xca, 0xc6, 0x19,
-	0x56, 0x94, 0x33, 0x07, 0xdc, 0x81, 0x66, 0xbd, 0xfb, 0x76, 0xb3, 0xbb, 0xb5, 0x7e, 0xec, 0x6e,
-	0x1b, 0x29, 0x55, 0x93, 0x79, 0xe4, 0xc5, 0x3c, 0x2f, 0x89, 0xe5, 0xa9, 0x2d, 0x93, 0xa9, 0xaf,
-	0x56, 0x33, 0x22, 0xbd, 0x3e, 0x89, 0xbf, 0x7d, 0x69, 0xc3, 0x32, 0xb0, 0x4f, 0xe2, 0xe0, 0x84,
-	0x43, 0x14, 0x5e, 0x62, 0xc6, 0xe6, 0x38, 0x2b, 0x6a, 0x2d, 0xa8, 0xa4, 0x9c, 0x49, 0xe7, 0xc1,
-	0x19, 0x32, 0x9e, 0x19, 0xec, 0xb0, 0xa2, 0xde, 0x7f, 0xaa, 0x41, 0x7b, 0x88, 0x05, 0xce, 0x25,
-	0xba, 0x81, 0xb0, 0xf8, 0x60, 0x61, 0x42, 0x18, 0xcf, 0xcd, 0x2b, 0x05, 0xf5, 0x42, 0xe9, 0x17,
-	0x02, 0x9a, 0xc3, 0x17, 0x55, 0xc3, 0x50, 0x60, 0x45, 0xc2, 0x78, 0x82, 0x59, 0x4a, 0xca, 0x62,
-	0xef, 0xfe, 0xa5, 0xd8, 0xe7, 0xe3, 0xba, 0x05, 0x82, 0xab, 0x8a, 0x1f, 0x60, 0x45, 0x7a, 0x9a,
-	0x8e, 0xc6, 0xf0, 0xe2, 0x14, 0x9b, 0xe3, 0xa5, 0xf3, 0xf0, 0x5c, 0x71, 0x4f, 0x2a, 0xee, 0x00,
-	0x2f, 0xff, 0xc8, 0xa1, 0xcc, 0xa9, 0xfd, 0x87, 0x1c, 0xca, 0x50, 0x04, 0x1f, 0xa7, 0x1c, 0x67,
-	0x61, 0xc4, 0x59, 0x42, 0x12, 0xe7, 0xd1, 0xb9, 0x52, 0x60, 0x41, 0xed, 0x6a, 0x28, 0x6a, 0xc0,
-	0xa7, 0x51, 0xc6, 0xe3, 0xa9, 0x0c, 0x67, 0x44, 0x84, 0x2b, 0x82, 0x85, 0x63, 0xdf, 0x81, 0x66,
-	0x2d, 0xb8, 0x30, 0xf2, 0x90, 0x88, 0xf7, 0x04, 0x8b, 0x37, 0x37, 0x1f, 0x8e, 0xeb, 0x96, 0xf3,
-	0x5b, 0xc2, 0xd2, 0xac, 0x94, 0xf9, 0x21, 0xba, 0xbd, 0xcd, 0xde, 0x05, 0xdb, 0xbd, 0x0b, 0x7e,
-	0xee, 0x5d, 0xf0, 0xf1, 0xe0, 0x5a, 0xdb, 0x83, 0x6b, 0x7d, 0x3f, 0xb8, 0xd6, 0xe8, 0xd5, 0x5f,
-	0x7b, 0x96, 0x14, 0x5d, 0x37, 0xb2, 0xf5, 0xe6, 0xbc, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0xf6, 0x61, 0x3e, 0xb4, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3d, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf5, 0x62, 0xbf, 0x7d, 0xf2, 0x18, 0xbf, 0x07, 0xc3, 0x7b, 0xd1, 0x06, 0x89, 0x35, 0x71,
+	0x81, 0x08, 0x12, 0xbb, 0x82, 0x74, 0x51, 0xa4, 0x24, 0x06, 0xa4, 0x50, 0x20, 0xa1, 0x25, 0x0d,
+	0x34, 0x9b, 0xd9, 0xf5, 0xb0, 0x9e, 0xb0, 0x33, 0xb3, 0xda, 0x19, 0x13, 0xd3, 0xa6, 0x4c, 0xc5,
+	0x5f, 0x48, 0x97, 0x92, 0x22, 0x3f, 0x82, 0x2e, 0x28, 0x55, 0x94, 0x82, 0x44, 0x50, 0xf0, 0x23,
+	0xd2, 0x44, 0xf3, 0x81, 0x81, 0x28, 0x8a, 0x12, 0xc5, 0x69, 0x6c, 0xcf, 0xb9, 0xf7, 0x9e, 0x73,
+	0x7c, 0xe7, 0xde, 0x01, 0x7e, 0xca, 0x05, 0xe5, 0x22, 0xa4, 0x84, 0xc9, 0x70, 0x7f, 0x29, 0xc1,
+	0x12, 0x2d, 0xe9, 0x43, 0x50, 0x94, 0x5c, 0x72, 0x38, 0x65, 0xe2, 0x81, 0x86, 0x6c, 0x7c, 0xfa,
+	0xbf, 0x8c, 0x67, 0x5c, 0xc7, 0x43, 0xf5, 0xcb, 0xa4, 0x4e, 0xdf, 0x36, 0xa9, 0xb1, 0x09, 0xd8,
+	0x3a, 0x13, 0x9a, 0x44, 0x94, 0x30, 0x1e, 0xea, 0x4f, 0x0b, 0xb5, 0x32, 0xce, 0xb3, 0x1c, 0x87,
+	0xfa, 0x94, 0xf4, 0x77, 0x43, 0x49, 0x28, 0x16, 0x12, 0xd1, 0xc2, 0x24, 0xb4, 0xdf, 0x39, 0xc0,
+	0xdd, 0x20, 0x4c, 0xe2, 0x12, 0xee, 0x80, 0x3a, 0x61, 0xbb, 0x39, 0x92, 0x84, 0x33, 0xcf, 0x99,
+	0x75, 0xe6, 0xeb, 0x9d, 0x07, 0xc7, 0xa7, 0xad, 0xca, 0xc7, 0xd3, 0xd6, 0x5c, 0x46, 0x64, 0xaf,
+	0x9f, 0x04, 0x29, 0xa7, 0x56, 0xd2, 0x7e, 0x2d, 0x8a, 0xee, 0x5e, 0x28, 0x0f, 0x0a, 0x2c, 0x82,
+	0x55, 0x9c, 0xbe, 0x7f, 0xbb, 0x08, 0xac, 0xa3, 0x55, 0x9c, 0x46, 0x57, 0x74, 0x90, 0x80, 0x49,
+	0xc4, 0x58, 0x1f, 0xe5, 0xca, 0xf7, 0x3e, 0x11, 0x84, 0x33, 0xe1, 0x8d, 0x8d, 0x40, 0x63, 0xc2,
+	0xd0, 0x6e, 0x0e, 0x59, 0xdb, 0x5f, 0x6a, 0xc0, 0xdd, 0x44, 0x25, 0xa2, 0x02, 0xce, 0x00, 0xa0,
+	0x3a, 0x1a, 0x77, 0x31, 0xe3, 0xd4, 0xfc, 0xa5, 0xa8, 0xae, 0x90, 0x55, 0x05, 0xc0, 0x3e, 0xf8,
+	0x7f, 0xe8, 0x30, 0x2e, 0x91, 0xc4, 0x71, 0xda, 0x43, 0x2c, 0xc3, 0xd6, 0xd8, 0xe3, 0xdf, 0x31,
+	0xf6, 0xe6, 0xe2, 0x68, 0xc1, 0x89, 0xa6, 0x86, 0xfc, 0x11, 0x92, 0x78, 0x45, 0xb3, 0xc3, 0x5d,
+	0xd0, 0xbc, 0x92, 0xa5, 0x68, 0xe0, 0x55, 0x47, 0x25, 0x37, 0x3e, 0xe4, 0xdd, 0x40, 0x83, 0x6f,
+	0x74, 0x08, 0xf3, 0x6a, 0x7f, 0x40, 0x87, 0x30, 0x98, 0x80, 0x46, 0xc6, 0x51, 0x1e, 0x27, 0x9c,
+	0x75, 0x71, 0xd7, 0xfb, 0x6b, 0x54, 0x2a, 0x40, 0xb1, 0x76, 0x34, 0x29, 0x9c, 0x03, 0xff, 0x26,
+	0x39, 0x4f, 0xf7, 0x44, 0x5c, 0xe0, 0x32, 0x3e, 0xc0, 0xa8, 0xf4, 0xdc, 0x59, 0x67, 0xbe, 0x16,
+	0x35, 0x0d, 0xbc, 0x89, 0xcb, 0x6d, 0x8c, 0x4a, 0xf8, 0x0c, 0x00, 0x8a, 0x06, 0xb1, 0xe8, 0x17,
+	0x45, 0x7e, 0xe0, 0xfd, 0xfd, 0xcb, 0x56, 0xd6, 0x99, 0xbc, 0x66, 0x65, 0x9d, 0x49, 0x63, 0xa5,
+	0x4e, 0xd1, 0x60, 0x4b, 0x73, 0xde, 0x9f, 0x79, 0x75, 0x71, 0xb4, 0xe0, 0x5d, 0x2b, 0x1c, 0x98,
+	0xad, 0x36, 0x23, 0xd7, 0xde, 0x06, 0x13, 0x6b, 0x94, 0x08, 0x35, 0x8a, 0x5b, 0x69, 0x0f, 0x77,
+	0xfb, 0x39, 0x86, 0x6b, 0xc0, 0xc5, 0x05, 0x4f, 0x7b, 0xc2, 0x73, 0x66, 0xab, 0xf3, 0x8d, 0xe5,
+	0x76, 0xf0, 0x9d, 0x75, 0x0f, 0x2e, 0xcb, 0xd6, 0x54, 0x6a, 0xa7, 0xae, 0x4c, 0x1b, 0x71, 0x5b,
+	0xdc, 0x7e, 0x3d, 0x06, 0x9a, 0x37, 0x92, 0xe0, 0x1d, 0x30, 0x2e, 0x24, 0x2a, 0x65, 0xdc, 0xc3,
+	0x24, 0xeb, 0x49, 0x3d, 0xe1, 0xd5, 0xa8, 0xa1, 0xb1, 0x27, 0x1a, 0x82, 0x0f, 0x01, 0x30, 0x29,
+	0x6a, 0xf1, 0xf5, 0x60, 0x37, 0x96, 0xa7, 0x03, 0xf3, 0x2a, 0x04, 0x97, 0xaf, 0x42, 0xf0, 0xf4,
+	0xf2, 0x55, 0xe8, 0xd4, 0x0e, 0x3f, 0xb5, 0x9c, 0xa8, 0xae, 0x6b, 0x14, 0x0a, 0x9f, 0xdb, 0xce,
+	0x5f, 0x2d, 0xae, 0x57, 0x1d, 0x55, 0x5b, 0xff, 0xd1, 0xcc, 0xc3, 0xdd, 0x85, 0x8f, 0x80, 0x2b,
+	0x8a, 0x9c, 0x48, 0xe1, 0xd5, 0x7e, 0xa2, 0x51, 0x5b, 0x2a, 0xb5, 0x53, 0x53, 0x36, 0x22, 0x5b,
+	0xd7, 0x7e, 0xe9, 0x80, 0xe6, 0x8d, 0x38, 0xbc, 0x05, 0x5c, 0xca, 0xd5, 0x35, 0xd8, 0xfd, 0xb7,
+	0x27, 0xb8, 0x0d, 0xdc, 0x17, 0xa6, 0x6b, 0x23, 0xdb, 0x76, 0x4b, 0xd8, 0x59, 0x39, 0x3e, 0xf3,
+	0x9d, 0x93, 0x33, 0xdf, 0xf9, 0x7c, 0xe6, 0x3b, 0x87, 0xe7, 0x7e, 0xe5, 0xe4, 0xdc, 0xaf, 0x7c,
+	0x38, 0xf7, 0x2b, 0x3b, 0x77, 0x7f, 0x48, 0x6e, 0x27, 0x49, 0x6b, 0x24, 0xae, 0xbe, 0x9c, 0x7b,
+	0x5f, 0x07, 0x00, 0xd7, 0xbd, 0x1a, 0x81, 0x3b, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +432,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -286,6 +497,145 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.BlockProvision.Size()
+		i -= size
+		if _, err := m.BlockProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMint(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -331,6 +681,62 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *EmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -674,6 +1080,413 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EmissionEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, EmissionSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -130,37 +130,143 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateEmissionSchedule is the Msg/UpdateEmissionSchedule request type.
+//
+// Since: cosmos-sdk 0.50
+type MsgUpdateEmissionSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// emission_schedule defines the new emission schedule, an empty schedule
+	// restores the minting based on the inflation rate.
+	EmissionSchedule EmissionSchedule `protobuf:"bytes,2,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule"`
+}
+
+func (m *MsgUpdateEmissionSchedule) Reset()         { *m = MsgUpdateEmissionSchedule{} }
+func (m *MsgUpdateEmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEmissionSchedule) ProtoMessage()    {}
+func (*MsgUpdateEmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{2}
+}
+func (m *MsgUpdateEmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEmissionSchedule.Merge(m, src)
+}
+func (m *MsgUpdateEmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEmissionSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateEmissionSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateEmissionSchedule) GetEmissionSchedule() EmissionSchedule {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return EmissionSchedule{}
+}
+
+// MsgUpdateEmissionScheduleResponse defines the response structure for
+// executing a MsgUpdateEmissionSchedule message.
+//
+// Since: cosmos-sdk 0.50
+type MsgUpdateEmissionScheduleResponse struct {
+}
+
+func (m *MsgUpdateEmissionScheduleResponse) Reset()         { *m = MsgUpdateEmissionScheduleResponse{} }
+func (m *MsgUpdateEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEmissionScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{3}
+}
+func (m *MsgUpdateEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEmissionScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEmissionScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.mint.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.mint.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateEmissionSchedule)(nil), "cosmos.mint.v1beta1.MsgUpdateEmissionSchedule")
+	proto.RegisterType((*MsgUpdateEmissionScheduleResponse)(nil), "cosmos.mint.v1beta1.MsgUpdateEmissionScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/tx.proto", fileDescriptor_a0d933a8bf5e188a) }
 
 var fileDescriptor_a0d933a8bf5e188a = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0xe2, 0x30, 0x2d, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0xa2, 0x5a, 0x4a,
-	0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x85, 0xe4, 0xb0, 0x19, 0x0f, 0x36, 0x0d,
-	0x22, 0x2f, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x49, 0x88, 0xae,
-	0x78, 0x88, 0x04, 0xd4, 0x0d, 0x60, 0x8e, 0xd2, 0x7e, 0x46, 0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0,
-	0x82, 0x94, 0xc4, 0x92, 0xd4, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x33, 0x2e, 0xce, 0xc4,
-	0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89,
-	0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x1a, 0x1d, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a,
-	0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xec, 0xb8, 0xd8, 0x0a, 0xc0, 0x26, 0x48, 0x30, 0x29,
-	0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0xf1, 0xae, 0x1e, 0xc4, 0x12, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x65, 0x65, 0xd2, 0xf4, 0x7c, 0x83,
-	0x16, 0xc2, 0xbc, 0xae, 0xe7, 0x1b, 0xb4, 0x14, 0x21, 0x26, 0xe8, 0x16, 0xa7, 0x64, 0xeb, 0x57,
-	0x40, 0x7c, 0x8d, 0xe6, 0x5a, 0x25, 0x49, 0x2e, 0x71, 0x34, 0xa1, 0xa0, 0xd4, 0xe2, 0x82, 0xfc,
-	0xbc, 0xe2, 0x54, 0xa3, 0x02, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x24, 0x2e, 0x1e, 0x14, 0xff,
-	0xa9, 0x60, 0x75, 0x17, 0x9a, 0x21, 0x52, 0x3a, 0xc4, 0xa8, 0x82, 0x59, 0x25, 0xc5, 0xda, 0x00,
-	0xf2, 0x8a, 0x93, 0xf3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
-	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa6,
-	0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x42, 0x63, 0x40, 0x1f, 0xd3, 0x6f, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xa8, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x13,
-	0x9e, 0x4f, 0xda, 0x4c, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x31, 0xcf, 0xd2, 0x40,
+	0x18, 0xee, 0x69, 0x24, 0xe1, 0x34, 0x51, 0x2a, 0x11, 0xa8, 0xa6, 0x42, 0xd1, 0x04, 0x89, 0xf4,
+	0x02, 0x1a, 0x06, 0x06, 0x13, 0x31, 0x8e, 0x24, 0x06, 0xe2, 0x62, 0x62, 0x48, 0x4b, 0x2f, 0xa5,
+	0xd1, 0xf6, 0x9a, 0xde, 0x41, 0x60, 0x23, 0x8e, 0x4e, 0xfe, 0x0c, 0x47, 0x06, 0x77, 0x57, 0x46,
+	0xe2, 0xe4, 0x64, 0x0c, 0x0c, 0x24, 0xae, 0xfe, 0x01, 0xd3, 0xde, 0x55, 0xbe, 0xaf, 0x14, 0xf2,
+	0x7d, 0xdf, 0xd2, 0x5e, 0xdf, 0xe7, 0x7d, 0x9e, 0xf7, 0x7d, 0x9e, 0xe6, 0xe0, 0x83, 0x11, 0xa1,
+	0x2e, 0xa1, 0xc8, 0x75, 0x3c, 0x86, 0xa6, 0x4d, 0x13, 0x33, 0xa3, 0x89, 0xd8, 0x4c, 0xf7, 0x03,
+	0xc2, 0x88, 0x7c, 0x97, 0xa3, 0x7a, 0x88, 0xea, 0x02, 0x55, 0x0a, 0x31, 0x85, 0xda, 0x68, 0xda,
+	0x0c, 0x5f, 0xbc, 0x5b, 0xc9, 0x19, 0xae, 0xe3, 0x11, 0x14, 0x3d, 0x45, 0x49, 0x4d, 0x93, 0x8f,
+	0xd4, 0x38, 0x9e, 0xb7, 0x89, 0x4d, 0xa2, 0x23, 0x0a, 0x4f, 0xa2, 0x5a, 0xe2, 0xac, 0x21, 0x07,
+	0xc4, 0x0e, 0xd1, 0x87, 0xf6, 0x1d, 0xc0, 0xdb, 0x3d, 0x6a, 0xbf, 0xf5, 0x2d, 0x83, 0xe1, 0x37,
+	0x46, 0x60, 0xb8, 0x54, 0x6e, 0xc3, 0xac, 0x31, 0x61, 0x63, 0x12, 0x38, 0x6c, 0x5e, 0x04, 0x65,
+	0x50, 0xcb, 0x76, 0x8b, 0x3f, 0xbe, 0x35, 0xf2, 0x82, 0xf8, 0xd2, 0xb2, 0x02, 0x4c, 0xe9, 0x80,
+	0x05, 0x8e, 0x67, 0xf7, 0xf7, 0xad, 0xf2, 0x0b, 0x98, 0xf1, 0x23, 0x85, 0xe2, 0xb5, 0x32, 0xa8,
+	0xdd, 0x6c, 0xdd, 0xd7, 0x53, 0xec, 0xea, 0x7c, 0x48, 0x37, 0xbb, 0xfa, 0xf5, 0x50, 0xfa, 0xba,
+	0x5b, 0xd6, 0x41, 0x5f, 0xb0, 0x3a, 0xcf, 0x3f, 0xed, 0x96, 0xf5, 0xbd, 0xde, 0xe7, 0xdd, 0xb2,
+	0x5e, 0xe1, 0x0a, 0x0d, 0x6a, 0x7d, 0x40, 0x33, 0xee, 0x3a, 0xb1, 0xad, 0x56, 0x82, 0x85, 0x44,
+	0xa9, 0x8f, 0xa9, 0x4f, 0x3c, 0x8a, 0xb5, 0x3f, 0x00, 0x96, 0xfe, 0x63, 0xaf, 0x5d, 0x87, 0x52,
+	0x87, 0x78, 0x83, 0xd1, 0x18, 0x5b, 0x93, 0x8f, 0xf8, 0xca, 0x36, 0xdf, 0xc3, 0x1c, 0x16, 0x5a,
+	0x43, 0x2a, 0xc4, 0x84, 0xe3, 0xc7, 0xa9, 0x8e, 0x93, 0x93, 0xcf, 0x7a, 0xbf, 0x83, 0x13, 0x60,
+	0xa7, 0x7d, 0x98, 0x42, 0xf5, 0x44, 0x0a, 0x31, 0x4f, 0xab, 0xc2, 0xca, 0x51, 0xaf, 0x71, 0x22,
+	0xad, 0xbf, 0x00, 0x5e, 0xef, 0x51, 0x5b, 0x36, 0xe1, 0xad, 0x73, 0xbf, 0xfc, 0x51, 0xea, 0xe2,
+	0x89, 0x5c, 0x95, 0xa7, 0x17, 0xe9, 0x8a, 0x67, 0xc9, 0x0b, 0x00, 0xef, 0x1d, 0x89, 0x5e, 0x3f,
+	0x2d, 0x94, 0xec, 0x57, 0xda, 0x97, 0xeb, 0x8f, 0x57, 0x50, 0x6e, 0x2c, 0xc2, 0x90, 0xbb, 0xaf,
+	0x56, 0x1b, 0x15, 0xac, 0x37, 0x2a, 0xf8, 0xbd, 0x51, 0xc1, 0x97, 0xad, 0x2a, 0xad, 0xb7, 0xaa,
+	0xf4, 0x73, 0xab, 0x4a, 0xef, 0x9e, 0xd8, 0x0e, 0x1b, 0x4f, 0x4c, 0x7d, 0x44, 0x5c, 0x71, 0x2f,
+	0xd0, 0x61, 0xd6, 0x6c, 0xee, 0x63, 0x6a, 0x66, 0xa2, 0x0b, 0xf3, 0xec, 0xdf, 0x00, 0x08, 0xa2,
+	0xdd, 0x19, 0xe2, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -180,6 +286,11 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateEmissionSchedule defines a governance operation for replacing the
+	// emission schedule of the x/mint module.
+	//
+	// Since: cosmos-sdk 0.50
+	UpdateEmissionSchedule(ctx context.Context, in *MsgUpdateEmissionSchedule, opts ...grpc.CallOption) (*MsgUpdateEmissionScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -199,6 +310,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateEmissionSchedule(ctx context.Context, in *MsgUpdateEmissionSchedule, opts ...grpc.CallOption) (*MsgUpdateEmissionScheduleResponse, error) {
+	out := new(MsgUpdateEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Msg/UpdateEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
@@ -206,6 +326,11 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateEmissionSchedule defines a governance operation for replacing the
+	// emission schedule of the x/mint module.
+	//
+	// Since: cosmos-sdk 0.50
+	UpdateEmissionSchedule(context.Context, *MsgUpdateEmissionSchedule) (*MsgUpdateEmissionScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -215,6 +340,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateEmissionSchedule(ctx context.Context, req *MsgUpdateEmissionSchedule) (*MsgUpdateEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmissionSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -238,6 +366,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Msg/UpdateEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEmissionSchedule(ctx, req.(*MsgUpdateEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -246,6 +392,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateEmissionSchedule",
+			Handler:    _Msg_UpdateEmissionSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/tx.proto",
@@ -314,6 +464,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateEmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -349,6 +562,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateEmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.EmissionSchedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -520,6 +757,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateEmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
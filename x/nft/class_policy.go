@@ -0,0 +1,113 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// DefaultClassPolicy returns the policy of a class which has none set: anyone may mint its nfts,
+// which are transferable and carry no royalty.
+func DefaultClassPolicy(classID string) ClassPolicy {
+	return ClassPolicy{ClassId: classID}
+}
+
+// ValidateBasic performs a stateless validation of the class policy.
+func (p ClassPolicy) ValidateBasic(ac address.Codec) error {
+	if len(p.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(p.Owner) > 0 {
+		if _, err := ac.StringToBytes(p.Owner); err != nil {
+			return errors.Wrapf(ErrInvalidClassPolicy, "invalid owner address %s: %s", p.Owner, err)
+		}
+	}
+
+	switch p.MintPolicy {
+	case MintPolicy_MINT_POLICY_OPEN:
+	case MintPolicy_MINT_POLICY_OWNER:
+		if len(p.Owner) == 0 {
+			return errors.Wrap(ErrInvalidClassPolicy, "owner mint policy requires an owner")
+		}
+	case MintPolicy_MINT_POLICY_ALLOW_LIST:
+	default:
+		return errors.Wrapf(ErrInvalidClassPolicy, "unknown mint policy %d", p.MintPolicy)
+	}
+
+	if len(p.Minters) > 0 && p.MintPolicy != MintPolicy_MINT_POLICY_ALLOW_LIST {
+		return errors.Wrap(ErrInvalidClassPolicy, "minters can only be set with the allow-list mint policy")
+	}
+
+	seen := make(map[string]bool, len(p.Minters))
+	for _, minter := range p.Minters {
+		if _, err := ac.StringToBytes(minter); err != nil {
+			return errors.Wrapf(ErrInvalidClassPolicy, "invalid minter address %s: %s", minter, err)
+		}
+		if seen[minter] {
+			return errors.Wrapf(ErrInvalidClassPolicy, "duplicate minter %s", minter)
+		}
+		seen[minter] = true
+	}
+
+	if len(p.RoyaltyRecipient) > 0 {
+		if _, err := ac.StringToBytes(p.RoyaltyRecipient); err != nil {
+			return errors.Wrapf(ErrInvalidClassPolicy, "invalid royalty recipient address %s: %s", p.RoyaltyRecipient, err)
+		}
+	}
+
+	rate, err := p.GetRoyaltyRateDec()
+	if err != nil {
+		return errors.Wrapf(ErrInvalidClassPolicy, "invalid royalty rate: %s", err)
+	}
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return errors.Wrapf(ErrInvalidClassPolicy, "royalty rate must be between 0 and 1: %s", rate)
+	}
+	if rate.IsPositive() && len(p.RoyaltyRecipient) == 0 {
+		return errors.Wrap(ErrInvalidClassPolicy, "royalty rate requires a royalty recipient")
+	}
+
+	return nil
+}
+
+// GetRoyaltyRateDec returns the royalty rate of the class, zero if not set.
+func (p ClassPolicy) GetRoyaltyRateDec() (math.LegacyDec, error) {
+	if len(p.RoyaltyRate) == 0 {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return math.LegacyNewDecFromStr(p.RoyaltyRate)
+}
+
+// RoyaltyAmount returns the royalty due to the royalty recipient for the given sale price,
+// rounded down.
+func (p ClassPolicy) RoyaltyAmount(salePrice math.Int) (math.Int, error) {
+	rate, err := p.GetRoyaltyRateDec()
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return math.LegacyNewDecFromInt(salePrice).Mul(rate).TruncateInt(), nil
+}
+
+// IsMinter returns whether the given address may mint nfts of the class.
+func (p ClassPolicy) IsMinter(minter string) bool {
+	switch p.MintPolicy {
+	case MintPolicy_MINT_POLICY_OPEN:
+		return true
+	case MintPolicy_MINT_POLICY_OWNER:
+		return minter == p.Owner
+	case MintPolicy_MINT_POLICY_ALLOW_LIST:
+		if len(p.Owner) > 0 && minter == p.Owner {
+			return true
+		}
+		for _, m := range p.Minters {
+			if m == minter {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
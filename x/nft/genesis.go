@@ -11,6 +11,11 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			return ErrEmptyClassID
 		}
 	}
+	for _, policy := range data.ClassPolicies {
+		if err := policy.ValidateBasic(ac); err != nil {
+			return err
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			if len(nft.Id) == 0 {
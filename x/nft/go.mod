@@ -126,6 +126,7 @@ require (
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/spf13/viper v1.16.0 // indirect
 	github.com/subosito/gotenv v1.4.2 // indirect
+	github.com/supranational/blst v0.3.14 // indirect
 	github.com/syndtr/goleveldb v1.0.1-0.20220721030215-126854af5e6d // indirect
 	github.com/tecbot/gorocksdb v0.0.0-20191217155057-f0fad39f321c // indirect
 	github.com/tendermint/go-amino v0.16.0 // indirect
@@ -157,3 +158,9 @@ require (
 replace github.com/gin-gonic/gin => github.com/gin-gonic/gin v1.9.0
 
 replace github.com/cosmos/cosmos-sdk => ../..
+
+replace cosmossdk.io/collections => ../../collections
+
+replace cosmossdk.io/core => ../../core
+
+replace cosmossdk.io/api => ../../api
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetClassPolicy defines a method for setting the policy of an exist nft class
+func (k Keeper) SetClassPolicy(ctx context.Context, policy nft.ClassPolicy) error {
+	if err := policy.ValidateBasic(k.ac); err != nil {
+		return err
+	}
+
+	if !k.HasClass(ctx, policy.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, policy.ClassId)
+	}
+
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassPolicy failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(classPolicyStoreKey(policy.ClassId), bz)
+}
+
+// GetClassPolicy defines a method for returning the policy of the specified class, the default
+// policy if none was set
+func (k Keeper) GetClassPolicy(ctx context.Context, classID string) nft.ClassPolicy {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(classPolicyStoreKey(classID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return nft.DefaultClassPolicy(classID)
+	}
+
+	var policy nft.ClassPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy
+}
+
+// GetClassPolicies defines a method for returning all the policies set on classes
+func (k Keeper) GetClassPolicies(ctx context.Context) (policies []*nft.ClassPolicy) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassPolicyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy nft.ClassPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, &policy)
+	}
+	return
+}
+
+// MintBy defines a method for minting a new nft on behalf of a minter, which must be allowed to
+// mint by the policy of the class
+func (k Keeper) MintBy(ctx context.Context, token nft.NFT, minter, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, token.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+	}
+
+	minterStr, err := k.ac.BytesToString(minter)
+	if err != nil {
+		return err
+	}
+
+	if !k.GetClassPolicy(ctx, token.ClassId).IsMinter(minterStr) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nfts of class %s", minterStr, token.ClassId)
+	}
+
+	return k.MintUnsafe(ctx, token, receiver)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/nft"
 
@@ -195,3 +196,50 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassPolicy return the minting, transfer and royalty policy of an NFT class
+func (k Keeper) ClassPolicy(goCtx context.Context, r *nft.QueryClassPolicyRequest) (*nft.QueryClassPolicyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	policy := k.GetClassPolicy(ctx, r.ClassId)
+	return &nft.QueryClassPolicyResponse{Policy: &policy}, nil
+}
+
+// Royalty return the royalty recipient and amount due for the sale of an NFT of a class, same as royaltyInfo in ERC2981
+func (k Keeper) Royalty(goCtx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	salePrice, ok := math.NewIntFromString(r.SalePrice)
+	if !ok || salePrice.IsNegative() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", r.SalePrice)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	policy := k.GetClassPolicy(ctx, r.ClassId)
+	amount, err := policy.RoyaltyAmount(salePrice)
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryRoyaltyResponse{Recipient: policy.RoyaltyRecipient, Amount: amount.String()}, nil
+}
@@ -592,3 +592,89 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestClassPolicyQuery() {
+	_, err := s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{})
+	s.Require().ErrorContains(err, nft.ErrEmptyClassID.Error())
+
+	_, err = s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{ClassId: testClassID})
+	s.Require().ErrorContains(err, "not found class")
+
+	s.TestSaveClass()
+
+	res, err := s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(nft.DefaultClassPolicy(testClassID), *res.Policy)
+
+	policy := nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OWNER,
+	}
+	s.Require().NoError(s.nftKeeper.SetClassPolicy(s.ctx, policy))
+
+	res, err = s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(policy, *res.Policy)
+}
+
+func (s *TestSuite) TestRoyalty() {
+	s.TestSaveClass()
+
+	testCases := []struct {
+		msg          string
+		req          *nft.QueryRoyaltyRequest
+		expError     string
+		expRecipient string
+		expAmount    string
+	}{
+		{
+			"fail empty ClassId",
+			&nft.QueryRoyaltyRequest{SalePrice: "100"},
+			nft.ErrEmptyClassID.Error(),
+			"",
+			"",
+		},
+		{
+			"fail invalid sale price",
+			&nft.QueryRoyaltyRequest{ClassId: testClassID, SalePrice: "-1"},
+			"invalid sale price",
+			"",
+			"",
+		},
+		{
+			"fail ClassId not exist",
+			&nft.QueryRoyaltyRequest{ClassId: "kitty1", SalePrice: "100"},
+			"not found class",
+			"",
+			"",
+		},
+		{
+			"success, rounded down",
+			&nft.QueryRoyaltyRequest{ClassId: testClassID, SalePrice: "1010"},
+			"",
+			s.addrs[1].String(),
+			"50",
+		},
+	}
+
+	err := s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{
+		ClassId:          testClassID,
+		RoyaltyRecipient: s.addrs[1].String(),
+		RoyaltyRate:      "0.05",
+	})
+	s.Require().NoError(err)
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := s.queryClient.Royalty(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				s.Require().ErrorContains(err, tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRecipient, res.Recipient)
+			s.Require().Equal(tc.expAmount, res.Amount)
+		})
+	}
+}
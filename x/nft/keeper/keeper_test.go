@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -201,6 +202,27 @@ func (s *TestSuite) TestMint() {
 	// test GetBalance
 	balance = s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[0])
 	s.Require().EqualValues(uint64(2), balance)
+
+	// a class with a restricted mint policy can only be minted with MintBy or MintUnsafe
+	err = s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OWNER,
+	})
+	s.Require().NoError(err)
+
+	expNFT3 := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID + "3",
+		Uri:     testURI + "3",
+	}
+	err = s.nftKeeper.Mint(s.ctx, expNFT3, s.addrs[0])
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, expNFT3.Id))
+
+	err = s.nftKeeper.MintUnsafe(s.ctx, expNFT3, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().True(s.addrs[1].Equals(s.nftKeeper.GetOwner(s.ctx, testClassID, expNFT3.Id)))
 }
 
 func (s *TestSuite) TestBurn() {
@@ -323,6 +345,95 @@ func (s *TestSuite) TestTransfer() {
 	// test GetNFTsOfClassByOwner
 	actNFTs := s.nftKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[1])
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
+
+	// the nfts of a non-transferable class can only be sent with TransferUnsafe
+	err = s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{ClassId: testClassID, NonTransferable: true})
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	err = s.nftKeeper.TransferUnsafe(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestClassPolicy() {
+	policy := nft.ClassPolicy{
+		ClassId:          testClassID,
+		Owner:            s.addrs[0].String(),
+		MintPolicy:       nft.MintPolicy_MINT_POLICY_ALLOW_LIST,
+		Minters:          []string{s.addrs[1].String()},
+		NonTransferable:  true,
+		RoyaltyRecipient: s.addrs[0].String(),
+		RoyaltyRate:      "0.05",
+	}
+	err := s.nftKeeper.SetClassPolicy(s.ctx, policy)
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.TestSaveClass()
+
+	// a class without policy has the default one
+	s.Require().Equal(nft.DefaultClassPolicy(testClassID), s.nftKeeper.GetClassPolicy(s.ctx, testClassID))
+
+	invalidPolicy := policy
+	invalidPolicy.RoyaltyRate = "1.5"
+	err = s.nftKeeper.SetClassPolicy(s.ctx, invalidPolicy)
+	s.Require().ErrorIs(err, nft.ErrInvalidClassPolicy)
+
+	err = s.nftKeeper.SetClassPolicy(s.ctx, policy)
+	s.Require().NoError(err)
+	s.Require().Equal(policy, s.nftKeeper.GetClassPolicy(s.ctx, testClassID))
+	s.Require().Equal([]*nft.ClassPolicy{&policy}, s.nftKeeper.GetClassPolicies(s.ctx))
+}
+
+func (s *TestSuite) TestMintBy() {
+	s.TestSaveClass()
+
+	token := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+
+	// anyone can mint with the default open policy
+	err := s.nftKeeper.MintBy(s.ctx, token, s.addrs[2], s.addrs[2])
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOW_LIST,
+		Minters:    []string{s.addrs[1].String()},
+	})
+	s.Require().NoError(err)
+
+	token.Id = testID + "2"
+	err = s.nftKeeper.MintBy(s.ctx, token, s.addrs[2], s.addrs[2])
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = s.nftKeeper.MintBy(s.ctx, token, s.addrs[1], s.addrs[2])
+	s.Require().NoError(err)
+
+	token.Id = testID + "3"
+	err = s.nftKeeper.MintBy(s.ctx, token, s.addrs[0], s.addrs[2])
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OWNER,
+	})
+	s.Require().NoError(err)
+
+	token.Id = testID + "4"
+	err = s.nftKeeper.MintBy(s.ctx, token, s.addrs[1], s.addrs[2])
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = s.nftKeeper.MintBy(s.ctx, token, s.addrs[0], s.addrs[2])
+	s.Require().NoError(err)
+	s.Require().EqualValues(uint64(4), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
 }
 
 func (s *TestSuite) TestExportGenesis() {
@@ -345,12 +456,22 @@ func (s *TestSuite) TestExportGenesis() {
 	err = s.nftKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	policy := nft.ClassPolicy{
+		ClassId:         testClassID,
+		Owner:           s.addrs[0].String(),
+		MintPolicy:      nft.MintPolicy_MINT_POLICY_OWNER,
+		NonTransferable: true,
+	}
+	err = s.nftKeeper.SetClassPolicy(s.ctx, policy)
+	s.Require().NoError(err)
+
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassPolicies: []*nft.ClassPolicy{&policy},
 	}
 	genesis := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -370,12 +491,21 @@ func (s *TestSuite) TestInitGenesis() {
 		Id:      testID,
 		Uri:     testURI,
 	}
+	expPolicy := nft.ClassPolicy{
+		ClassId:          testClassID,
+		Owner:            s.addrs[1].String(),
+		MintPolicy:       nft.MintPolicy_MINT_POLICY_OWNER,
+		NonTransferable:  true,
+		RoyaltyRecipient: s.addrs[1].String(),
+		RoyaltyRate:      "0.1",
+	}
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&expClass},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassPolicies: []*nft.ClassPolicy{&expPolicy},
 	}
 	s.nftKeeper.InitGenesis(s.ctx, expGenesis)
 
@@ -383,8 +513,11 @@ func (s *TestSuite) TestInitGenesis() {
 	s.Require().True(has)
 	s.Require().EqualValues(expClass, actual)
 
-	// test GetNFT
+	// test GetNFT, the entries are imported regardless of the class policy
 	actNFT, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
+	s.Require().True(s.addrs[0].Equals(s.nftKeeper.GetOwner(s.ctx, testClassID, testID)))
+	// test GetClassPolicy
+	s.Require().Equal(expPolicy, s.nftKeeper.GetClassPolicy(s.ctx, testClassID))
 }
@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassPolicyKey       = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -31,6 +32,14 @@ func classStoreKey(classID string) []byte {
 	return key
 }
 
+// classPolicyStoreKey returns the byte representation of the nft class policy key
+func classPolicyStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassPolicyKey)+len(classID))
+	copy(key, ClassPolicyKey)
+	copy(key[len(ClassPolicyKey):], classID)
+	return key
+}
+
 // nftStoreKey returns the byte representation of the nft
 func nftStoreKey(classID string) []byte {
 	key := make([]byte, len(NFTKey)+len(classID)+len(Delimiter))
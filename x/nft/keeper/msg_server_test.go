@@ -130,3 +130,23 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSendNonTransferable() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{ClassId: testClassID, NonTransferable: true})
+	s.Require().NoError(err)
+
+	_, err = s.nftKeeper.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[0].String(),
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+	s.Require().True(s.addrs[0].Equals(s.nftKeeper.GetOwner(s.ctx, testClassID, testID)))
+}
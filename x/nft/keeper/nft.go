@@ -9,14 +9,32 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Mint defines a method for minting a new nft
+// Note: only the nfts of a class with an open mint policy can be minted with this method, MintBy
+// must be used for the other classes
 func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
 	if !k.HasClass(ctx, token.ClassId) {
 		return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
 	}
 
+	if k.GetClassPolicy(ctx, token.ClassId).MintPolicy != nft.MintPolicy_MINT_POLICY_OPEN {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "minting nfts of class %s is restricted by its mint policy", token.ClassId)
+	}
+
+	return k.MintUnsafe(ctx, token, receiver)
+}
+
+// MintUnsafe defines a method for minting a new nft without checking the mint policy of its class
+// Note: it is meant for trusted callers only, such as the genesis import, the upper module needs
+// to authorize the mint
+func (k Keeper) MintUnsafe(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, token.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+	}
+
 	if k.HasNFT(ctx, token.ClassId, token.Id) {
 		return errors.Wrap(nft.ErrNFTExists, token.Id)
 	}
@@ -95,6 +113,7 @@ func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) {
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
+// The nfts of a non-transferable class cannot be sent.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx context.Context,
 	classID string,
@@ -105,6 +124,25 @@ func (k Keeper) Transfer(ctx context.Context,
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
 
+	if k.GetClassPolicy(ctx, classID).NonTransferable {
+		return errors.Wrapf(nft.ErrNonTransferable, "nft %s of class %s", nftID, classID)
+	}
+
+	return k.TransferUnsafe(ctx, classID, nftID, receiver)
+}
+
+// TransferUnsafe defines a method for sending a nft from one account to another account without
+// checking whether its class is transferable.
+// Note: it is meant for trusted callers only, the upper module needs to authenticate nft
+func (k Keeper) TransferUnsafe(ctx context.Context,
+	classID string,
+	nftID string,
+	receiver sdk.AccAddress,
+) error {
+	if !k.HasClass(ctx, classID) {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
 	if !k.HasNFT(ctx, classID, nftID) {
 		return errors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
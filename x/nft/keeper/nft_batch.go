@@ -7,17 +7,24 @@ import (
 	"cosmossdk.io/x/nft"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // BatchMint defines a method for minting a batch of nfts
+// Note: only the nfts of classes with an open mint policy can be minted with this method
 func (k Keeper) BatchMint(ctx context.Context,
 	tokens []nft.NFT,
 	receiver sdk.AccAddress,
 ) error {
 	checked := make(map[string]bool, len(tokens))
 	for _, token := range tokens {
-		if !checked[token.ClassId] && !k.HasClass(ctx, token.ClassId) {
-			return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+		if !checked[token.ClassId] {
+			if !k.HasClass(ctx, token.ClassId) {
+				return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+			}
+			if k.GetClassPolicy(ctx, token.ClassId).MintPolicy != nft.MintPolicy_MINT_POLICY_OPEN {
+				return errors.Wrapf(sdkerrors.ErrUnauthorized, "minting nfts of class %s is restricted by its mint policy", token.ClassId)
+			}
 		}
 
 		if k.HasNFT(ctx, token.ClassId, token.Id) {
@@ -66,6 +73,7 @@ func (k Keeper) BatchUpdate(ctx context.Context, tokens []nft.NFT) error {
 }
 
 // BatchTransfer defines a method for sending a batch of nfts from one account to another account from a specific classID.
+// The nfts of a non-transferable class cannot be sent.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) BatchTransfer(ctx context.Context,
 	classID string,
@@ -75,6 +83,9 @@ func (k Keeper) BatchTransfer(ctx context.Context,
 	if !k.HasClass(ctx, classID) {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
+	if k.GetClassPolicy(ctx, classID).NonTransferable {
+		return errors.Wrapf(nft.ErrNonTransferable, "class %s", classID)
+	}
 	for _, nftID := range nftIDs {
 		if !k.HasNFT(ctx, classID, nftID) {
 			return errors.Wrap(nft.ErrNFTNotExists, nftID)
@@ -95,6 +95,23 @@ func (s *TestSuite) TestBatchMint() {
 			},
 			false,
 		},
+		{
+			"faild with restricted mint policy",
+			func(tokens []nft.NFT) {
+				s.saveClass(tokens)
+				err := s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{
+					ClassId:    "classID2",
+					Owner:      receiver.String(),
+					MintPolicy: nft.MintPolicy_MINT_POLICY_OWNER,
+				})
+				s.Require().NoError(err)
+			},
+			[]nft.NFT{
+				{ClassId: "classID1", Id: "nftID1"},
+				{ClassId: "classID2", Id: "nftID1"},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -311,6 +328,18 @@ func (s *TestSuite) TestBatchTransfer() {
 			[]string{"nftID1", "nftID2"},
 			false,
 		},
+		{
+			"failed with non-transferable class",
+			func() {
+				s.saveClass(tokens)
+				s.nftKeeper.BatchMint(s.ctx, tokens, owner)
+				err := s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{ClassId: "classID1", NonTransferable: true})
+				s.Require().NoError(err)
+			},
+			"classID1",
+			[]string{"nftID1", "nftID2"},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
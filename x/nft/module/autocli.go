@@ -78,6 +78,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "ClassPolicy",
+					Use:       "class-policy [class-id]",
+					Short:     "Query the minting, transfer and royalty policy of an NFT class.",
+					Example:   fmt.Sprintf(`%s query %s class-policy <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "Royalty",
+					Use:       "royalty [class-id] [sale-price]",
+					Short:     "Query the royalty recipient and amount due for the sale of an NFT of a class.",
+					Example:   fmt.Sprintf(`%s query %s royalty <class-id> <sale-price>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "sale_price"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
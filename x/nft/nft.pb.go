@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +24,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintPolicy defines which accounts may mint the NFTs of a class.
+type MintPolicy int32
+
+const (
+	// MINT_POLICY_OPEN allows any account to mint NFTs of the class.
+	MintPolicy_MINT_POLICY_OPEN MintPolicy = 0
+	// MINT_POLICY_OWNER only allows the owner of the class to mint NFTs of the class.
+	MintPolicy_MINT_POLICY_OWNER MintPolicy = 1
+	// MINT_POLICY_ALLOW_LIST allows the owner and the minters of the class to mint NFTs of the class.
+	MintPolicy_MINT_POLICY_ALLOW_LIST MintPolicy = 2
+)
+
+var MintPolicy_name = map[int32]string{
+	0: "MINT_POLICY_OPEN",
+	1: "MINT_POLICY_OWNER",
+	2: "MINT_POLICY_ALLOW_LIST",
+}
+
+var MintPolicy_value = map[string]int32{
+	"MINT_POLICY_OPEN":       0,
+	"MINT_POLICY_OWNER":      1,
+	"MINT_POLICY_ALLOW_LIST": 2,
+}
+
+func (x MintPolicy) String() string {
+	return proto.EnumName(MintPolicy_name, int32(x))
+}
+
+func (MintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -205,35 +238,151 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
+// ClassPolicy defines the minting, transfer and royalty configuration of an NFT class.
+type ClassPolicy struct {
+	// class_id associated with the policy
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// owner is the owner address of the class. Required by the owner mint policy
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// mint_policy defines which accounts may mint NFTs of the class
+	MintPolicy MintPolicy `protobuf:"varint,3,opt,name=mint_policy,json=mintPolicy,proto3,enum=cosmos.nft.v1beta1.MintPolicy" json:"mint_policy,omitempty"`
+	// minters are the addresses allowed to mint NFTs of the class with the allow-list mint policy
+	Minters []string `protobuf:"bytes,4,rep,name=minters,proto3" json:"minters,omitempty"`
+	// non_transferable defines whether the NFTs of the class are bound to their owner and can not be sent
+	NonTransferable bool `protobuf:"varint,5,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
+	// royalty_recipient is the address receiving the royalties of the sales of NFTs of the class. Optional
+	RoyaltyRecipient string `protobuf:"bytes,6,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	// royalty_rate is the share of the sale price of an NFT of the class due to the royalty recipient. Optional
+	RoyaltyRate string `protobuf:"bytes,7,opt,name=royalty_rate,json=royaltyRate,proto3" json:"royalty_rate,omitempty"`
+}
+
+func (m *ClassPolicy) Reset()         { *m = ClassPolicy{} }
+func (m *ClassPolicy) String() string { return proto.CompactTextString(m) }
+func (*ClassPolicy) ProtoMessage()    {}
+func (*ClassPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassPolicy.Merge(m, src)
+}
+func (m *ClassPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassPolicy proto.InternalMessageInfo
+
+func (m *ClassPolicy) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetMintPolicy() MintPolicy {
+	if m != nil {
+		return m.MintPolicy
+	}
+	return MintPolicy_MINT_POLICY_OPEN
+}
+
+func (m *ClassPolicy) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *ClassPolicy) GetNonTransferable() bool {
+	if m != nil {
+		return m.NonTransferable
+	}
+	return false
+}
+
+func (m *ClassPolicy) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetRoyaltyRate() string {
+	if m != nil {
+		return m.RoyaltyRate
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MintPolicy", MintPolicy_name, MintPolicy_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassPolicy)(nil), "cosmos.nft.v1beta1.ClassPolicy")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6e, 0xda, 0x4c,
+	0x10, 0xc6, 0x86, 0x84, 0x64, 0xf8, 0x95, 0xdf, 0x59, 0xa5, 0x91, 0x13, 0x55, 0x16, 0xca, 0x29,
+	0xad, 0x1a, 0x5b, 0xd0, 0x07, 0xa8, 0x20, 0xa5, 0x2a, 0x12, 0x01, 0xe4, 0x50, 0x45, 0xed, 0xc5,
+	0x5a, 0xec, 0x05, 0x56, 0x35, 0xbb, 0x68, 0x77, 0x69, 0xeb, 0x27, 0xe8, 0xb5, 0x0f, 0x93, 0x63,
+	0x1f, 0xa0, 0xc7, 0x28, 0xa7, 0x1e, 0x2b, 0x78, 0x83, 0x3e, 0x41, 0xe5, 0xb5, 0xa1, 0x54, 0x8d,
+	0xe8, 0x6d, 0xe6, 0x9b, 0x6f, 0x66, 0xbe, 0xcf, 0xe3, 0x85, 0xc7, 0x21, 0x97, 0x53, 0x2e, 0x3d,
+	0x36, 0x52, 0xde, 0x87, 0xda, 0x90, 0x28, 0x5c, 0x4b, 0x63, 0x77, 0x26, 0xb8, 0xe2, 0x08, 0x65,
+	0x55, 0x37, 0x45, 0xf2, 0xea, 0xe9, 0xc9, 0x98, 0xf3, 0x71, 0x4c, 0x3c, 0xcd, 0x18, 0xce, 0x47,
+	0x1e, 0x66, 0x49, 0x46, 0x3f, 0x3d, 0xc9, 0xe8, 0x81, 0xce, 0xbc, 0xbc, 0x57, 0x27, 0x67, 0x5f,
+	0x0d, 0xd8, 0xb9, 0x8c, 0xb1, 0x94, 0xe8, 0x00, 0x4c, 0x1a, 0xd9, 0x46, 0xd5, 0x38, 0xdf, 0xf7,
+	0x4d, 0x1a, 0x21, 0x04, 0x25, 0x86, 0xa7, 0xc4, 0x36, 0x35, 0xa2, 0x63, 0x74, 0x0c, 0xbb, 0x32,
+	0x99, 0x0e, 0x79, 0x6c, 0x17, 0x35, 0x9a, 0x67, 0xa8, 0x0a, 0x95, 0x88, 0xc8, 0x50, 0xd0, 0x99,
+	0xa2, 0x9c, 0xd9, 0x25, 0x5d, 0xdc, 0x84, 0x90, 0x05, 0xc5, 0xb9, 0xa0, 0xf6, 0x8e, 0xae, 0xa4,
+	0x21, 0x3a, 0x81, 0xbd, 0xb9, 0xa0, 0xc1, 0x04, 0xcb, 0x89, 0xbd, 0xab, 0xe1, 0xf2, 0x5c, 0xd0,
+	0xd7, 0x58, 0x4e, 0xd0, 0x39, 0x94, 0x22, 0xac, 0xb0, 0x5d, 0xae, 0x1a, 0xe7, 0x95, 0xfa, 0x91,
+	0x9b, 0x39, 0x73, 0x57, 0xce, 0xdc, 0x06, 0x4b, 0x7c, 0xcd, 0x38, 0xfb, 0x6c, 0x40, 0xb1, 0xfb,
+	0x6a, 0x90, 0x0e, 0x0b, 0x53, 0x17, 0xc1, 0xda, 0x42, 0x59, 0xe7, 0xed, 0x28, 0xf7, 0x65, 0xae,
+	0x7d, 0xe5, 0x4a, 0x8a, 0x0f, 0x2b, 0x29, 0x3d, 0xac, 0x04, 0xfe, 0xa9, 0xe4, 0xa7, 0x09, 0x15,
+	0xfd, 0x21, 0xfb, 0x3c, 0xa6, 0x61, 0xb2, 0x4d, 0x91, 0x0b, 0x3b, 0xfc, 0x23, 0x23, 0x22, 0x13,
+	0xd5, 0xb4, 0xef, 0x6f, 0x2f, 0x8e, 0xf2, 0xa3, 0x34, 0xa2, 0x48, 0x10, 0x29, 0xaf, 0x95, 0xa0,
+	0x6c, 0xec, 0x67, 0x34, 0xf4, 0x02, 0x2a, 0x53, 0xca, 0x54, 0x30, 0xd3, 0x93, 0xb5, 0xf2, 0x83,
+	0xba, 0xe3, 0xfe, 0xfd, 0x0f, 0xb8, 0x57, 0x94, 0xa9, 0x6c, 0xbf, 0x0f, 0xd3, 0x75, 0x8c, 0xea,
+	0x50, 0x4e, 0x33, 0x22, 0xa4, 0x5d, 0xaa, 0x16, 0xb7, 0xae, 0x5c, 0x11, 0xd1, 0x13, 0xb0, 0x18,
+	0x67, 0x81, 0x12, 0x98, 0xc9, 0x11, 0x11, 0x78, 0x18, 0x13, 0x7d, 0xbd, 0x3d, 0xff, 0x7f, 0xc6,
+	0xd9, 0x60, 0x03, 0x46, 0x2d, 0x38, 0x14, 0x3c, 0xc1, 0xb1, 0x4a, 0x02, 0x41, 0x42, 0x3a, 0xa3,
+	0x84, 0xa9, 0xec, 0xa4, 0x5b, 0x16, 0x59, 0x79, 0x8b, 0xbf, 0xea, 0x40, 0x35, 0xf8, 0x6f, 0x3d,
+	0x06, 0x2b, 0xa2, 0xaf, 0xbf, 0xdf, 0x3c, 0xb8, 0xbf, 0xbd, 0x80, 0x7c, 0xc2, 0x4b, 0x12, 0xfa,
+	0x95, 0x55, 0x1f, 0x56, 0xe4, 0xe9, 0x1b, 0x80, 0xdf, 0x96, 0xd1, 0x11, 0x58, 0x57, 0xed, 0xee,
+	0x20, 0xe8, 0xf7, 0x3a, 0xed, 0xcb, 0xb7, 0x41, 0xaf, 0xdf, 0xea, 0x5a, 0x05, 0xf4, 0x08, 0x0e,
+	0xff, 0x40, 0x6f, 0xba, 0x2d, 0xdf, 0x32, 0xd0, 0x29, 0x1c, 0x6f, 0xc2, 0x8d, 0x4e, 0xa7, 0x77,
+	0x13, 0x74, 0xda, 0xd7, 0x03, 0xcb, 0x6c, 0x3e, 0xfb, 0xb6, 0x70, 0x8c, 0xbb, 0x85, 0x63, 0xfc,
+	0x58, 0x38, 0xc6, 0x97, 0xa5, 0x53, 0xb8, 0x5b, 0x3a, 0x85, 0xef, 0x4b, 0xa7, 0xf0, 0x2e, 0x7f,
+	0x78, 0x32, 0x7a, 0xef, 0x52, 0xee, 0x7d, 0x4a, 0x9f, 0xe4, 0x70, 0x57, 0xff, 0x0d, 0xcf, 0x7f,
+	0x0d, 0x00, 0xd1, 0x4f, 0xed, 0x8b, 0xb3, 0x03, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +525,81 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoyaltyRate) > 0 {
+		i -= len(m.RoyaltyRate)
+		copy(dAtA[i:], m.RoyaltyRate)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.RoyaltyRate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NonTransferable {
+		i--
+		if m.NonTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintPolicy != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +677,43 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *ClassPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintPolicy != 0 {
+		n += 1 + sovNft(uint64(m.MintPolicy))
+	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.NonTransferable {
+		n += 2
+	}
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.RoyaltyRate)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1212,255 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPolicy", wireType)
+			}
+			m.MintPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintPolicy |= MintPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonTransferable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryDowntimeHistory(),
+		GetCmdQueryDowntimeHistories(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +110,84 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryDowntimeHistory implements the command to query the downtime
+// infractions history of a validator.
+func GetCmdQueryDowntimeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "downtime-history [validator-conspub]",
+		Short: "Query a validator's downtime infractions history and the penalty of its next downtime infraction",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the downtime infractions history of that validator:
+
+$ <appd> query slashing downtime-history '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryDowntimeHistoryRequest{ConsAddress: consAddr.String()}
+			res, err := queryClient.DowntimeHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDowntimeHistories implements the command to query the downtime
+// infractions history of all validators.
+func GetCmdQueryDowntimeHistories() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "downtime-histories",
+		Short: "Query the downtime infractions history of all validators",
+		Long: strings.TrimSpace(`downtime infractions histories of validators:
+
+$ <appd> query slashing downtime-histories
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDowntimeHistoriesRequest{Pagination: pageReq}
+			res, err := queryClient.DowntimeHistories(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "downtime histories")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -146,3 +146,41 @@ func (s *CLITestSuite) TestGetCmdQueryParams() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestGetCmdQueryDowntimeHistory() {
+	pubKeyBz, err := s.encCfg.Codec.MarshalInterfaceJSON(s.pub)
+	s.Require().NoError(err)
+	pubKeyStr := string(pubKeyBz)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"invalid address", []string{"foo"}, true},
+		{
+			"valid address (json output)",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryDowntimeHistory()
+			clientCtx := s.clientCtx
+
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
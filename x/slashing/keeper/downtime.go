@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetDowntimeHistory returns the downtime infractions history of a validator,
+// without the infractions which decayed at the current block time. An empty
+// history is returned if the validator has no downtime infractions.
+func (k Keeper) GetDowntimeHistory(ctx context.Context, consAddr sdk.ConsAddress) (types.DowntimeHistory, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.DowntimeHistoryKey(consAddr))
+	if err != nil {
+		return types.DowntimeHistory{}, err
+	}
+
+	history := types.NewDowntimeHistory(consAddr, nil)
+	if bz != nil {
+		if err := k.cdc.Unmarshal(bz, &history); err != nil {
+			return types.DowntimeHistory{}, err
+		}
+	}
+
+	return k.decayDowntimeHistory(ctx, history)
+}
+
+// SetDowntimeHistory sets the downtime infractions history of a validator. The
+// history is deleted if it has no infractions.
+func (k Keeper) SetDowntimeHistory(ctx context.Context, consAddr sdk.ConsAddress, history types.DowntimeHistory) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if len(history.Infractions) == 0 {
+		return store.Delete(types.DowntimeHistoryKey(consAddr))
+	}
+
+	bz, err := k.cdc.Marshal(&history)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.DowntimeHistoryKey(consAddr), bz)
+}
+
+// IterateDowntimeHistories iterates over the stored downtime infractions
+// histories, without the infractions which decayed at the current block time.
+func (k Keeper) IterateDowntimeHistories(ctx context.Context,
+	handler func(address sdk.ConsAddress, history types.DowntimeHistory) (stop bool),
+) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.DowntimeHistoryKeyPrefix, storetypes.PrefixEndBytes(types.DowntimeHistoryKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.DowntimeHistoryAddress(iter.Key())
+		var history types.DowntimeHistory
+		if err := k.cdc.Unmarshal(iter.Value(), &history); err != nil {
+			return err
+		}
+
+		history, err = k.decayDowntimeHistory(ctx, history)
+		if err != nil {
+			return err
+		}
+
+		if handler(address, history) {
+			break
+		}
+	}
+	return nil
+}
+
+// NextDowntimePenalty returns the penalty of the next downtime infraction of a
+// validator, given the downtime infractions in its history.
+func (k Keeper) NextDowntimePenalty(ctx context.Context, consAddr sdk.ConsAddress) (types.DowntimePenalty, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.DowntimePenalty{}, err
+	}
+
+	history, err := k.GetDowntimeHistory(ctx, consAddr)
+	if err != nil {
+		return types.DowntimePenalty{}, err
+	}
+
+	return params.DowntimePenaltyFor(len(history.Infractions)), nil
+}
+
+// addDowntimeInfraction adds a downtime infraction to the history of a
+// validator, and removes the infractions which decayed.
+func (k Keeper) addDowntimeInfraction(ctx context.Context, consAddr sdk.ConsAddress, infraction types.DowntimeInfraction) error {
+	history, err := k.GetDowntimeHistory(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	history.Infractions = append(history.Infractions, infraction)
+	return k.SetDowntimeHistory(ctx, consAddr, history)
+}
+
+func (k Keeper) decayDowntimeHistory(ctx context.Context, history types.DowntimeHistory) (types.DowntimeHistory, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.DowntimeHistory{}, err
+	}
+
+	return history.Decay(sdk.UnwrapSDKContext(ctx).BlockTime(), params.DowntimeHistoryDecay), nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/comet"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestDowntimePenalties() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	penalties := []slashingtypes.DowntimePenalty{
+		slashingtypes.NewDowntimePenalty(sdkmath.LegacyNewDecWithPrec(1, 2), 10*time.Minute),
+		slashingtypes.NewDowntimePenalty(sdkmath.LegacyNewDecWithPrec(5, 2), time.Hour),
+		slashingtypes.NewDowntimePenalty(sdkmath.LegacyNewDecWithPrec(1, 1), 24*time.Hour),
+	}
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimePenalties = penalties
+	params.DowntimeHistoryDecay = 7 * 24 * time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	power := int64(100)
+	height := int64(20)
+	// the validator missed the maximum number of blocks, and is punished at
+	// the next missed block
+	maxMissed := params.SignedBlocksWindow - params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
+
+	// the last penalty applies to the further infractions
+	expPenalties := append(penalties, penalties[2])
+	for i, expPenalty := range expPenalties {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, maxMissed)))
+
+		penalty, err := keeper.NextDowntimePenalty(ctx, consAddr)
+		require.NoError(err)
+		require.Equal(expPenalty, penalty, "infraction %d", i)
+
+		s.stakingKeeper.EXPECT().IsValidatorJailed(ctx, consAddr).Return(false)
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(stakingtypes.Validator{})
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, height-sdk.ValidatorUpdateDelay-1, power, expPenalty.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME).Return(sdkmath.ZeroInt())
+		s.stakingKeeper.EXPECT().Jail(ctx, consAddr)
+		require.NoError(keeper.HandleValidatorSignature(ctx, consAddr.Bytes(), power, comet.BlockIDFlagAbsent))
+
+		info, err := keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.NoError(err)
+		require.Equal(ctx.BlockTime().Add(expPenalty.JailDuration), info.JailedUntil)
+
+		history, err := keeper.GetDowntimeHistory(ctx, consAddr)
+		require.NoError(err)
+		require.Len(history.Infractions, i+1)
+		require.Equal(slashingtypes.NewDowntimeInfraction(height, ctx.BlockTime(), expPenalty), history.Infractions[i])
+	}
+
+	// the first infractions decay after a week
+	firstInfraction := ctx.BlockTime().Add(-3 * time.Hour)
+	ctx = ctx.WithBlockTime(firstInfraction.Add(params.DowntimeHistoryDecay).Add(time.Hour))
+	history, err := keeper.GetDowntimeHistory(ctx, consAddr)
+	require.NoError(err)
+	require.Len(history.Infractions, 2)
+
+	penalty, err := keeper.NextDowntimePenalty(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(penalties[2], penalty)
+
+	// all the infractions decay
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.DowntimeHistoryDecay))
+	history, err = keeper.GetDowntimeHistory(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(slashingtypes.NewDowntimeHistory(consAddr, []slashingtypes.DowntimeInfraction{}), history)
+
+	penalty, err = keeper.NextDowntimePenalty(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(penalties[0], penalty)
+}
+
+func (s *KeeperTestSuite) TestDowntimePenaltiesDefault() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// the flat downtime penalty applies without a penalty schedule, whatever
+	// the history of the validator
+	history := slashingtypes.NewDowntimeHistory(consAddr, []slashingtypes.DowntimeInfraction{
+		slashingtypes.NewDowntimeInfraction(10, ctx.BlockTime(), slashingtypes.NewDowntimePenalty(params.SlashFractionDowntime, params.DowntimeJailDuration)),
+	})
+	require.NoError(keeper.SetDowntimeHistory(ctx, consAddr, history))
+
+	penalty, err := keeper.NextDowntimePenalty(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(slashingtypes.NewDowntimePenalty(params.SlashFractionDowntime, params.DowntimeJailDuration), penalty)
+
+	// an empty history is deleted
+	require.NoError(keeper.SetDowntimeHistory(ctx, consAddr, slashingtypes.NewDowntimeHistory(consAddr, nil)))
+	var histories []slashingtypes.DowntimeHistory
+	require.NoError(keeper.IterateDowntimeHistories(ctx, func(_ sdk.ConsAddress, history slashingtypes.DowntimeHistory) bool {
+		histories = append(histories, history)
+		return false
+	}))
+	require.Empty(histories)
+}
+
+func (s *KeeperTestSuite) TestDowntimePenaltiesAlreadyJailed() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 10
+	require.NoError(keeper.SetParams(ctx, params))
+
+	maxMissed := params.SignedBlocksWindow - params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, maxMissed)))
+
+	// a validator which is not punished has no downtime infraction
+	s.stakingKeeper.EXPECT().IsValidatorJailed(ctx, consAddr).Return(false)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(stakingtypes.Validator{Jailed: true})
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	require.NoError(keeper.HandleValidatorSignature(ctx, consAddr.Bytes(), 100, comet.BlockIDFlagAbsent))
+
+	history, err := keeper.GetDowntimeHistory(ctx, consAddr)
+	require.NoError(err)
+	require.Empty(history.Infractions)
+}
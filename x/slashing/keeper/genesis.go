@@ -42,6 +42,16 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, history := range data.DowntimeHistories {
+		address, err := sdk.ConsAddressFromBech32(history.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := keeper.SetDowntimeHistory(ctx, address, history); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -77,5 +87,19 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	downtimeHistories := make([]types.DowntimeHistory, 0)
+	err = keeper.IterateDowntimeHistories(ctx, func(_ sdk.ConsAddress, history types.DowntimeHistory) (stop bool) {
+		if len(history.Infractions) > 0 {
+			downtimeHistories = append(downtimeHistories, history)
+		}
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.DowntimeHistories = downtimeHistories
+
+	return genesis
 }
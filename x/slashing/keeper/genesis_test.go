@@ -26,11 +26,21 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 
 	keeper.SetValidatorSigningInfo(ctx, consAddr1, info1)
 	keeper.SetValidatorSigningInfo(ctx, consAddr2, info2)
+
+	params := testutil.TestParams()
+	history := types.NewDowntimeHistory(consAddr1, []types.DowntimeInfraction{
+		types.NewDowntimeInfraction(10, ctx.BlockTime(), types.NewDowntimePenalty(params.SlashFractionDowntime, params.DowntimeJailDuration)),
+	})
+	require.NoError(keeper.SetDowntimeHistory(ctx, consAddr1, history))
 	genesisState := keeper.ExportGenesis(ctx)
 
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
 	require.Equal(genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Len(genesisState.DowntimeHistories, 1)
+
+	// the downtime history is cleared before genesis is initialized
+	require.NoError(keeper.SetDowntimeHistory(ctx, consAddr1, types.NewDowntimeHistory(consAddr1, nil)))
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	err := keeper.Tombstone(ctx, consAddr1)
@@ -56,4 +66,8 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.GetValidatorSigningInfo(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+
+	newHistory, err := keeper.GetDowntimeHistory(ctx, consAddr1)
+	require.NoError(err)
+	require.Equal(genesisState.DowntimeHistories[0], newHistory)
 }
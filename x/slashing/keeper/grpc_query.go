@@ -82,3 +82,63 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// DowntimeHistory returns the downtime infractions history of a specific
+// validator, and the penalty of its next downtime infraction.
+func (k Keeper) DowntimeHistory(ctx context.Context, req *types.QueryDowntimeHistoryRequest) (*types.QueryDowntimeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := k.GetDowntimeHistory(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	penalty, err := k.NextDowntimePenalty(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDowntimeHistoryResponse{History: history, NextPenalty: penalty}, nil
+}
+
+// DowntimeHistories returns the downtime infractions history of all
+// validators.
+func (k Keeper) DowntimeHistories(ctx context.Context, req *types.QueryDowntimeHistoriesRequest) (*types.QueryDowntimeHistoriesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	var histories []types.DowntimeHistory
+
+	historyStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.DowntimeHistoryKeyPrefix)
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key, value []byte) error {
+		var history types.DowntimeHistory
+		err := k.cdc.Unmarshal(value, &history)
+		if err != nil {
+			return err
+		}
+
+		history, err = k.decayDowntimeHistory(ctx, history)
+		if err != nil {
+			return err
+		}
+		histories = append(histories, history)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDowntimeHistoriesResponse{Histories: histories, Pagination: pageRes}, nil
+}
@@ -89,3 +89,73 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCDowntimeHistory() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	historyResp, err := queryClient.DowntimeHistory(gocontext.Background(), &slashingtypes.QueryDowntimeHistoryRequest{ConsAddress: ""})
+	require.ErrorContains(err, "invalid request")
+	require.Nil(historyResp)
+
+	params := testutil.TestParams()
+
+	// a validator without infractions has an empty history
+	historyResp, err = queryClient.DowntimeHistory(gocontext.Background(),
+		&slashingtypes.QueryDowntimeHistoryRequest{ConsAddress: consAddr.String()})
+	require.NoError(err)
+	require.Empty(historyResp.History.Infractions)
+	require.Equal(slashingtypes.NewDowntimePenalty(params.SlashFractionDowntime, params.DowntimeJailDuration), historyResp.NextPenalty)
+
+	penalty := slashingtypes.NewDowntimePenalty(params.SlashFractionDowntime, params.DowntimeJailDuration)
+	history := slashingtypes.NewDowntimeHistory(consAddr, []slashingtypes.DowntimeInfraction{
+		slashingtypes.NewDowntimeInfraction(10, ctx.BlockTime(), penalty),
+	})
+	require.NoError(keeper.SetDowntimeHistory(ctx, consAddr, history))
+
+	historyResp, err = queryClient.DowntimeHistory(gocontext.Background(),
+		&slashingtypes.QueryDowntimeHistoryRequest{ConsAddress: consAddr.String()})
+	require.NoError(err)
+	require.Equal(history.Address, historyResp.History.Address)
+	require.Len(historyResp.History.Infractions, 1)
+	require.Equal(int64(10), historyResp.History.Infractions[0].Height)
+	require.Equal(penalty, historyResp.NextPenalty)
+}
+
+func (s *KeeperTestSuite) TestGRPCDowntimeHistories() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	// set the history of two validators
+	consAddr1 := sdk.ConsAddress(sdk.AccAddress([]byte("addr1_______________")))
+	consAddr2 := sdk.ConsAddress(sdk.AccAddress([]byte("addr2_______________")))
+	params := testutil.TestParams()
+	penalty := slashingtypes.NewDowntimePenalty(params.SlashFractionDowntime, params.DowntimeJailDuration)
+	for _, addr := range []sdk.ConsAddress{consAddr1, consAddr2} {
+		history := slashingtypes.NewDowntimeHistory(addr, []slashingtypes.DowntimeInfraction{
+			slashingtypes.NewDowntimeInfraction(10, ctx.BlockTime(), penalty),
+		})
+		require.NoError(keeper.SetDowntimeHistory(ctx, addr, history))
+	}
+
+	var histories []slashingtypes.DowntimeHistory
+	require.NoError(keeper.IterateDowntimeHistories(ctx, func(_ sdk.ConsAddress, history slashingtypes.DowntimeHistory) (stop bool) {
+		histories = append(histories, history)
+		return false
+	}))
+	require.Len(histories, 2)
+
+	// verify all values are returned without pagination
+	historiesResp, err := queryClient.DowntimeHistories(gocontext.Background(),
+		&slashingtypes.QueryDowntimeHistoriesRequest{Pagination: nil})
+	require.NoError(err)
+	require.Equal(histories, historiesResp.Histories)
+
+	historiesResp, err = queryClient.DowntimeHistories(gocontext.Background(),
+		&slashingtypes.QueryDowntimeHistoriesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(err)
+	require.Len(historiesResp.Histories, 1)
+	require.Equal(histories[0], historiesResp.Histories[0])
+	require.NotNil(historiesResp.Pagination.NextKey)
+	require.Equal(uint64(2), historiesResp.Pagination.Total)
+}
@@ -115,12 +115,14 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
+			// The penalty escalates with the downtime infractions of the
+			// validator which did not decay yet.
+			penalty, err := k.NextDowntimePenalty(ctx, consAddr)
 			if err != nil {
 				return err
 			}
 
-			coinsBurned := k.sk.SlashWithInfractionReason(sdkCtx, consAddr, distributionHeight, power, slashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			coinsBurned := k.sk.SlashWithInfractionReason(sdkCtx, consAddr, distributionHeight, power, penalty.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
 			sdkCtx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -133,11 +135,12 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 			)
 			k.sk.Jail(sdkCtx, consAddr)
 
-			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-			if err != nil {
+			signInfo.JailedUntil = sdkCtx.BlockHeader().Time.Add(penalty.JailDuration)
+
+			infraction := types.NewDowntimeInfraction(height, sdkCtx.BlockHeader().Time, penalty)
+			if err := k.addDowntimeInfraction(ctx, consAddr, infraction); err != nil {
 				return err
 			}
-			signInfo.JailedUntil = sdkCtx.BlockHeader().Time.Add(downtimeJailDur)
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
@@ -154,7 +157,7 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", slashFractionDowntime.String(),
+				"slashed", penalty.SlashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
@@ -37,6 +37,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.DowntimeHistoryKeyPrefix):
+			var historyA, historyB types.DowntimeHistory
+			cdc.MustUnmarshal(kvA.Value, &historyA)
+			cdc.MustUnmarshal(kvB.Value, &historyB)
+			return fmt.Sprintf("%v\n%v", historyA, historyB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
@@ -31,12 +31,16 @@ func TestDecodeStore(t *testing.T) {
 	missed := []byte{1} // we want to display the bytes for simulation diffs
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
+	history := types.NewDowntimeHistory(consAddr1, []types.DowntimeInfraction{
+		types.NewDowntimeInfraction(10, time.Now().UTC(), types.NewDowntimePenalty(types.DefaultSlashFractionDowntime, types.DefaultDowntimeJailDuration)),
+	})
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitmapKey(consAddr1, 6), Value: missed},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.DowntimeHistoryKey(consAddr1), Value: cdc.MustMarshal(&history)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -49,6 +53,7 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v\n", missed, missed), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"DowntimeHistory", fmt.Sprintf("%v\n%v", history, history), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
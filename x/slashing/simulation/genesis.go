@@ -20,6 +20,8 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	DowntimePenalties       = "downtime_penalties"
+	DowntimeHistoryDecay    = "downtime_history_decay"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -47,6 +49,24 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimePenalties randomized DowntimePenalties, escalating from the
+// given downtime slash fraction and jail duration.
+func GenDowntimePenalties(r *rand.Rand, slashFractionDowntime math.LegacyDec, downtimeJailDuration time.Duration) []types.DowntimePenalty {
+	penalties := make([]types.DowntimePenalty, r.Intn(4))
+	for i := range penalties {
+		slashFractionDowntime = math.LegacyMinDec(slashFractionDowntime.MulInt64(2), math.LegacyOneDec())
+		downtimeJailDuration *= 2
+		penalties[i] = types.NewDowntimePenalty(slashFractionDowntime, downtimeJailDuration)
+	}
+
+	return penalties
+}
+
+// GenDowntimeHistoryDecay randomized DowntimeHistoryDecay
+func GenDowntimeHistoryDecay(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*30)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -64,10 +84,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var slashFractionDowntime math.LegacyDec
 	simState.AppParams.GetOrGenerate(SlashFractionDowntime, &slashFractionDowntime, simState.Rand, func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) })
 
+	var downtimePenalties []types.DowntimePenalty
+	simState.AppParams.GetOrGenerate(DowntimePenalties, &downtimePenalties, simState.Rand, func(r *rand.Rand) {
+		downtimePenalties = GenDowntimePenalties(r, slashFractionDowntime, downtimeJailDuration)
+	})
+
+	var downtimeHistoryDecay time.Duration
+	simState.AppParams.GetOrGenerate(DowntimeHistoryDecay, &downtimeHistoryDecay, simState.Rand, func(r *rand.Rand) { downtimeHistoryDecay = GenDowntimeHistoryDecay(r) })
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
 	)
+	params.DowntimePenalties = downtimePenalties
+	params.DowntimeHistoryDecay = downtimeHistoryDecay
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
 
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDowntimeHistory creates a new DowntimeHistory instance
+func NewDowntimeHistory(consAddr sdk.ConsAddress, infractions []DowntimeInfraction) DowntimeHistory {
+	return DowntimeHistory{
+		Address:     consAddr.String(),
+		Infractions: infractions,
+	}
+}
+
+// NewDowntimeInfraction creates a new DowntimeInfraction instance
+func NewDowntimeInfraction(height int64, infractionTime time.Time, penalty DowntimePenalty) DowntimeInfraction {
+	return DowntimeInfraction{
+		Height:  height,
+		Time:    infractionTime,
+		Penalty: penalty,
+	}
+}
+
+// Decay returns the history without the infractions which decayed at the
+// given block time. The infractions never decay if decay is zero.
+func (h DowntimeHistory) Decay(blockTime time.Time, decay time.Duration) DowntimeHistory {
+	if decay == 0 {
+		return h
+	}
+
+	infractions := make([]DowntimeInfraction, 0, len(h.Infractions))
+	for _, infraction := range h.Infractions {
+		if blockTime.Before(infraction.Time.Add(decay)) {
+			infractions = append(infractions, infraction)
+		}
+	}
+
+	return DowntimeHistory{Address: h.Address, Infractions: infractions}
+}
+
+// Validate performs a basic validation of the downtime history.
+func (h DowntimeHistory) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(h.Address); err != nil {
+		return fmt.Errorf("invalid downtime history address %s: %w", h.Address, err)
+	}
+
+	for i, infraction := range h.Infractions {
+		if err := validateSlashFractionDowntime(infraction.Penalty.SlashFraction); err != nil {
+			return fmt.Errorf("invalid downtime infraction %d of %s: %w", i, h.Address, err)
+		}
+		if err := validateDowntimeJailDuration(infraction.Penalty.JailDuration); err != nil {
+			return fmt.Errorf("invalid downtime infraction %d of %s: %w", i, h.Address, err)
+		}
+
+		if i > 0 && infraction.Height < h.Infractions[i-1].Height {
+			return fmt.Errorf("downtime infractions of %s are not in chronological order", h.Address)
+		}
+	}
+
+	return nil
+}
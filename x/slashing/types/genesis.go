@@ -29,9 +29,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:            DefaultParams(),
+		SigningInfos:      []SigningInfo{},
+		MissedBlocks:      []ValidatorMissedBlocks{},
+		DowntimeHistories: []DowntimeHistory{},
 	}
 }
 
@@ -62,5 +63,25 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateDowntimePenalties(data.Params.DowntimePenalties); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeHistoryDecay(data.Params.DowntimeHistoryDecay); err != nil {
+		return err
+	}
+
+	seenHistories := make(map[string]bool, len(data.DowntimeHistories))
+	for _, history := range data.DowntimeHistories {
+		if err := history.Validate(); err != nil {
+			return err
+		}
+
+		if seenHistories[history.Address] {
+			return fmt.Errorf("duplicate downtime history for %s", history.Address)
+		}
+		seenHistories[history.Address] = true
+	}
+
 	return nil
 }
@@ -46,12 +46,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes>: DowntimeHistory
 
 var (
 	ParamsKey                           = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x02} // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = []byte{0x03} // Prefix for address-pubkey relation
+	DowntimeHistoryKeyPrefix            = []byte{0x04} // Prefix for downtime infractions history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -87,3 +90,16 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// DowntimeHistoryKey returns the key for a validator's downtime infractions
+// history, stored by *Consensus* address.
+func DowntimeHistoryKey(v sdk.ConsAddress) []byte {
+	return append(DowntimeHistoryKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// DowntimeHistoryAddress extracts the address from a downtime history key.
+func DowntimeHistoryAddress(key []byte) sdk.ConsAddress {
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	return sdk.ConsAddress(key[2:])
+}
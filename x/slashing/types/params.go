@@ -11,6 +11,7 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultDowntimeHistoryDecay = 30 * 24 * time.Hour
 )
 
 var (
@@ -35,13 +36,38 @@ func NewParams(
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSignedBlocksWindow,
 		DefaultMinSignedPerWindow,
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
 	)
+	params.DowntimeHistoryDecay = DefaultDowntimeHistoryDecay
+
+	return params
+}
+
+// NewDowntimePenalty creates a new DowntimePenalty object
+func NewDowntimePenalty(slashFraction math.LegacyDec, jailDuration time.Duration) DowntimePenalty {
+	return DowntimePenalty{
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
+}
+
+// DowntimePenaltyFor returns the penalty of a downtime infraction of a
+// validator with the given number of previous downtime infractions.
+func (p Params) DowntimePenaltyFor(previousInfractions int) DowntimePenalty {
+	if len(p.DowntimePenalties) == 0 {
+		return NewDowntimePenalty(p.SlashFractionDowntime, p.DowntimeJailDuration)
+	}
+
+	if previousInfractions >= len(p.DowntimePenalties) {
+		return p.DowntimePenalties[len(p.DowntimePenalties)-1]
+	}
+
+	return p.DowntimePenalties[previousInfractions]
 }
 
 // Validate validates the params
@@ -61,6 +87,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimePenalties(p.DowntimePenalties); err != nil {
+		return err
+	}
+	if err := validateDowntimeHistoryDecay(p.DowntimeHistoryDecay); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -146,3 +178,34 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimePenalties(i interface{}) error {
+	v, ok := i.([]DowntimePenalty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, penalty := range v {
+		if err := validateSlashFractionDowntime(penalty.SlashFraction); err != nil {
+			return fmt.Errorf("invalid downtime penalty %d: %w", i, err)
+		}
+		if err := validateDowntimeJailDuration(penalty.JailDuration); err != nil {
+			return fmt.Errorf("invalid downtime penalty %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDowntimeHistoryDecay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime history decay cannot be negative: %s", v)
+	}
+
+	return nil
+}